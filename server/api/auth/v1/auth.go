@@ -6,7 +6,9 @@ import (
 
 // 登录请求
 type LoginReq struct {
-	g.Meta   `path:"/auth/login" tags:"Auth" method:"post" summary:"Password login" noAuth:"true"`
+	g.Meta `path:"/auth/login" tags:"Auth" method:"post" summary:"Password login" noAuth:"true"`
+	// 用户名；为空时使用站点密码（core/dev/keyPassword）以管理员身份登录
+	Username string `json:"username"`
 	Password string `json:"password" v:"required"`
 	// 可选：令牌有效期（秒）。仅非生产环境生效，用于测试 token 过期场景。
 	TTL int `json:"ttl" v:"min:1"`
@@ -23,8 +25,17 @@ type MeReq struct {
 }
 
 type UserInfo struct {
-	Username string   `json:"username"`
-	Roles    []string `json:"roles"`
+	Id          int64    `json:"id"`
+	Username    string   `json:"username"`
+	DisplayName string   `json:"displayName"`
+	AvatarUrl   string   `json:"avatarUrl"`
+	Bio         string   `json:"bio"`
+	Role        string   `json:"role" dc:"admin 管理员，editor 编辑，author 作者"`
+	Roles       []string `json:"roles"`
+	IsActive    bool     `json:"isActive"`
+	HasPassword bool     `json:"hasPassword" dc:"是否已设置用户名密码登录"`
+	LastLoginAt string   `json:"lastLoginAt"`
+	CreatedAt   string   `json:"createdAt"`
 }
 
 type MeRes struct {
@@ -36,6 +47,16 @@ type IAuthV1 interface {
 	Login(ctx g.Ctx, req *LoginReq) (res *LoginRes, err error)
 	Me(ctx g.Ctx, req *MeReq) (res *MeRes, err error)
 	Logout(ctx g.Ctx, req *LogoutReq) (res *LogoutRes, err error)
+
+	// 个人资料
+	UpdateProfile(ctx g.Ctx, req *UpdateProfileReq) (res *UpdateProfileRes, err error)
+	ChangePassword(ctx g.Ctx, req *ChangePasswordReq) (res *ChangePasswordRes, err error)
+
+	// 用户管理（管理员）
+	ListUsers(ctx g.Ctx, req *ListUsersReq) (res *ListUsersRes, err error)
+	CreateUser(ctx g.Ctx, req *CreateUserReq) (res *CreateUserRes, err error)
+	UpdateUser(ctx g.Ctx, req *UpdateUserReq) (res *UpdateUserRes, err error)
+	DeleteUser(ctx g.Ctx, req *DeleteUserReq) (res *DeleteUserRes, err error)
 }
 
 // 登出当前会话（需登录）
@@ -59,3 +80,83 @@ type GenerateUrlTokenRes struct {
 	ExpiresAt int64  `json:"expires_at" dc:"令牌过期时间戳（秒）"`
 	LoginUrl  string `json:"login_url" dc:"包含token的登录URL"`
 }
+
+// 修改个人资料
+type UpdateProfileReq struct {
+	g.Meta         `path:"/auth/profile" tags:"Auth" method:"put" summary:"Update the profile of the current user"`
+	DisplayName    *string `json:"displayName" v:"max-length:100"`
+	AvatarFileUuid *string `json:"avatarFileUuid" dc:"头像文件UUID，空字符串表示清除"`
+	Bio            *string `json:"bio" v:"max-length:2000"`
+}
+
+type UpdateProfileRes struct {
+	User *UserInfo `json:"user"`
+}
+
+// 修改当前用户密码，之前签发的令牌全部失效并返回新令牌
+type ChangePasswordReq struct {
+	g.Meta      `path:"/auth/password" tags:"Auth" method:"put" summary:"Change the password of the current user"`
+	OldPassword string `json:"oldPassword" dc:"原密码，尚未设置密码时可为空"`
+	NewPassword string `json:"newPassword" v:"required|min-length:8"`
+}
+
+type ChangePasswordRes struct {
+	Token     string `json:"token"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// 用户列表
+type ListUsersReq struct {
+	g.Meta `path:"/auth/users" tags:"Auth" method:"get" summary:"List users" roles:"admin"`
+	Page   int `json:"page" d:"1" v:"min:1"`
+	Size   int `json:"size" d:"20" v:"min:1|max:100"`
+}
+
+type ListUsersRes struct {
+	Page  int        `json:"page"`
+	Size  int        `json:"size"`
+	Total int        `json:"total"`
+	List  []UserInfo `json:"list"`
+}
+
+// 创建用户
+type CreateUserReq struct {
+	g.Meta         `path:"/auth/users" tags:"Auth" method:"post" summary:"Create a user" roles:"admin"`
+	Username       string `json:"username" v:"required|max-length:50"`
+	Password       string `json:"password" v:"required|min-length:8"`
+	Role           string `json:"role" d:"author" v:"in:admin,editor,author"`
+	DisplayName    string `json:"displayName" v:"max-length:100"`
+	AvatarFileUuid string `json:"avatarFileUuid"`
+	Bio            string `json:"bio" v:"max-length:2000"`
+}
+
+type CreateUserRes struct {
+	User *UserInfo `json:"user"`
+}
+
+// 修改用户（角色、状态、资料或重置密码）
+type UpdateUserReq struct {
+	g.Meta         `path:"/auth/users" tags:"Auth" method:"put" summary:"Update a user" roles:"admin"`
+	Id             int64   `json:"id" v:"required|min:1"`
+	Role           *string `json:"role" v:"in:admin,editor,author"`
+	IsActive       *bool   `json:"isActive"`
+	Password       *string `json:"password" dc:"重置密码，该用户已签发的令牌全部失效"`
+	DisplayName    *string `json:"displayName" v:"max-length:100"`
+	AvatarFileUuid *string `json:"avatarFileUuid"`
+	Bio            *string `json:"bio" v:"max-length:2000"`
+}
+
+type UpdateUserRes struct {
+	User *UserInfo `json:"user"`
+}
+
+// 删除用户，其文章与微博转给另一个用户
+type DeleteUserReq struct {
+	g.Meta     `path:"/auth/users" tags:"Auth" method:"delete" summary:"Delete a user" roles:"admin"`
+	Id         int64 `json:"id" v:"required|min:1"`
+	ReassignTo int64 `json:"reassignTo" dc:"接收其文章与微博的用户ID，用户没有内容时可为空"`
+}
+
+type DeleteUserRes struct {
+	Deleted bool `json:"deleted"`
+}
@@ -16,9 +16,74 @@ type IBlogV1 interface {
 	List(ctx context.Context, req *v1.ListReq) (res *v1.ListRes, err error)
 	Detail(ctx context.Context, req *v1.DetailReq) (res *v1.DetailRes, err error)
 	Delete(ctx context.Context, req *v1.DeleteReq) (res *v1.DeleteRes, err error)
+	Like(ctx context.Context, req *v1.LikeReq) (res *v1.LikeRes, err error)
+	Unlike(ctx context.Context, req *v1.UnlikeReq) (res *v1.UnlikeRes, err error)
+	Share(ctx context.Context, req *v1.ShareReq) (res *v1.ShareRes, err error)
+	Trash(ctx context.Context, req *v1.TrashReq) (res *v1.TrashRes, err error)
+	Restore(ctx context.Context, req *v1.RestoreReq) (res *v1.RestoreRes, err error)
+	Purge(ctx context.Context, req *v1.PurgeReq) (res *v1.PurgeRes, err error)
+	Search(ctx context.Context, req *v1.SearchReq) (res *v1.SearchRes, err error)
+	Feed(ctx context.Context, req *v1.FeedReq) (res *v1.FeedRes, err error)
+	Scheduled(ctx context.Context, req *v1.ScheduledReq) (res *v1.ScheduledRes, err error)
+	Import(ctx context.Context, req *v1.ImportReq) (res *v1.ImportRes, err error)
+	Export(ctx context.Context, req *v1.ExportReq) (res *v1.ExportRes, err error)
+	Related(ctx context.Context, req *v1.RelatedReq) (res *v1.RelatedRes, err error)
+	SeriesNav(ctx context.Context, req *v1.SeriesNavReq) (res *v1.SeriesNavRes, err error)
+	Archive(ctx context.Context, req *v1.ArchiveReq) (res *v1.ArchiveRes, err error)
+	ArchiveArticles(ctx context.Context, req *v1.ArchiveArticlesReq) (res *v1.ArchiveArticlesRes, err error)
+	ArticleVersions(ctx context.Context, req *v1.ArticleVersionsReq) (res *v1.ArticleVersionsRes, err error)
+	ArticleVersion(ctx context.Context, req *v1.ArticleVersionReq) (res *v1.ArticleVersionRes, err error)
+	ArticleVersionDiff(ctx context.Context, req *v1.ArticleVersionDiffReq) (res *v1.ArticleVersionDiffRes, err error)
+	RestoreArticleVersion(ctx context.Context, req *v1.RestoreArticleVersionReq) (res *v1.RestoreArticleVersionRes, err error)
+	SetArticlePassword(ctx context.Context, req *v1.SetArticlePasswordReq) (res *v1.SetArticlePasswordRes, err error)
+	UnlockArticle(ctx context.Context, req *v1.UnlockArticleReq) (res *v1.UnlockArticleRes, err error)
+	CreatePreviewToken(ctx context.Context, req *v1.CreatePreviewTokenReq) (res *v1.CreatePreviewTokenRes, err error)
+	ListPreviewTokens(ctx context.Context, req *v1.ListPreviewTokensReq) (res *v1.ListPreviewTokensRes, err error)
+	RevokePreviewToken(ctx context.Context, req *v1.RevokePreviewTokenReq) (res *v1.RevokePreviewTokenRes, err error)
 	CreateCategory(ctx context.Context, req *v1.CreateCategoryReq) (res *v1.CreateCategoryRes, err error)
 	ListCategories(ctx context.Context, req *v1.ListCategoriesReq) (res *v1.ListCategoriesRes, err error)
+	UpdateCategory(ctx context.Context, req *v1.UpdateCategoryReq) (res *v1.UpdateCategoryRes, err error)
+	DeleteCategory(ctx context.Context, req *v1.DeleteCategoryReq) (res *v1.DeleteCategoryRes, err error)
+	ReorderCategories(ctx context.Context, req *v1.ReorderCategoriesReq) (res *v1.ReorderCategoriesRes, err error)
+	CategoryTree(ctx context.Context, req *v1.CategoryTreeReq) (res *v1.CategoryTreeRes, err error)
+	ListTags(ctx context.Context, req *v1.ListTagsReq) (res *v1.ListTagsRes, err error)
+	CreateTag(ctx context.Context, req *v1.CreateTagReq) (res *v1.CreateTagRes, err error)
+	UpdateTag(ctx context.Context, req *v1.UpdateTagReq) (res *v1.UpdateTagRes, err error)
+	DeleteTag(ctx context.Context, req *v1.DeleteTagReq) (res *v1.DeleteTagRes, err error)
+	MergeTags(ctx context.Context, req *v1.MergeTagsReq) (res *v1.MergeTagsRes, err error)
+	TagArticles(ctx context.Context, req *v1.TagArticlesReq) (res *v1.TagArticlesRes, err error)
+	TagCloud(ctx context.Context, req *v1.TagCloudReq) (res *v1.TagCloudRes, err error)
+	ListSeries(ctx context.Context, req *v1.ListSeriesReq) (res *v1.ListSeriesRes, err error)
+	SeriesDetail(ctx context.Context, req *v1.SeriesDetailReq) (res *v1.SeriesDetailRes, err error)
+	CreateSeries(ctx context.Context, req *v1.CreateSeriesReq) (res *v1.CreateSeriesRes, err error)
+	UpdateSeries(ctx context.Context, req *v1.UpdateSeriesReq) (res *v1.UpdateSeriesRes, err error)
+	DeleteSeries(ctx context.Context, req *v1.DeleteSeriesReq) (res *v1.DeleteSeriesRes, err error)
+	SetSeriesArticles(ctx context.Context, req *v1.SetSeriesArticlesReq) (res *v1.SetSeriesArticlesRes, err error)
 	CreateComment(ctx context.Context, req *v1.CreateCommentReq) (res *v1.CreateCommentRes, err error)
 	ListComments(ctx context.Context, req *v1.ListCommentsReq) (res *v1.ListCommentsRes, err error)
 	DeleteComment(ctx context.Context, req *v1.DeleteCommentReq) (res *v1.DeleteCommentRes, err error)
+	CommentFormToken(ctx context.Context, req *v1.CommentFormTokenReq) (res *v1.CommentFormTokenRes, err error)
+	ModerateComment(ctx context.Context, req *v1.ModerateCommentReq) (res *v1.ModerateCommentRes, err error)
+	UnsubscribeCommentReplies(ctx context.Context, req *v1.UnsubscribeCommentRepliesReq) (res *v1.UnsubscribeCommentRepliesRes, err error)
+	Subscribe(ctx context.Context, req *v1.SubscribeReq) (res *v1.SubscribeRes, err error)
+	ConfirmSubscription(ctx context.Context, req *v1.ConfirmSubscriptionReq) (res *v1.ConfirmSubscriptionRes, err error)
+	Unsubscribe(ctx context.Context, req *v1.UnsubscribeReq) (res *v1.UnsubscribeRes, err error)
+	NewsletterPreferences(ctx context.Context, req *v1.NewsletterPreferencesReq) (res *v1.NewsletterPreferencesRes, err error)
+	UpdateNewsletterPreferences(ctx context.Context, req *v1.UpdateNewsletterPreferencesReq) (res *v1.UpdateNewsletterPreferencesRes, err error)
+	ListSubscribers(ctx context.Context, req *v1.ListSubscribersReq) (res *v1.ListSubscribersRes, err error)
+	DeleteSubscriber(ctx context.Context, req *v1.DeleteSubscriberReq) (res *v1.DeleteSubscriberRes, err error)
+	ListMailOutbox(ctx context.Context, req *v1.ListMailOutboxReq) (res *v1.ListMailOutboxRes, err error)
+	RetryMail(ctx context.Context, req *v1.RetryMailReq) (res *v1.RetryMailRes, err error)
+	SendTestMail(ctx context.Context, req *v1.SendTestMailReq) (res *v1.SendTestMailRes, err error)
+	ReceiveWebmention(ctx context.Context, req *v1.ReceiveWebmentionReq) (res *v1.ReceiveWebmentionRes, err error)
+	Pingback(ctx context.Context, req *v1.PingbackReq) (res *v1.PingbackRes, err error)
+	ListWebmentions(ctx context.Context, req *v1.ListWebmentionsReq) (res *v1.ListWebmentionsRes, err error)
+	BlockWebmention(ctx context.Context, req *v1.BlockWebmentionReq) (res *v1.BlockWebmentionRes, err error)
+	ListWebmentionSends(ctx context.Context, req *v1.ListWebmentionSendsReq) (res *v1.ListWebmentionSendsRes, err error)
+	RegenerateShareImage(ctx context.Context, req *v1.RegenerateShareImageReq) (res *v1.RegenerateShareImageRes, err error)
+	ListAuthors(ctx context.Context, req *v1.ListAuthorsReq) (res *v1.ListAuthorsRes, err error)
+	AuthorDetail(ctx context.Context, req *v1.AuthorDetailReq) (res *v1.AuthorDetailRes, err error)
+	ListTranslations(ctx context.Context, req *v1.ListTranslationsReq) (res *v1.ListTranslationsRes, err error)
+	SaveTranslation(ctx context.Context, req *v1.SaveTranslationReq) (res *v1.SaveTranslationRes, err error)
+	DeleteTranslation(ctx context.Context, req *v1.DeleteTranslationReq) (res *v1.DeleteTranslationRes, err error)
 }
@@ -1,9 +1,11 @@
 package v1
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
 )
 
 // 博客模块 API 定义，遵循 gf gen ctrl 生成规范
@@ -30,19 +32,20 @@ type TagInput struct {
 // 创建博客文章
 type CreateReq struct {
 	g.Meta        `path:"/blog/articles" tags:"Blog" method:"post" summary:"Create a blog article"`
-	Title         string     `json:"title" v:"required|length:3,255"`                         // 文章标题
-	Slug          string     `json:"slug" v:"required|length:3,255"`                          // URL友好标识
-	Summary       string     `json:"summary" v:"length:0,500"`                                // 文章摘要
-	Content       string     `json:"content" v:"required"`                                    // Markdown内容
-	CategoryId    int64      `json:"categoryId" v:"min:1"`                                    // 分类ID
-	Tags          []TagInput `json:"tags"`                                                    // 标签列表
-	Status        string     `json:"status" d:"draft" v:"in:draft,published,private,archive"` // 文章状态
-	IsDraft       bool       `json:"isDraft" d:"true"`                                        // 是否为草稿
-	IsTop         bool       `json:"isTop" d:"false"`                                         // 是否置顶
-	IsPrivate     bool       `json:"isPrivate" d:"false"`                                     // 是否私密
-	FeaturedImage string     `json:"featuredImage"`                                           // 特色图片URL
-	PublishAt     *time.Time `json:"publishAt"`                                               // 发布时间
-	SEO           SEOInput   `json:"seo"`                                                     // SEO数据
+	Title         string     `json:"title" v:"required|length:3,255"`                                   // 文章标题
+	Slug          string     `json:"slug" v:"length:0,255"`                                             // URL友好标识，为空时根据标题生成（汉字转拼音）
+	Summary       string     `json:"summary" v:"length:0,500"`                                          // 文章摘要
+	Content       string     `json:"content" v:"required"`                                              // Markdown内容
+	CategoryId    int64      `json:"categoryId" v:"min:1"`                                              // 分类ID
+	Tags          []TagInput `json:"tags"`                                                              // 标签列表
+	Status        string     `json:"status" d:"draft" v:"in:draft,published,scheduled,private,archive"` // 文章状态
+	IsDraft       bool       `json:"isDraft" d:"true"`                                                  // 是否为草稿
+	IsTop         bool       `json:"isTop" d:"false"`                                                   // 是否置顶
+	IsPrivate     bool       `json:"isPrivate" d:"false"`                                               // 是否私密
+	FeaturedImage string     `json:"featuredImage"`                                                     // 特色图片URL
+	PublishAt     *time.Time `json:"publishAt"`                                                         // 发布时间
+	Language      string     `json:"language" v:"length:0,20"`                                          // 原文语言（如 zh-CN、en），为空时为站点默认语言
+	SEO           SEOInput   `json:"seo"`                                                               // SEO数据
 }
 
 type CreateRes struct {
@@ -55,18 +58,20 @@ type UpdateReq struct {
 	g.Meta        `path:"/blog/articles" tags:"Blog" method:"put" summary:"Update a blog article"`
 	Id            int64      `json:"id" v:"required|min:1"`
 	Title         string     `json:"title" v:"required|length:3,255"`
-	Slug          string     `json:"slug" v:"required|length:3,255"`
+	Slug          string     `json:"slug" v:"length:0,255"` // 为空时保持不变，修改后旧地址 301 重定向
 	Summary       string     `json:"summary" v:"length:0,500"`
 	Content       string     `json:"content" v:"required"`
 	CategoryId    int64      `json:"categoryId" v:"min:1"`
 	Tags          []TagInput `json:"tags"`
-	Status        string     `json:"status" v:"in:draft,published,private,archive"`
+	Status        string     `json:"status" v:"in:draft,published,scheduled,private,archive"`
 	IsDraft       bool       `json:"isDraft"`
 	IsTop         bool       `json:"isTop"`
 	IsPrivate     bool       `json:"isPrivate"`
 	FeaturedImage string     `json:"featuredImage"`
 	PublishAt     *time.Time `json:"publishAt"`
+	Language      string     `json:"language" v:"length:0,20"` // 原文语言，为空时保持不变
 	SEO           SEOInput   `json:"seo"`
+	ChangeSummary string     `json:"changeSummary" v:"length:0,500"` // 修改说明（记录到版本历史）
 }
 
 type UpdateRes struct {
@@ -82,6 +87,11 @@ type ListReq struct {
 	Tag        string `json:"tag"`
 	Status     string `json:"status"`
 	Search     string `json:"search"`
+	AuthorId   int64  `json:"authorId"` // 按作者ID过滤
+	Author     string `json:"author"`   // 按作者用户名过滤
+	Lang       string `json:"lang"`     // 语言（如 en），有对应翻译的文章返回译文
+	// 按分类过滤时是否包含子分类的文章
+	IncludeChildren bool `json:"includeChildren"`
 }
 
 type TagItem struct {
@@ -91,26 +101,37 @@ type TagItem struct {
 }
 
 type ArticleItem struct {
-	Id            int64      `json:"id"`
-	Title         string     `json:"title"`
-	Slug          string     `json:"slug"`
-	Summary       string     `json:"summary"`
-	CategoryId    int64      `json:"categoryId"`
-	CategoryName  string     `json:"categoryName"`
-	Status        string     `json:"status"`
-	IsDraft       bool       `json:"isDraft"`
-	IsTop         bool       `json:"isTop"`
-	IsPrivate     bool       `json:"isPrivate"`
-	ViewCount     int        `json:"viewCount"`
-	LikeCount     int        `json:"likeCount"`
-	CommentCount  int        `json:"commentCount"`
-	ShareCount    int        `json:"shareCount"`
-	FeaturedImage string     `json:"featuredImage"`
-	ReadTime      int        `json:"readTime"`
-	PublishAt     *time.Time `json:"publishAt"`
-	CreatedAt     time.Time  `json:"createdAt"`
-	UpdatedAt     time.Time  `json:"updatedAt"`
-	Tags          []TagItem  `json:"tags"`
+	Id            int64        `json:"id"`
+	Title         string       `json:"title"`
+	Slug          string       `json:"slug"`
+	Summary       string       `json:"summary"`
+	CategoryId    int64        `json:"categoryId"`
+	CategoryName  string       `json:"categoryName"`
+	Status        string       `json:"status"`
+	IsDraft       bool         `json:"isDraft"`
+	IsTop         bool         `json:"isTop"`
+	IsPrivate     bool         `json:"isPrivate"`
+	Protected     bool         `json:"protected"` // 文章设置了访问密码
+	ViewCount     int          `json:"viewCount"`
+	LikeCount     int          `json:"likeCount"`
+	CommentCount  int          `json:"commentCount"`
+	ShareCount    int          `json:"shareCount"`
+	FeaturedImage string       `json:"featuredImage"`
+	ReadTime      int          `json:"readTime"`
+	PublishAt     *time.Time   `json:"publishAt"`
+	CreatedAt     time.Time    `json:"createdAt"`
+	UpdatedAt     time.Time    `json:"updatedAt"`
+	Tags          []TagItem    `json:"tags"`
+	Author        *AuthorBrief `json:"author"`
+	Language      string       `json:"language"` // 返回内容的语言
+}
+
+// 作者简要信息
+type AuthorBrief struct {
+	Id          int64  `json:"id"`
+	Username    string `json:"username"`
+	DisplayName string `json:"displayName"`
+	AvatarUrl   string `json:"avatarUrl"`
 }
 
 type ListRes struct {
@@ -120,50 +141,114 @@ type ListRes struct {
 	List  []ArticleItem `json:"list"`
 }
 
+// 文章全文搜索
+type SearchReq struct {
+	g.Meta     `path:"/blog/articles/search" tags:"Blog" method:"get" summary:"Full-text search published articles" noAuth:"true"`
+	Q          string `json:"q" v:"required|length:1,100"` // 搜索关键词（支持中文）
+	Page       int    `json:"page" d:"1"`
+	Size       int    `json:"size" d:"10"`
+	CategoryId int64  `json:"categoryId"`
+	Tag        string `json:"tag"` // 标签 slug 或名称
+	// 按分类过滤时是否包含子分类的文章
+	IncludeChildren bool `json:"includeChildren"`
+}
+
+type SearchItem struct {
+	Id             int64      `json:"id"`
+	Title          string     `json:"title"`
+	TitleHighlight string     `json:"titleHighlight"` // 标题高亮（<mark>包裹命中词）
+	Slug           string     `json:"slug"`
+	Summary        string     `json:"summary"`
+	Headline       string     `json:"headline"` // 正文命中片段（<mark>包裹命中词）
+	CategoryId     int64      `json:"categoryId"`
+	FeaturedImage  string     `json:"featuredImage"`
+	PublishAt      *time.Time `json:"publishAt"`
+	Rank           float64    `json:"rank"` // 相关度得分
+	Tags           []TagItem  `json:"tags"`
+}
+
+type SearchRes struct {
+	Page  int          `json:"page"`
+	Size  int          `json:"size"`
+	Total int          `json:"total"`
+	List  []SearchItem `json:"list"`
+}
+
 // 文章详情
 type DetailReq struct {
 	g.Meta        `path:"/blog/articles/detail" tags:"Blog" method:"get" summary:"Get blog article detail" noAuth:"true"`
-	Id            int64 `json:"id" v:"required|min:1"`
-	IncrementView bool  `json:"incrementView" d:"true"` // 是否增加浏览次数
+	Id            int64  `json:"id" v:"required-without:Slug"`
+	Slug          string `json:"slug"`                   // 按 slug 查询，旧 slug 会 301 重定向到当前地址
+	IncrementView bool   `json:"incrementView" d:"true"` // 是否增加浏览次数
+	Preview       string `json:"preview"`                // 草稿预览令牌，匿名访客凭此只读查看未发布文章
+	Unlock        string `json:"unlock"`                 // 密码保护文章的解锁令牌，也可通过 Cookie 携带
+	Lang          string `json:"lang"`                   // 语言（如 en），存在对应翻译时返回译文，缺失时按 translation_fallback 处理
+}
+
+// hreflang 备用语言链接
+type HreflangLink struct {
+	Hreflang string `json:"hreflang"` // 语言标签，x-default 表示默认版本
+	URL      string `json:"url"`
 }
 
 type SEOData struct {
-	MetaTitle       string `json:"metaTitle"`
-	MetaDescription string `json:"metaDescription"`
-	MetaKeywords    string `json:"metaKeywords"`
-	OGTitle         string `json:"ogTitle"`
-	OGDescription   string `json:"ogDescription"`
-	OGImage         string `json:"ogImage"`
-	TwitterTitle    string `json:"twitterTitle"`
-	TwitterDesc     string `json:"twitterDesc"`
-	TwitterImage    string `json:"twitterImage"`
-	CanonicalURL    string `json:"canonicalUrl"`
+	MetaTitle       string          `json:"metaTitle"`
+	MetaDescription string          `json:"metaDescription"`
+	MetaKeywords    string          `json:"metaKeywords"`
+	OGTitle         string          `json:"ogTitle"`
+	OGDescription   string          `json:"ogDescription"`
+	OGImage         string          `json:"ogImage"`
+	TwitterTitle    string          `json:"twitterTitle"`
+	TwitterDesc     string          `json:"twitterDesc"`
+	TwitterImage    string          `json:"twitterImage"`
+	CanonicalURL    string          `json:"canonicalUrl"`
+	JsonLd          json.RawMessage `json:"jsonLd"`                  // schema.org BlogPosting 结构化数据
+	WebmentionURL   string          `json:"webmentionUrl,omitempty"` // <link rel="webmention"> 端点
+	PingbackURL     string          `json:"pingbackUrl,omitempty"`   // <link rel="pingback"> 端点
+	Language        string          `json:"language"`                // 页面语言（<html lang>）
+	Alternates      []HreflangLink  `json:"alternates,omitempty"`    // <link rel="alternate" hreflang> 备用语言版本
+}
+
+// 文章的语言版本
+type TranslationLink struct {
+	Language string `json:"language"`
+	Slug     string `json:"slug"`
+	Title    string `json:"title"`
+	Original bool   `json:"original"` // 是否为原文
+	Current  bool   `json:"current"`  // 是否为当前返回的版本
 }
 
 type DetailRes struct {
-	Id            int64      `json:"id"`
-	Title         string     `json:"title"`
-	Slug          string     `json:"slug"`
-	Summary       string     `json:"summary"`
-	Content       string     `json:"content"`     // Markdown内容
-	HtmlContent   string     `json:"htmlContent"` // HTML渲染内容
-	CategoryId    int64      `json:"categoryId"`
-	CategoryName  string     `json:"categoryName"`
-	Status        string     `json:"status"`
-	IsDraft       bool       `json:"isDraft"`
-	IsTop         bool       `json:"isTop"`
-	IsPrivate     bool       `json:"isPrivate"`
-	ViewCount     int        `json:"viewCount"`
-	LikeCount     int        `json:"likeCount"`
-	CommentCount  int        `json:"commentCount"`
-	ShareCount    int        `json:"shareCount"`
-	FeaturedImage string     `json:"featuredImage"`
-	ReadTime      int        `json:"readTime"`
-	PublishAt     *time.Time `json:"publishAt"`
-	CreatedAt     time.Time  `json:"createdAt"`
-	UpdatedAt     time.Time  `json:"updatedAt"`
-	Tags          []TagItem  `json:"tags"`
-	SEO           SEOData    `json:"seo"`
+	Id            int64             `json:"id"`
+	Title         string            `json:"title"`
+	Slug          string            `json:"slug"`
+	Summary       string            `json:"summary"`
+	Content       string            `json:"content"`     // Markdown内容
+	HtmlContent   string            `json:"htmlContent"` // HTML渲染内容
+	CategoryId    int64             `json:"categoryId"`
+	CategoryName  string            `json:"categoryName"`
+	Status        string            `json:"status"`
+	IsDraft       bool              `json:"isDraft"`
+	IsTop         bool              `json:"isTop"`
+	IsPrivate     bool              `json:"isPrivate"`
+	ViewCount     int               `json:"viewCount"`
+	LikeCount     int               `json:"likeCount"`
+	CommentCount  int               `json:"commentCount"`
+	ShareCount    int               `json:"shareCount"`
+	Liked         bool              `json:"liked"` // 当前访客是否已点赞
+	FeaturedImage string            `json:"featuredImage"`
+	ReadTime      int               `json:"readTime"`
+	PublishAt     *time.Time        `json:"publishAt"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	UpdatedAt     time.Time         `json:"updatedAt"`
+	Tags          []TagItem         `json:"tags"`
+	Author        *AuthorBrief      `json:"author"`
+	Language      string            `json:"language"`               // 返回内容的语言
+	Translations  []TranslationLink `json:"translations,omitempty"` // 全部语言版本（只有原文时为空）
+	SEO           SEOData           `json:"seo"`
+	Preview       bool              `json:"preview"`   // 通过预览令牌访问的草稿
+	Protected     bool              `json:"protected"` // 文章设置了访问密码
+	Locked        bool              `json:"locked"`    // 尚未解锁，正文为空
 }
 
 // 删除文章（软删除）
@@ -176,13 +261,122 @@ type DeleteRes struct {
 	Deleted bool `json:"deleted"`
 }
 
+// 匿名点赞（按访客 Cookie 去重）
+type LikeReq struct {
+	g.Meta `path:"/blog/articles/like" tags:"Blog" method:"post" summary:"Like a blog article" noAuth:"true"`
+	Id     int64 `json:"id" v:"required|min:1"`
+}
+
+type LikeRes struct {
+	Liked     bool `json:"liked"`
+	LikeCount int  `json:"likeCount"`
+}
+
+type UnlikeReq struct {
+	g.Meta `path:"/blog/articles/like" tags:"Blog" method:"delete" summary:"Cancel a like on a blog article" noAuth:"true"`
+	Id     int64 `json:"id" v:"required|min:1"`
+}
+
+type UnlikeRes struct {
+	Liked     bool `json:"liked"`
+	LikeCount int  `json:"likeCount"`
+}
+
+// 分享记录
+type ShareReq struct {
+	g.Meta  `path:"/blog/articles/share" tags:"Blog" method:"post" summary:"Record a share of a blog article" noAuth:"true"`
+	Id      int64  `json:"id" v:"required|min:1"`
+	Channel string `json:"channel" v:"required|length:1,32"` // 分享渠道，如 wechat、weibo、twitter、link
+}
+
+type ShareRes struct {
+	ShareCount int `json:"shareCount"`
+}
+
+// 回收站文章列表
+type TrashReq struct {
+	g.Meta `path:"/blog/articles/trash" tags:"Blog" method:"get" summary:"List deleted articles in the trash"`
+	Page   int `json:"page" d:"1"`
+	Size   int `json:"size" d:"10"`
+}
+
+type TrashItem struct {
+	ArticleItem
+	DeletedAt *time.Time `json:"deletedAt"`
+	PurgeAt   *time.Time `json:"purgeAt"` // 预计永久删除时间
+}
+
+type TrashRes struct {
+	Page          int         `json:"page"`
+	Size          int         `json:"size"`
+	Total         int         `json:"total"`
+	RetentionDays int         `json:"retentionDays"`
+	List          []TrashItem `json:"list"`
+}
+
+// 从回收站恢复文章
+type RestoreReq struct {
+	g.Meta `path:"/blog/articles/restore" tags:"Blog" method:"post" summary:"Restore an article from the trash"`
+	Id     int64 `json:"id" v:"required|min:1"`
+}
+
+type RestoreRes struct {
+	Restored bool `json:"restored"`
+}
+
+// 永久删除回收站中的文章
+type PurgeReq struct {
+	g.Meta `path:"/blog/articles/trash" tags:"Blog" method:"delete" summary:"Permanently delete an article from the trash"`
+	Id     int64 `json:"id" v:"required|min:1"`
+}
+
+type PurgeRes struct {
+	Purged bool `json:"purged"`
+}
+
+// 相关文章（按共同标签、分类与文本相似度排序）
+type RelatedReq struct {
+	g.Meta `path:"/blog/articles/related" tags:"Blog" method:"get" summary:"List related articles" noAuth:"true"`
+	Id     int64 `json:"id" v:"required|min:1"`
+	Limit  int   `json:"limit" d:"5" v:"min:1|max:20"`
+}
+
+type RelatedItem struct {
+	ArticleItem
+	Score float64 `json:"score"`
+}
+
+type RelatedRes struct {
+	List []RelatedItem `json:"list"`
+}
+
+// 文章所在系列的导航（上一篇/下一篇）
+type SeriesNavReq struct {
+	g.Meta `path:"/blog/articles/series-nav" tags:"Blog" method:"get" summary:"Series navigation of a blog article" noAuth:"true"`
+	Id     int64 `json:"id" v:"required|min:1"`
+}
+
+type SeriesNavLink struct {
+	Id    int64  `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+}
+
+type SeriesNavRes struct {
+	Series   *SeriesItem    `json:"series"` // 文章不属于任何系列时为空
+	Position int            `json:"position"`
+	Total    int            `json:"total"`
+	Prev     *SeriesNavLink `json:"prev"`
+	Next     *SeriesNavLink `json:"next"`
+}
+
 // 分类管理
 type CreateCategoryReq struct {
-	g.Meta      `path:"/blog/categories" tags:"Blog" method:"post" summary:"Create a blog category"`
+	g.Meta      `path:"/blog/categories" tags:"Blog" method:"post" summary:"Create a blog category" roles:"editor"`
 	Name        string `json:"name" v:"required|length:2,100"`
-	Slug        string `json:"slug" v:"required|length:2,100"`
+	Slug        string `json:"slug" v:"length:0,100"` // 为空时根据名称生成
 	ParentId    *int64 `json:"parentId"`
-	SortOrder   int    `json:"sortOrder" d:"0"`
+	SortOrder   *int   `json:"sortOrder"` // 为空时排在同级末尾
 	Description string `json:"description"`
 }
 
@@ -213,6 +407,169 @@ type ListCategoriesRes struct {
 	List []CategoryItem `json:"list"`
 }
 
+type UpdateCategoryReq struct {
+	g.Meta      `path:"/blog/categories" tags:"Blog" method:"put" summary:"Update or move a blog category" roles:"editor"`
+	Id          int64   `json:"id" v:"required|min:1"`
+	Name        string  `json:"name" v:"length:0,100"`
+	Slug        string  `json:"slug" v:"length:0,100"`
+	Description *string `json:"description"`
+	ParentId    *int64  `json:"parentId"` // 0 表示移动到顶级，不传表示不变
+	SortOrder   *int    `json:"sortOrder"`
+	IsActive    *bool   `json:"isActive"`
+}
+
+type UpdateCategoryRes struct {
+	Updated bool `json:"updated"`
+}
+
+type DeleteCategoryReq struct {
+	g.Meta     `path:"/blog/categories" tags:"Blog" method:"delete" summary:"Delete a blog category" roles:"editor"`
+	Id         int64 `json:"id" v:"required|min:1"`
+	ReassignTo int64 `json:"reassignTo"` // 分类下有文章时必填：文章转移到的分类ID
+}
+
+type DeleteCategoryRes struct {
+	Deleted bool `json:"deleted"`
+}
+
+// 同级分类排序
+type ReorderCategoriesReq struct {
+	g.Meta   `path:"/blog/categories/reorder" tags:"Blog" method:"put" summary:"Reorder sibling blog categories" roles:"editor"`
+	ParentId int64   `json:"parentId"`         // 0 表示顶级分类
+	Ids      []int64 `json:"ids" v:"required"` // 按新顺序排列的分类ID
+}
+
+type ReorderCategoriesRes struct {
+	Reordered bool `json:"reordered"`
+}
+
+// 分类树
+type CategoryTreeReq struct {
+	g.Meta          `path:"/blog/categories/tree" tags:"Blog" method:"get" summary:"Nested blog category tree with rolled-up counts" noAuth:"true"`
+	IncludeInactive bool `json:"includeInactive"` // 是否包含停用分类（需登录）
+}
+
+type CategoryTreeNode struct {
+	CategoryItem
+	TotalArticleCount int                `json:"totalArticleCount"` // 含全部子分类的文章数
+	Children          []CategoryTreeNode `json:"children"`
+}
+
+type CategoryTreeRes struct {
+	List []CategoryTreeNode `json:"list"`
+}
+
+// 标签管理
+type ListTagsReq struct {
+	g.Meta          `path:"/blog/tags" tags:"Blog" method:"get" summary:"List blog tags" noAuth:"true"`
+	Page            int    `json:"page" d:"1"`
+	Size            int    `json:"size" d:"50" v:"max:200"`
+	Keyword         string `json:"keyword"`                         // 按名称或 slug 模糊匹配
+	Sort            string `json:"sort" d:"name" v:"in:name,count"` // 排序方式
+	IncludeInactive bool   `json:"includeInactive"`                 // 是否包含停用标签（需登录）
+}
+
+type TagDetailItem struct {
+	Id           int64  `json:"id"`
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	Description  string `json:"description"`
+	Color        string `json:"color"`
+	ArticleCount int    `json:"articleCount"`
+	IsActive     bool   `json:"isActive"`
+	CreatedAt    string `json:"createdAt"`
+	UpdatedAt    string `json:"updatedAt"`
+}
+
+type ListTagsRes struct {
+	Page  int             `json:"page"`
+	Size  int             `json:"size"`
+	Total int             `json:"total"`
+	List  []TagDetailItem `json:"list"`
+}
+
+type CreateTagReq struct {
+	g.Meta      `path:"/blog/tags" tags:"Blog" method:"post" summary:"Create a blog tag" roles:"editor"`
+	Name        string `json:"name" v:"required|length:1,50"`
+	Slug        string `json:"slug" v:"length:0,50"` // 为空时根据名称生成
+	Description string `json:"description"`
+	Color       string `json:"color"` // #RRGGBB
+}
+
+type CreateTagRes struct {
+	TagDetailItem
+}
+
+type UpdateTagReq struct {
+	g.Meta      `path:"/blog/tags" tags:"Blog" method:"put" summary:"Update a blog tag" roles:"editor"`
+	Id          int64  `json:"id" v:"required|min:1"`
+	Name        string `json:"name" v:"length:0,50"`
+	Slug        string `json:"slug" v:"length:0,50"`
+	Description string `json:"description"`
+	Color       string `json:"color"`
+	IsActive    *bool  `json:"isActive"`
+}
+
+type UpdateTagRes struct {
+	Updated bool `json:"updated"`
+}
+
+type DeleteTagReq struct {
+	g.Meta `path:"/blog/tags" tags:"Blog" method:"delete" summary:"Delete a blog tag" roles:"editor"`
+	Id     int64 `json:"id" v:"required|min:1"`
+}
+
+type DeleteTagRes struct {
+	Deleted bool `json:"deleted"`
+}
+
+// 合并标签：source 的文章关联转移到 target 后删除 source
+type MergeTagsReq struct {
+	g.Meta   `path:"/blog/tags/merge" tags:"Blog" method:"post" summary:"Merge one blog tag into another" roles:"editor"`
+	SourceId int64 `json:"sourceId" v:"required|min:1"`
+	TargetId int64 `json:"targetId" v:"required|min:1|different:SourceId"`
+}
+
+type MergeTagsRes struct {
+	Merged bool `json:"merged"`
+}
+
+// 标签下的文章列表
+type TagArticlesReq struct {
+	g.Meta `path:"/blog/tags/articles" tags:"Blog" method:"get" summary:"List published articles of a tag" noAuth:"true"`
+	Slug   string `json:"slug" v:"required"`
+	Page   int    `json:"page" d:"1"`
+	Size   int    `json:"size" d:"10"`
+}
+
+type TagArticlesRes struct {
+	Tag   TagDetailItem `json:"tag"`
+	Page  int           `json:"page"`
+	Size  int           `json:"size"`
+	Total int           `json:"total"`
+	List  []ArticleItem `json:"list"`
+}
+
+// 标签云
+type TagCloudReq struct {
+	g.Meta `path:"/blog/tags/cloud" tags:"Blog" method:"get" summary:"Weighted tag cloud" noAuth:"true"`
+	Limit  int `json:"limit" d:"50" v:"max:200"`
+	Levels int `json:"levels" d:"5" v:"min:2|max:10"` // 权重等级数
+}
+
+type TagCloudItem struct {
+	Id           int64  `json:"id"`
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	Color        string `json:"color"`
+	ArticleCount int    `json:"articleCount"`
+	Weight       int    `json:"weight"` // 1..levels
+}
+
+type TagCloudRes struct {
+	List []TagCloudItem `json:"list"`
+}
+
 // 评论管理
 type CreateCommentReq struct {
 	g.Meta         `path:"/blog/comments" tags:"Blog" method:"post" summary:"Create a blog comment" noAuth:"true"`
@@ -222,13 +579,51 @@ type CreateCommentReq struct {
 	VisitorEmail   string `json:"visitorEmail" v:"email"`               // 访客邮箱（可选）
 	VisitorWebsite string `json:"visitorWebsite"`                       // 访客网站（可选）
 	Content        string `json:"content" v:"required|length:1,1000"`   // 评论内容
+	Honeypot       string `json:"honeypot"`                             // 蜜罐字段（前端隐藏，正常用户应保持为空）
+	FormToken      string `json:"formToken"`                            // 表单令牌（通过 /blog/comments/form-token 获取）
+	PowNonce       string `json:"powNonce"`                             // 工作量证明随机数（难度为0时可不填）
 }
 
 type CreateCommentRes struct {
 	Id        int64  `json:"id"`
+	Status    string `json:"status"` // approved：已发布；pending：疑似垃圾，等待审核
 	CreatedAt string `json:"createdAt"`
 }
 
+// 获取评论表单令牌（反垃圾）
+type CommentFormTokenReq struct {
+	g.Meta    `path:"/blog/comments/form-token" tags:"Blog" method:"get" summary:"Issue a signed comment form token" noAuth:"true"`
+	ArticleId int64 `json:"articleId" v:"required|min:1"`
+}
+
+type CommentFormTokenRes struct {
+	Token      string `json:"token"`      // 签名令牌，提交评论时原样带回
+	IssuedAt   int64  `json:"issuedAt"`   // 签发时间（Unix秒）
+	ExpiresAt  int64  `json:"expiresAt"`  // 过期时间（Unix秒）
+	Difficulty int    `json:"difficulty"` // 工作量证明难度：需找到 nonce 使 sha256(token+":"+nonce) 前导零比特数不少于该值
+}
+
+// 审核评论
+type ModerateCommentReq struct {
+	g.Meta `path:"/blog/comments/moderate" tags:"Blog" method:"put" summary:"Moderate a blog comment" roles:"editor"`
+	Id     int64  `json:"id" v:"required|min:1"`
+	Status string `json:"status" v:"required|in:approved,pending,deleted"`
+}
+
+type ModerateCommentRes struct {
+	Updated bool `json:"updated"`
+}
+
+// 退订评论回复通知；同时作为 List-Unsubscribe 一键退订地址（令牌在查询参数中）
+type UnsubscribeCommentRepliesReq struct {
+	g.Meta `path:"/blog/comments/unsubscribe" tags:"Blog" method:"post" summary:"Stop reply notifications for a comment thread" noAuth:"true"`
+	Token  string `json:"token" v:"required"`
+}
+
+type UnsubscribeCommentRepliesRes struct {
+	ThreadId int64 `json:"threadId"` // 退订的评论串（顶级评论ID），0 表示全部回复通知
+}
+
 type ListCommentsReq struct {
 	g.Meta    `path:"/blog/comments" tags:"Blog" method:"get" summary:"List blog comments" noAuth:"true"`
 	ArticleId int64  `json:"articleId" v:"required|min:1"`
@@ -247,6 +642,7 @@ type CommentItem struct {
 	Content        string        `json:"content"`        // 评论内容
 	HtmlContent    string        `json:"htmlContent"`    // HTML渲染内容
 	Status         string        `json:"status"`         // 评论状态
+	SpamReasons    string        `json:"spamReasons"`    // 反垃圾命中原因（仅登录可见）
 	CreatedAt      time.Time     `json:"createdAt"`
 	Replies        []CommentItem `json:"replies"` // 回复评论列表
 }
@@ -260,7 +656,7 @@ type ListCommentsRes struct {
 
 // 删除评论
 type DeleteCommentReq struct {
-	g.Meta `path:"/blog/comments" tags:"Blog" method:"delete" summary:"Delete a blog comment"`
+	g.Meta `path:"/blog/comments" tags:"Blog" method:"delete" summary:"Delete a blog comment" roles:"editor"`
 	Id     int64 `json:"id" v:"required|min:1"`
 }
 
@@ -268,6 +664,673 @@ type DeleteCommentRes struct {
 	Deleted bool `json:"deleted"`
 }
 
+// 订阅源（RSS 2.0 / Atom 1.0 / JSON Feed 1.1）
+type FeedReq struct {
+	g.Meta   `path:"/blog/feed/{format}" tags:"Blog" method:"get" summary:"Blog syndication feed (rss/atom/json)" noAuth:"true"`
+	Format   string `json:"format" in:"path" v:"required|in:rss,atom,json"` // 订阅格式
+	Category string `json:"category"`                                       // 分类 slug，可选
+	Tag      string `json:"tag"`                                            // 标签 slug，可选
+	Lang     string `json:"lang"`                                           // 语言，可选：只包含有该语言版本的文章并输出译文
+}
+
+// FeedRes 订阅源内容直接写入响应体
+type FeedRes struct{}
+
+// 待发布的定时文章（按发布时间升序）
+type ScheduledReq struct {
+	g.Meta `path:"/blog/articles/scheduled" tags:"Blog" method:"get" summary:"List upcoming scheduled articles"`
+	Page   int `json:"page" d:"1"`
+	Size   int `json:"size" d:"10"`
+}
+
+type ScheduledRes struct {
+	Page  int           `json:"page"`
+	Size  int           `json:"size"`
+	Total int           `json:"total"`
+	List  []ArticleItem `json:"list"`
+}
+
+// 从 Hexo/Hugo Markdown 压缩包或 WordPress WXR 导出文件导入文章
+type ImportReq struct {
+	g.Meta           `path:"/blog/import" tags:"Blog" method:"post" summary:"Import articles from Hexo/Hugo Markdown or WordPress WXR" roles:"editor"`
+	File             *ghttp.UploadFile `json:"file" type:"file" v:"required#请选择要导入的文件"` // zip 压缩包或 WXR XML 文件
+	DryRun           bool              `json:"dryRun"`                                  // 仅生成报告，不写入
+	DefaultCategory  string            `json:"defaultCategory" v:"length:0,100"`        // 无分类文章归入的分类，默认“未分类”
+	SkipRemoteImages bool              `json:"skipRemoteImages"`                        // 不抓取远程图片
+}
+
+type ImportItem struct {
+	Source        string   `json:"source"`
+	Title         string   `json:"title"`
+	Slug          string   `json:"slug"`
+	Status        string   `json:"status"`
+	Category      string   `json:"category"`
+	Tags          []string `json:"tags"`
+	PublishAt     string   `json:"publishAt"`
+	Images        int      `json:"images"`
+	MissingImages []string `json:"missingImages"`
+	Action        string   `json:"action"` // create/skip/fail
+	Reason        string   `json:"reason"`
+	ArticleId     int64    `json:"articleId"`
+}
+
+type ImportRes struct {
+	Format        string       `json:"format"` // markdown/wxr
+	DryRun        bool         `json:"dryRun"`
+	Total         int          `json:"total"`
+	Created       int          `json:"created"`
+	Skipped       int          `json:"skipped"`
+	Failed        int          `json:"failed"`
+	NewCategories []string     `json:"newCategories"`
+	NewTags       []string     `json:"newTags"`
+	Warnings      []string     `json:"warnings"`
+	Items         []ImportItem `json:"items"`
+}
+
+// 导出文章为 zip 压缩包（Markdown 或静态 HTML 站点）
+type ExportReq struct {
+	g.Meta        `path:"/blog/export" tags:"Blog" method:"get" summary:"Export articles as a Markdown or static HTML zip" roles:"editor"`
+	Format        string `json:"format" d:"markdown" v:"in:markdown,html"` // markdown：带 Front Matter 的 Markdown；html：静态站点
+	IncludeDrafts bool   `json:"includeDrafts"`                            // 仅 Markdown 导出有效
+}
+
+// ExportRes 压缩包直接写入响应体
+type ExportRes struct{}
+
+// 按月归档
+type ArchiveMonthItem struct {
+	Month int `json:"month"`
+	Count int `json:"count"`
+}
+
+type ArchiveYearItem struct {
+	Year   int                `json:"year"`
+	Count  int                `json:"count"`
+	Months []ArchiveMonthItem `json:"months"` // 按时间倒序
+}
+
+type ArchiveReq struct {
+	g.Meta `path:"/blog/archive" tags:"Blog" method:"get" summary:"Year/month archive buckets of blog articles" noAuth:"true"`
+	Status string `json:"status"` // 仅登录后生效，默认 published
+}
+
+type ArchiveRes struct {
+	Total int               `json:"total"`
+	Years []ArchiveYearItem `json:"years"` // 按时间倒序
+}
+
+type ArchiveArticlesReq struct {
+	g.Meta `path:"/blog/archive/articles" tags:"Blog" method:"get" summary:"List blog articles within a month" noAuth:"true"`
+	Year   int    `json:"year" v:"required|between:1970,9999"`
+	Month  int    `json:"month" v:"required|between:1,12"`
+	Status string `json:"status"` // 仅登录后生效，默认 published
+	Page   int    `json:"page" d:"1"`
+	Size   int    `json:"size" d:"10" v:"max:100"`
+}
+
+type ArchiveArticlesRes struct {
+	Year  int           `json:"year"`
+	Month int           `json:"month"`
+	Page  int           `json:"page"`
+	Size  int           `json:"size"`
+	Total int           `json:"total"`
+	List  []ArticleItem `json:"list"`
+}
+
+// 文章版本列表
+type ArticleVersionsReq struct {
+	g.Meta    `path:"/blog/articles/versions" tags:"Blog" method:"get" summary:"List versions of a blog article"`
+	ArticleId int64 `json:"articleId" v:"required|min:1"`
+	Page      int   `json:"page" d:"1"`
+	Size      int   `json:"size" d:"20"`
+}
+
+type ArticleVersionItem struct {
+	Id            int64  `json:"id"`
+	Version       int    `json:"version"`
+	Title         string `json:"title"`
+	ChangeType    string `json:"changeType"` // create/update/delete/restore
+	ChangeSummary string `json:"changeSummary"`
+	OperatorId    int64  `json:"operatorId"`
+	Added         int    `json:"added"`   // 相比上一版本新增行数
+	Removed       int    `json:"removed"` // 相比上一版本删除行数
+	CreatedAt     string `json:"createdAt"`
+}
+
+type ArticleVersionsRes struct {
+	Page  int                  `json:"page"`
+	Size  int                  `json:"size"`
+	Total int                  `json:"total"`
+	Items []ArticleVersionItem `json:"items"`
+}
+
+// 文章版本详情
+type ArticleVersionReq struct {
+	g.Meta    `path:"/blog/articles/versions/detail" tags:"Blog" method:"get" summary:"Get a version of a blog article"`
+	ArticleId int64 `json:"articleId" v:"required|min:1"`
+	Version   int   `json:"version" v:"required|min:1"`
+}
+
+type ArticleVersionRes struct {
+	ArticleVersionItem
+	Content       string `json:"content"`
+	HtmlContent   string `json:"htmlContent"`
+	Summary       string `json:"summary"`
+	Slug          string `json:"slug"`
+	CategoryId    int64  `json:"categoryId"`
+	Status        string `json:"status"`
+	FeaturedImage string `json:"featuredImage"`
+}
+
+// 两个版本之间的行级差异
+type ArticleVersionDiffReq struct {
+	g.Meta    `path:"/blog/articles/versions/diff" tags:"Blog" method:"get" summary:"Line-level diff between two versions"`
+	ArticleId int64 `json:"articleId" v:"required|min:1"`
+	From      int   `json:"from" v:"required|min:1"` // 旧版本号
+	To        int   `json:"to" v:"required|min:1"`   // 新版本号
+}
+
+type DiffLine struct {
+	Op    string `json:"op"` // equal/insert/delete
+	Text  string `json:"text"`
+	OldNo int    `json:"oldNo,omitempty"`
+	NewNo int    `json:"newNo,omitempty"`
+}
+
+type ArticleVersionDiffRes struct {
+	From     int        `json:"from"`
+	To       int        `json:"to"`
+	TitleOld string     `json:"titleOld"`
+	TitleNew string     `json:"titleNew"`
+	Added    int        `json:"added"`
+	Removed  int        `json:"removed"`
+	Lines    []DiffLine `json:"lines"`
+}
+
+// 恢复到指定版本（作为新的版本记录）
+type RestoreArticleVersionReq struct {
+	g.Meta        `path:"/blog/articles/versions/restore" tags:"Blog" method:"post" summary:"Restore a blog article to a version"`
+	ArticleId     int64  `json:"articleId" v:"required|min:1"`
+	Version       int    `json:"version" v:"required|min:1"`
+	ChangeSummary string `json:"changeSummary" v:"length:0,500"`
+}
+
+type RestoreArticleVersionRes struct {
+	Version int `json:"version"` // 恢复后产生的新版本号
+}
+
+// 密码保护：设置或取消访问密码
+type SetArticlePasswordReq struct {
+	g.Meta   `path:"/blog/articles/password" tags:"Blog" method:"put" summary:"Set or clear the access password of an article"`
+	Id       int64  `json:"id" v:"required|min:1"`
+	Password string `json:"password" v:"length:0,64"` // 为空时取消密码保护
+}
+
+type SetArticlePasswordRes struct {
+	Protected bool `json:"protected"`
+}
+
+// 密码保护：输入密码解锁文章
+type UnlockArticleReq struct {
+	g.Meta   `path:"/blog/articles/unlock" tags:"Blog" method:"post" summary:"Unlock a password-protected article" noAuth:"true"`
+	Id       int64  `json:"id" v:"required|min:1"`
+	Password string `json:"password" v:"required|length:1,64"`
+}
+
+type UnlockArticleRes struct {
+	Token     string `json:"token"`     // 同时写入 Cookie；不便使用 Cookie 时作为 unlock 参数传给详情接口
+	ExpiresAt int64  `json:"expiresAt"` // Unix 秒
+}
+
+// 草稿预览链接
+type PreviewTokenItem struct {
+	Id         int64  `json:"id"`
+	ArticleId  int64  `json:"articleId"`
+	Note       string `json:"note"`
+	ExpiresAt  string `json:"expiresAt"`
+	RevokedAt  string `json:"revokedAt"`
+	LastUsedAt string `json:"lastUsedAt"`
+	UseCount   int    `json:"useCount"`
+	Active     bool   `json:"active"` // 未撤销且未过期
+	CreatedAt  string `json:"createdAt"`
+}
+
+type CreatePreviewTokenReq struct {
+	g.Meta    `path:"/blog/articles/preview-tokens" tags:"Blog" method:"post" summary:"Create a shareable preview link for a draft"`
+	ArticleId int64  `json:"articleId" v:"required|min:1"`
+	Ttl       int64  `json:"ttl" v:"min:0"` // 有效期（秒），0 使用默认值，最长 30 天
+	Note      string `json:"note" v:"length:0,200"`
+}
+
+type CreatePreviewTokenRes struct {
+	PreviewTokenItem
+	Token      string `json:"token"`      // 令牌明文只在创建时返回
+	PreviewUrl string `json:"previewUrl"` // 前台文章地址附带 preview 参数
+}
+
+type ListPreviewTokensReq struct {
+	g.Meta    `path:"/blog/articles/preview-tokens" tags:"Blog" method:"get" summary:"List preview links of an article"`
+	ArticleId int64 `json:"articleId" v:"required|min:1"`
+}
+
+type ListPreviewTokensRes struct {
+	Items []PreviewTokenItem `json:"items"`
+}
+
+type RevokePreviewTokenReq struct {
+	g.Meta `path:"/blog/articles/preview-tokens" tags:"Blog" method:"delete" summary:"Revoke a preview link"`
+	Id     int64 `json:"id" v:"required|min:1"`
+}
+
+type RevokePreviewTokenRes struct {
+	Revoked bool `json:"revoked"`
+}
+
+// 系列管理
+type SeriesItem struct {
+	Id           int64  `json:"id"`
+	Title        string `json:"title"`
+	Slug         string `json:"slug"`
+	Description  string `json:"description"`
+	CoverImage   string `json:"coverImage"`
+	ArticleCount int    `json:"articleCount"`
+	CreatedAt    string `json:"createdAt"`
+	UpdatedAt    string `json:"updatedAt"`
+}
+
+type ListSeriesReq struct {
+	g.Meta `path:"/blog/series" tags:"Blog" method:"get" summary:"List article series" noAuth:"true"`
+}
+
+type ListSeriesRes struct {
+	List []SeriesItem `json:"list"`
+}
+
+// 系列索引页
+type SeriesDetailReq struct {
+	g.Meta `path:"/blog/series/detail" tags:"Blog" method:"get" summary:"Get a series with its articles" noAuth:"true"`
+	Slug   string `json:"slug" v:"required|length:1,120"`
+}
+
+type SeriesDetailRes struct {
+	SeriesItem
+	Articles []ArticleItem `json:"articles"`
+}
+
+type CreateSeriesReq struct {
+	g.Meta      `path:"/blog/series" tags:"Blog" method:"post" summary:"Create an article series" roles:"editor"`
+	Title       string `json:"title" v:"required|length:1,200"`
+	Slug        string `json:"slug" v:"length:0,120"` // 为空时根据标题生成
+	Description string `json:"description"`
+	CoverImage  string `json:"coverImage" v:"length:0,500"`
+}
+
+type CreateSeriesRes struct {
+	Id   int64  `json:"id"`
+	Slug string `json:"slug"`
+}
+
+type UpdateSeriesReq struct {
+	g.Meta      `path:"/blog/series" tags:"Blog" method:"put" summary:"Update an article series" roles:"editor"`
+	Id          int64  `json:"id" v:"required|min:1"`
+	Title       string `json:"title" v:"length:0,200"`
+	Slug        string `json:"slug" v:"length:0,120"` // 为空时保持不变
+	Description string `json:"description"`
+	CoverImage  string `json:"coverImage" v:"length:0,500"`
+}
+
+type UpdateSeriesRes struct {
+	Updated bool `json:"updated"`
+}
+
+type DeleteSeriesReq struct {
+	g.Meta `path:"/blog/series" tags:"Blog" method:"delete" summary:"Delete an article series" roles:"editor"`
+	Id     int64 `json:"id" v:"required|min:1"`
+}
+
+type DeleteSeriesRes struct {
+	Deleted bool `json:"deleted"`
+}
+
+// 设置系列文章及顺序（整体替换）
+type SetSeriesArticlesReq struct {
+	g.Meta     `path:"/blog/series/articles" tags:"Blog" method:"put" summary:"Set the ordered articles of a series" roles:"editor"`
+	Id         int64   `json:"id" v:"required|min:1"`
+	ArticleIds []int64 `json:"articleIds"`
+}
+
+type SetSeriesArticlesRes struct {
+	Updated bool `json:"updated"`
+}
+
+// 邮件订阅
+type SubscriberItem struct {
+	Id            int64  `json:"id"`
+	Email         string `json:"email"`
+	Name          string `json:"name"`
+	Status        string `json:"status"`    // pending/active/unsubscribed/bounced
+	Frequency     string `json:"frequency"` // instant/daily/weekly
+	AllCategories bool   `json:"allCategories"`
+	ConfirmedAt   string `json:"confirmedAt"`
+	LastSentAt    string `json:"lastSentAt"`
+	SendCount     int    `json:"sendCount"`
+	BounceCount   int    `json:"bounceCount"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+type SubscribeReq struct {
+	g.Meta      `path:"/blog/newsletter/subscribe" tags:"Blog" method:"post" summary:"Subscribe to new posts by email" noAuth:"true"`
+	Email       string  `json:"email" v:"required|email|length:1,255"`
+	Name        string  `json:"name" v:"length:0,100"`
+	Frequency   string  `json:"frequency" v:"in:instant,daily,weekly"` // 默认 instant
+	CategoryIds []int64 `json:"categoryIds"`                           // 为空时订阅全部分类
+}
+
+type SubscribeRes struct {
+	Pending bool `json:"pending"` // 始终为 true：需点击确认邮件中的链接，已订阅的地址不会被提示
+}
+
+type ConfirmSubscriptionReq struct {
+	g.Meta `path:"/blog/newsletter/confirm" tags:"Blog" method:"post" summary:"Confirm an email subscription" noAuth:"true"`
+	Token  string `json:"token" v:"required"`
+}
+
+type ConfirmSubscriptionRes struct {
+	Email     string `json:"email"`
+	Frequency string `json:"frequency"`
+}
+
+// 退订；同时作为 List-Unsubscribe 一键退订地址（令牌在查询参数中）
+type UnsubscribeReq struct {
+	g.Meta `path:"/blog/newsletter/unsubscribe" tags:"Blog" method:"post" summary:"Unsubscribe from email notifications" noAuth:"true"`
+	Token  string `json:"token" v:"required"`
+}
+
+type UnsubscribeRes struct {
+	Unsubscribed bool `json:"unsubscribed"`
+}
+
+type NewsletterPreferencesItem struct {
+	Email         string  `json:"email"`
+	Name          string  `json:"name"`
+	Status        string  `json:"status"`
+	Frequency     string  `json:"frequency"`
+	AllCategories bool    `json:"allCategories"`
+	CategoryIds   []int64 `json:"categoryIds"`
+}
+
+type NewsletterPreferencesReq struct {
+	g.Meta `path:"/blog/newsletter/preferences" tags:"Blog" method:"get" summary:"Get subscription preferences" noAuth:"true"`
+	Token  string `json:"token" v:"required"` // 退订令牌
+}
+
+type NewsletterPreferencesRes struct {
+	NewsletterPreferencesItem
+}
+
+type UpdateNewsletterPreferencesReq struct {
+	g.Meta      `path:"/blog/newsletter/preferences" tags:"Blog" method:"put" summary:"Update subscription preferences" noAuth:"true"`
+	Token       string  `json:"token" v:"required"`
+	Name        string  `json:"name" v:"length:0,100"`
+	Frequency   string  `json:"frequency" v:"in:instant,daily,weekly"` // 为空时保持不变
+	CategoryIds []int64 `json:"categoryIds"`                           // 为空时订阅全部分类
+}
+
+type UpdateNewsletterPreferencesRes struct {
+	NewsletterPreferencesItem
+}
+
+type ListSubscribersReq struct {
+	g.Meta  `path:"/blog/newsletter/subscribers" tags:"Blog" method:"get" summary:"List newsletter subscribers" roles:"admin"`
+	Status  string `json:"status" v:"in:pending,active,unsubscribed,bounced"`
+	Keyword string `json:"keyword"` // 匹配邮箱或名称
+	Page    int    `json:"page" d:"1"`
+	Size    int    `json:"size" d:"20" v:"max:100"`
+}
+
+type ListSubscribersRes struct {
+	Page  int              `json:"page"`
+	Size  int              `json:"size"`
+	Total int              `json:"total"`
+	List  []SubscriberItem `json:"list"`
+}
+
+type DeleteSubscriberReq struct {
+	g.Meta `path:"/blog/newsletter/subscribers" tags:"Blog" method:"delete" summary:"Delete a newsletter subscriber" roles:"admin"`
+	Id     int64 `json:"id" v:"required|min:1"`
+}
+
+type DeleteSubscriberRes struct {
+	Deleted bool `json:"deleted"`
+}
+
+// 邮件发件箱
+type MailOutboxItem struct {
+	Id            int64  `json:"id"`
+	Kind          string `json:"kind"`
+	Recipient     string `json:"recipient"`
+	Subject       string `json:"subject"`
+	Status        string `json:"status"` // pending/sending/sent/failed/bounced/skipped
+	Attempts      int    `json:"attempts"`
+	NextAttemptAt string `json:"nextAttemptAt"`
+	LastError     string `json:"lastError"`
+	CreatedAt     string `json:"createdAt"`
+	SentAt        string `json:"sentAt"`
+}
+
+type ListMailOutboxReq struct {
+	g.Meta `path:"/blog/mail/outbox" tags:"Blog" method:"get" summary:"List outgoing emails" roles:"admin"`
+	Status string `json:"status" v:"in:pending,sending,sent,failed,bounced,skipped"`
+	Kind   string `json:"kind"`
+	Page   int    `json:"page" d:"1"`
+	Size   int    `json:"size" d:"20" v:"max:100"`
+}
+
+type ListMailOutboxRes struct {
+	Page  int              `json:"page"`
+	Size  int              `json:"size"`
+	Total int              `json:"total"`
+	List  []MailOutboxItem `json:"list"`
+}
+
+type RetryMailReq struct {
+	g.Meta `path:"/blog/mail/retry" tags:"Blog" method:"post" summary:"Requeue a failed or bounced email" roles:"admin"`
+	Id     int64 `json:"id" v:"required|min:1"`
+}
+
+type RetryMailRes struct {
+	Queued bool `json:"queued"`
+}
+
+// 发送测试邮件，检查 SMTP 配置
+type SendTestMailReq struct {
+	g.Meta `path:"/blog/mail/test" tags:"Blog" method:"post" summary:"Send a test email with the current SMTP settings" roles:"admin"`
+	To     string `json:"to" v:"required|email"`
+}
+
+type SendTestMailRes struct {
+	Sent bool `json:"sent"`
+}
+
+// Webmention / Pingback
+// 接收 Webmention（application/x-www-form-urlencoded），成功返回 202，来源页面异步校验
+type ReceiveWebmentionReq struct {
+	g.Meta `path:"/blog/webmention" tags:"Blog" method:"post" summary:"Webmention receiver endpoint" noAuth:"true"`
+	Source string `json:"source" p:"source"`
+	Target string `json:"target" p:"target"`
+}
+
+// ReceiveWebmentionRes 状态码与纯文本说明直接写入响应体
+type ReceiveWebmentionRes struct{}
+
+// 接收 Pingback（XML-RPC pingback.ping），响应为 XML-RPC 文档
+type PingbackReq struct {
+	g.Meta `path:"/blog/pingback" tags:"Blog" method:"post" summary:"Pingback XML-RPC endpoint" noAuth:"true"`
+}
+
+// PingbackRes XML-RPC 响应直接写入响应体
+type PingbackRes struct{}
+
+type WebmentionItem struct {
+	Id          int64  `json:"id"`
+	Source      string `json:"source"`
+	Protocol    string `json:"protocol"` // webmention/pingback
+	Type        string `json:"type"`     // mention/reply/like/repost/bookmark
+	Status      string `json:"status"`   // pending/verified/rejected/deleted/blocked
+	URL         string `json:"url"`
+	Title       string `json:"title"`
+	Content     string `json:"content"` // 纯文本摘录
+	AuthorName  string `json:"authorName"`
+	AuthorURL   string `json:"authorUrl"`
+	AuthorPhoto string `json:"authorPhoto"`
+	PublishedAt string `json:"publishedAt"`
+	LastError   string `json:"lastError,omitempty"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+// 文章收到的提及；非 verified 状态需要登录
+type ListWebmentionsReq struct {
+	g.Meta    `path:"/blog/webmentions" tags:"Blog" method:"get" summary:"List webmentions of an article" noAuth:"true"`
+	ArticleId int64  `json:"articleId" v:"required|min:1"`
+	Type      string `json:"type" v:"in:mention,reply,like,repost,bookmark"`
+	Status    string `json:"status" v:"in:pending,verified,rejected,deleted,blocked"`
+	Page      int    `json:"page" d:"1"`
+	Size      int    `json:"size" d:"50" v:"max:100"`
+}
+
+type ListWebmentionsRes struct {
+	Page   int              `json:"page"`
+	Size   int              `json:"size"`
+	Total  int              `json:"total"`
+	Counts map[string]int   `json:"counts"` // 已校验提及按类型计数
+	List   []WebmentionItem `json:"list"`
+}
+
+// 屏蔽提及
+type BlockWebmentionReq struct {
+	g.Meta `path:"/blog/webmentions" tags:"Blog" method:"delete" summary:"Block a webmention" roles:"editor"`
+	Id     int64 `json:"id" v:"required|min:1"`
+}
+
+type BlockWebmentionRes struct {
+	Blocked bool `json:"blocked"`
+}
+
+type WebmentionSendItem struct {
+	Id         int64  `json:"id"`
+	Target     string `json:"target"`
+	Endpoint   string `json:"endpoint"`
+	Protocol   string `json:"protocol"`
+	Status     string `json:"status"` // pending/sent/failed/no_endpoint
+	StatusCode int    `json:"statusCode"`
+	Attempts   int    `json:"attempts"`
+	LastError  string `json:"lastError"`
+	SentAt     string `json:"sentAt"`
+}
+
+// 文章向外发送的通知
+type ListWebmentionSendsReq struct {
+	g.Meta    `path:"/blog/webmentions/sends" tags:"Blog" method:"get" summary:"List outgoing webmentions of an article"`
+	ArticleId int64 `json:"articleId" v:"required|min:1"`
+}
+
+type ListWebmentionSendsRes struct {
+	List []WebmentionSendItem `json:"list"`
+}
+
+// 分享卡片：立即重新生成文章或微博的 Open Graph 图片（如修改站点名称、分类名称之后）
+type RegenerateShareImageReq struct {
+	g.Meta `path:"/blog/share-image/regenerate" tags:"Blog" method:"post" summary:"Regenerate the Open Graph share image of an article or weibo post"`
+	Type   string `json:"type" v:"required|in:article,weibo"`
+	Id     int64  `json:"id" v:"required|min:1"`
+}
+
+type RegenerateShareImageRes struct {
+	URL string `json:"url"` // 图片地址（/file/download/<uuid>）
+}
+
+// 作者列表（有公开内容的作者）
+type ListAuthorsReq struct {
+	g.Meta `path:"/blog/authors" tags:"Blog" method:"get" summary:"List authors with published content" noAuth:"true"`
+}
+
+type AuthorItem struct {
+	Id           int64  `json:"id"`
+	Username     string `json:"username"`
+	DisplayName  string `json:"displayName"`
+	AvatarUrl    string `json:"avatarUrl"`
+	Bio          string `json:"bio"`
+	ArticleCount int    `json:"articleCount"` // 已发布的公开文章数
+	WeiboCount   int    `json:"weiboCount"`   // 公开微博数
+}
+
+type ListAuthorsRes struct {
+	List []AuthorItem `json:"list"`
+}
+
+// 作者页：作者资料；文章与微博分别通过 /blog/articles?author= 与 /weibo/posts?author= 分页查询
+type AuthorDetailReq struct {
+	g.Meta   `path:"/blog/authors/detail" tags:"Blog" method:"get" summary:"Get an author profile by username" noAuth:"true"`
+	Username string `json:"username" v:"required"`
+}
+
+type AuthorDetailRes struct {
+	Author AuthorItem `json:"author"`
+}
+
+// 文章翻译列表（包括草稿）
+type ListTranslationsReq struct {
+	g.Meta    `path:"/blog/articles/translations" tags:"Blog" method:"get" summary:"List translations of an article"`
+	ArticleId int64 `json:"articleId" v:"required|min:1"`
+}
+
+type TranslationItem struct {
+	Id          int64     `json:"id"`
+	ArticleId   int64     `json:"articleId"`
+	Language    string    `json:"language"`
+	Title       string    `json:"title"`
+	Slug        string    `json:"slug"`
+	Summary     string    `json:"summary"`
+	Content     string    `json:"content"`
+	HtmlContent string    `json:"htmlContent"`
+	Status      string    `json:"status"`
+	SEO         SEOInput  `json:"seo"` // 显式填写的SEO数据，缺失字段在详情中按译文回退
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type ListTranslationsRes struct {
+	List []TranslationItem `json:"list"`
+}
+
+// 新建或更新文章翻译（按文章与语言唯一）
+type SaveTranslationReq struct {
+	g.Meta    `path:"/blog/articles/translations" tags:"Blog" method:"put" summary:"Create or update an article translation"`
+	ArticleId int64    `json:"articleId" v:"required|min:1"`
+	Language  string   `json:"language" v:"required|length:2,20"`       // 语言（如 en、zh-TW），不能与原文语言相同
+	Title     string   `json:"title" v:"required|length:1,255"`         // 译文标题
+	Slug      string   `json:"slug" v:"length:0,255"`                   // 译文URL标识，为空时沿用已有值或根据标题生成
+	Summary   string   `json:"summary" v:"length:0,500"`                // 译文摘要，为空时从正文生成
+	Content   string   `json:"content" v:"required"`                    // 译文 Markdown 内容
+	Status    string   `json:"status" d:"draft" v:"in:draft,published"` // 翻译状态，文章发布后已发布的翻译才对访客可见
+	SEO       SEOInput `json:"seo"`                                     // 译文SEO数据
+}
+
+type SaveTranslationRes struct {
+	TranslationItem
+}
+
+// 删除文章翻译
+type DeleteTranslationReq struct {
+	g.Meta    `path:"/blog/articles/translations" tags:"Blog" method:"delete" summary:"Delete an article translation"`
+	ArticleId int64  `json:"articleId" v:"required|min:1"`
+	Language  string `json:"language" v:"required"`
+}
+
+type DeleteTranslationRes struct {
+	Deleted bool `json:"deleted"`
+}
+
 // IBlogV1 接口声明（用于 gf gen ctrl 生成控制器）
 type IBlogV1 interface {
 	// 文章管理
@@ -276,13 +1339,100 @@ type IBlogV1 interface {
 	List(ctx g.Ctx, req *ListReq) (res *ListRes, err error)
 	Detail(ctx g.Ctx, req *DetailReq) (res *DetailRes, err error)
 	Delete(ctx g.Ctx, req *DeleteReq) (res *DeleteRes, err error)
+	Like(ctx g.Ctx, req *LikeReq) (res *LikeRes, err error)
+	Unlike(ctx g.Ctx, req *UnlikeReq) (res *UnlikeRes, err error)
+	Share(ctx g.Ctx, req *ShareReq) (res *ShareRes, err error)
+	Trash(ctx g.Ctx, req *TrashReq) (res *TrashRes, err error)
+	Restore(ctx g.Ctx, req *RestoreReq) (res *RestoreRes, err error)
+	Purge(ctx g.Ctx, req *PurgeReq) (res *PurgeRes, err error)
+	Search(ctx g.Ctx, req *SearchReq) (res *SearchRes, err error)
+	Feed(ctx g.Ctx, req *FeedReq) (res *FeedRes, err error)
+	Scheduled(ctx g.Ctx, req *ScheduledReq) (res *ScheduledRes, err error)
+	Import(ctx g.Ctx, req *ImportReq) (res *ImportRes, err error)
+	Export(ctx g.Ctx, req *ExportReq) (res *ExportRes, err error)
+	Related(ctx g.Ctx, req *RelatedReq) (res *RelatedRes, err error)
+	SeriesNav(ctx g.Ctx, req *SeriesNavReq) (res *SeriesNavRes, err error)
+	Archive(ctx g.Ctx, req *ArchiveReq) (res *ArchiveRes, err error)
+	ArchiveArticles(ctx g.Ctx, req *ArchiveArticlesReq) (res *ArchiveArticlesRes, err error)
+
+	// 版本历史
+	ArticleVersions(ctx g.Ctx, req *ArticleVersionsReq) (res *ArticleVersionsRes, err error)
+	ArticleVersion(ctx g.Ctx, req *ArticleVersionReq) (res *ArticleVersionRes, err error)
+	ArticleVersionDiff(ctx g.Ctx, req *ArticleVersionDiffReq) (res *ArticleVersionDiffRes, err error)
+	RestoreArticleVersion(ctx g.Ctx, req *RestoreArticleVersionReq) (res *RestoreArticleVersionRes, err error)
+
+	// 访问控制
+	SetArticlePassword(ctx g.Ctx, req *SetArticlePasswordReq) (res *SetArticlePasswordRes, err error)
+	UnlockArticle(ctx g.Ctx, req *UnlockArticleReq) (res *UnlockArticleRes, err error)
+
+	// 草稿预览链接
+	CreatePreviewToken(ctx g.Ctx, req *CreatePreviewTokenReq) (res *CreatePreviewTokenRes, err error)
+	ListPreviewTokens(ctx g.Ctx, req *ListPreviewTokensReq) (res *ListPreviewTokensRes, err error)
+	RevokePreviewToken(ctx g.Ctx, req *RevokePreviewTokenReq) (res *RevokePreviewTokenRes, err error)
 
 	// 分类管理
 	CreateCategory(ctx g.Ctx, req *CreateCategoryReq) (res *CreateCategoryRes, err error)
 	ListCategories(ctx g.Ctx, req *ListCategoriesReq) (res *ListCategoriesRes, err error)
+	UpdateCategory(ctx g.Ctx, req *UpdateCategoryReq) (res *UpdateCategoryRes, err error)
+	DeleteCategory(ctx g.Ctx, req *DeleteCategoryReq) (res *DeleteCategoryRes, err error)
+	ReorderCategories(ctx g.Ctx, req *ReorderCategoriesReq) (res *ReorderCategoriesRes, err error)
+	CategoryTree(ctx g.Ctx, req *CategoryTreeReq) (res *CategoryTreeRes, err error)
+
+	// 标签管理
+	ListTags(ctx g.Ctx, req *ListTagsReq) (res *ListTagsRes, err error)
+	CreateTag(ctx g.Ctx, req *CreateTagReq) (res *CreateTagRes, err error)
+	UpdateTag(ctx g.Ctx, req *UpdateTagReq) (res *UpdateTagRes, err error)
+	DeleteTag(ctx g.Ctx, req *DeleteTagReq) (res *DeleteTagRes, err error)
+	MergeTags(ctx g.Ctx, req *MergeTagsReq) (res *MergeTagsRes, err error)
+	TagArticles(ctx g.Ctx, req *TagArticlesReq) (res *TagArticlesRes, err error)
+	TagCloud(ctx g.Ctx, req *TagCloudReq) (res *TagCloudRes, err error)
+
+	// 系列管理
+	ListSeries(ctx g.Ctx, req *ListSeriesReq) (res *ListSeriesRes, err error)
+	SeriesDetail(ctx g.Ctx, req *SeriesDetailReq) (res *SeriesDetailRes, err error)
+	CreateSeries(ctx g.Ctx, req *CreateSeriesReq) (res *CreateSeriesRes, err error)
+	UpdateSeries(ctx g.Ctx, req *UpdateSeriesReq) (res *UpdateSeriesRes, err error)
+	DeleteSeries(ctx g.Ctx, req *DeleteSeriesReq) (res *DeleteSeriesRes, err error)
+	SetSeriesArticles(ctx g.Ctx, req *SetSeriesArticlesReq) (res *SetSeriesArticlesRes, err error)
 
 	// 评论管理
 	CreateComment(ctx g.Ctx, req *CreateCommentReq) (res *CreateCommentRes, err error)
 	ListComments(ctx g.Ctx, req *ListCommentsReq) (res *ListCommentsRes, err error)
 	DeleteComment(ctx g.Ctx, req *DeleteCommentReq) (res *DeleteCommentRes, err error)
+	CommentFormToken(ctx g.Ctx, req *CommentFormTokenReq) (res *CommentFormTokenRes, err error)
+	ModerateComment(ctx g.Ctx, req *ModerateCommentReq) (res *ModerateCommentRes, err error)
+	UnsubscribeCommentReplies(ctx g.Ctx, req *UnsubscribeCommentRepliesReq) (res *UnsubscribeCommentRepliesRes, err error)
+
+	// 邮件订阅
+	Subscribe(ctx g.Ctx, req *SubscribeReq) (res *SubscribeRes, err error)
+	ConfirmSubscription(ctx g.Ctx, req *ConfirmSubscriptionReq) (res *ConfirmSubscriptionRes, err error)
+	Unsubscribe(ctx g.Ctx, req *UnsubscribeReq) (res *UnsubscribeRes, err error)
+	NewsletterPreferences(ctx g.Ctx, req *NewsletterPreferencesReq) (res *NewsletterPreferencesRes, err error)
+	UpdateNewsletterPreferences(ctx g.Ctx, req *UpdateNewsletterPreferencesReq) (res *UpdateNewsletterPreferencesRes, err error)
+	ListSubscribers(ctx g.Ctx, req *ListSubscribersReq) (res *ListSubscribersRes, err error)
+	DeleteSubscriber(ctx g.Ctx, req *DeleteSubscriberReq) (res *DeleteSubscriberRes, err error)
+
+	// 邮件发件箱
+	ListMailOutbox(ctx g.Ctx, req *ListMailOutboxReq) (res *ListMailOutboxRes, err error)
+	RetryMail(ctx g.Ctx, req *RetryMailReq) (res *RetryMailRes, err error)
+	SendTestMail(ctx g.Ctx, req *SendTestMailReq) (res *SendTestMailRes, err error)
+
+	// Webmention / Pingback
+	ReceiveWebmention(ctx g.Ctx, req *ReceiveWebmentionReq) (res *ReceiveWebmentionRes, err error)
+	Pingback(ctx g.Ctx, req *PingbackReq) (res *PingbackRes, err error)
+	ListWebmentions(ctx g.Ctx, req *ListWebmentionsReq) (res *ListWebmentionsRes, err error)
+	BlockWebmention(ctx g.Ctx, req *BlockWebmentionReq) (res *BlockWebmentionRes, err error)
+	ListWebmentionSends(ctx g.Ctx, req *ListWebmentionSendsReq) (res *ListWebmentionSendsRes, err error)
+
+	// 分享卡片
+	RegenerateShareImage(ctx g.Ctx, req *RegenerateShareImageReq) (res *RegenerateShareImageRes, err error)
+
+	// 作者
+	ListAuthors(ctx g.Ctx, req *ListAuthorsReq) (res *ListAuthorsRes, err error)
+	AuthorDetail(ctx g.Ctx, req *AuthorDetailReq) (res *AuthorDetailRes, err error)
+
+	// 翻译
+	ListTranslations(ctx g.Ctx, req *ListTranslationsReq) (res *ListTranslationsRes, err error)
+	SaveTranslation(ctx g.Ctx, req *SaveTranslationReq) (res *SaveTranslationRes, err error)
+	DeleteTranslation(ctx g.Ctx, req *DeleteTranslationReq) (res *DeleteTranslationRes, err error)
 }
@@ -6,7 +6,7 @@ import (
 
 // 列表查询
 type ListReq struct {
-	g.Meta    `path:"/config/list" tags:"Config" method:"get" summary:"List dynamic configs with filters"`
+	g.Meta    `path:"/config/list" tags:"Config" method:"get" summary:"List dynamic configs with filters" roles:"admin"`
 	Namespace string `json:"namespace" dc:"命名空间，可选"`
 	Env       string `json:"env" dc:"环境，可选"`
 	KeyLike   string `json:"key_like" dc:"Key 模糊查询，可选"`
@@ -35,7 +35,7 @@ type ListRes struct {
 
 // 单项查询
 type ItemReq struct {
-	g.Meta    `path:"/config/item" tags:"Config" method:"get" summary:"Get config item by unique key"`
+	g.Meta    `path:"/config/item" tags:"Config" method:"get" summary:"Get config item by unique key" roles:"admin"`
 	Namespace string `json:"namespace" v:"required"`
 	Env       string `json:"env" v:"required"`
 	Key       string `json:"key" v:"required"`
@@ -47,7 +47,7 @@ type ItemRes struct {
 
 // 创建
 type CreateReq struct {
-	g.Meta       `path:"/config/create" tags:"Config" method:"post" summary:"Create a dynamic config"`
+	g.Meta       `path:"/config/create" tags:"Config" method:"post" summary:"Create a dynamic config" roles:"admin"`
 	Namespace    string      `json:"namespace" v:"required"`
 	Env          string      `json:"env" v:"required"`
 	Key          string      `json:"key" v:"required"`
@@ -64,7 +64,7 @@ type CreateRes struct {
 
 // 更新
 type UpdateReq struct {
-	g.Meta       `path:"/config/update" tags:"Config" method:"put" summary:"Update a dynamic config"`
+	g.Meta       `path:"/config/update" tags:"Config" method:"put" summary:"Update a dynamic config" roles:"admin"`
 	Namespace    string      `json:"namespace" v:"required"`
 	Env          string      `json:"env" v:"required"`
 	Key          string      `json:"key" v:"required"`
@@ -82,7 +82,7 @@ type UpdateRes struct {
 
 // 删除
 type DeleteReq struct {
-	g.Meta       `path:"/config/delete" tags:"Config" method:"delete" summary:"Delete a dynamic config"`
+	g.Meta       `path:"/config/delete" tags:"Config" method:"delete" summary:"Delete a dynamic config" roles:"admin"`
 	Namespace    string `json:"namespace" v:"required"`
 	Env          string `json:"env" v:"required"`
 	Key          string `json:"key" v:"required"`
@@ -96,7 +96,7 @@ type DeleteRes struct {
 
 // 历史版本
 type VersionsReq struct {
-	g.Meta    `path:"/config/versions" tags:"Config" method:"get" summary:"List versions of a config item"`
+	g.Meta    `path:"/config/versions" tags:"Config" method:"get" summary:"List versions of a config item" roles:"admin"`
 	Namespace string `json:"namespace" v:"required"`
 	Env       string `json:"env" v:"required"`
 	Key       string `json:"key" v:"required"`
@@ -119,7 +119,7 @@ type VersionsRes struct {
 
 // 回滚
 type RollbackReq struct {
-	g.Meta       `path:"/config/rollback" tags:"Config" method:"post" summary:"Rollback a config item to specific version"`
+	g.Meta       `path:"/config/rollback" tags:"Config" method:"post" summary:"Rollback a config item to specific version" roles:"admin"`
 	Namespace    string `json:"namespace" v:"required"`
 	Env          string `json:"env" v:"required"`
 	Key          string `json:"key" v:"required"`
@@ -133,7 +133,7 @@ type RollbackRes struct {
 
 // 导入/导出
 type ImportReq struct {
-	g.Meta       `path:"/config/import" tags:"Config" method:"post" summary:"Import configs in batch"`
+	g.Meta       `path:"/config/import" tags:"Config" method:"post" summary:"Import configs in batch" roles:"admin"`
 	Items        []ConfigItem `json:"items" v:"required|length:1,10000"`
 	ChangeReason string       `json:"change_reason" v:"required"`
 }
@@ -145,7 +145,7 @@ type ImportRes struct {
 }
 
 type ExportReq struct {
-	g.Meta    `path:"/config/export" tags:"Config" method:"get" summary:"Export configs"`
+	g.Meta    `path:"/config/export" tags:"Config" method:"get" summary:"Export configs" roles:"admin"`
 	Namespace string `json:"namespace"`
 	Env       string `json:"env"`
 	Enabled   *bool  `json:"enabled"`
@@ -157,7 +157,7 @@ type ExportRes struct {
 
 // 刷新缓存
 type RefreshReq struct {
-	g.Meta `path:"/config/refresh" tags:"Config" method:"post" summary:"Rebuild config cache"`
+	g.Meta `path:"/config/refresh" tags:"Config" method:"post" summary:"Rebuild config cache" roles:"admin"`
 	Reason string `json:"reason"`
 }
 
@@ -169,7 +169,7 @@ type RefreshRes struct {
 
 // 缓存统计
 type StatsReq struct {
-	g.Meta `path:"/config/stats" tags:"Config" method:"get" summary:"Get config cache stats"`
+	g.Meta `path:"/config/stats" tags:"Config" method:"get" summary:"Get config cache stats" roles:"admin"`
 }
 
 type StatsRes struct {
@@ -112,7 +112,7 @@ type GetFileInfoRes struct {
 
 // GetFileListReq 获取文件列表请求结构
 type GetFileListReq struct {
-	g.Meta          `path:"/file/list" tags:"File" method:"get" summary:"Get file list with pagination and filters"`
+	g.Meta          `path:"/file/list" tags:"File" method:"get" summary:"Get file list with pagination and filters" roles:"editor"`
 	Page            int    `json:"page" d:"1" dc:"页码（从1开始）"`
 	PageSize        int    `json:"page_size" d:"20" dc:"每页数量（最大100）"`
 	Category        string `json:"category" dc:"文件分类筛选"`
@@ -157,7 +157,7 @@ type GetFileListRes struct {
 
 // DeleteFileReq 删除文件请求结构
 type DeleteFileReq struct {
-	g.Meta   `path:"/file/delete/{file_uuid}" tags:"File" method:"delete" summary:"Delete file by UUID"`
+	g.Meta   `path:"/file/delete/{file_uuid}" tags:"File" method:"delete" summary:"Delete file by UUID" roles:"editor"`
 	FileUuid string `json:"file_uuid" v:"required#文件UUID不能为空" dc:"文件唯一标识符"`
 }
 
@@ -169,7 +169,7 @@ type DeleteFileRes struct {
 
 // RestoreFileReq 恢复文件请求结构
 type RestoreFileReq struct {
-	g.Meta   `path:"/file/restore/{file_uuid}" tags:"File" method:"post" summary:"Restore deleted file by UUID"`
+	g.Meta   `path:"/file/restore/{file_uuid}" tags:"File" method:"post" summary:"Restore deleted file by UUID" roles:"editor"`
 	FileUuid string `json:"file_uuid" v:"required#文件UUID不能为空" dc:"文件唯一标识符"`
 }
 
@@ -264,7 +264,7 @@ type UploadFileForWeiboRes struct {
 
 // CleanupFilesReq 清理文件请求结构
 type CleanupFilesReq struct {
-	g.Meta `path:"/file/cleanup" tags:"File" method:"post" summary:"Cleanup deleted files"`
+	g.Meta `path:"/file/cleanup" tags:"File" method:"post" summary:"Cleanup deleted files" roles:"admin"`
 }
 
 // DeletedFileInfo 已删除文件信息结构
@@ -291,7 +291,7 @@ type CleanupFilesRes struct {
 
 // GetCleanupStatusReq 获取清理状态请求结构
 type GetCleanupStatusReq struct {
-	g.Meta `path:"/file/cleanup/status" tags:"File" method:"get" summary:"Get file cleanup status"`
+	g.Meta `path:"/file/cleanup/status" tags:"File" method:"get" summary:"Get file cleanup status" roles:"admin"`
 }
 
 // GetCleanupStatusRes 获取清理状态响应结构
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"github.com/gogf/gf/v2/frame/g"
+)
+
+// 根站点地图（URL 较多时为站点地图索引）
+type SitemapReq struct {
+	g.Meta `path:"/sitemap.xml" tags:"SEO" method:"get" summary:"Sitemap or sitemap index" noAuth:"true"`
+}
+
+// SitemapRes 内容直接写入响应体
+type SitemapRes struct{}
+
+// 分片站点地图
+type SitemapPartReq struct {
+	g.Meta `path:"/sitemaps/{name}.xml" tags:"SEO" method:"get" summary:"Sitemap part, e.g. articles-1" noAuth:"true"`
+	Name   string `json:"name" in:"path" v:"required|length:1,64"` // 分区名-页码
+}
+
+// SitemapPartRes 内容直接写入响应体
+type SitemapPartRes struct{}
+
+// robots.txt
+type RobotsReq struct {
+	g.Meta `path:"/robots.txt" tags:"SEO" method:"get" summary:"robots.txt" noAuth:"true"`
+}
+
+// RobotsRes 内容直接写入响应体
+type RobotsRes struct{}
@@ -55,6 +55,9 @@ type ListReq struct {
 	Page       int    `json:"page" d:"1"`
 	Size       int    `json:"size" d:"10"`
 	Visibility string `json:"visibility" v:"in:public,private"`
+	AuthorId   int64  `json:"authorId" dc:"按作者ID过滤"`
+	Author     string `json:"author" dc:"按作者用户名过滤"`
+	Mention    string `json:"mention" dc:"按被提及的用户名过滤"`
 }
 
 type AssetItem struct {
@@ -62,16 +65,37 @@ type AssetItem struct {
 	Kind   string `json:"kind"`
 }
 
+// 作者简要信息
+type AuthorBrief struct {
+	Id          int64  `json:"id"`
+	Username    string `json:"username"`
+	DisplayName string `json:"displayName"`
+	AvatarUrl   string `json:"avatarUrl"`
+}
+
+// 正文实体（话题、@提及），偏移量按 Unicode 码点计算，区间左闭右开
+type ContentEntity struct {
+	Type     string `json:"type"`               // hashtag 话题，mention 提及
+	Start    int    `json:"start"`              // 起始偏移
+	End      int    `json:"end"`                // 结束偏移（不含）
+	Text     string `json:"text"`               // 原文片段，包含 # 或 @
+	Topic    string `json:"topic,omitempty"`    // 话题名称，用于 /weibo/topics/posts?name=
+	Username string `json:"username,omitempty"` // 被提及的用户名
+	UserId   int64  `json:"userId,omitempty"`   // 被提及的用户ID
+}
+
 type WeiboItem struct {
-	Id         int64       `json:"id"`
-	Content    string      `json:"content"`
-	Visibility string      `json:"visibility"`
-	CreatedAt  string      `json:"createdAt"`
-	City       string      `json:"city"`
-	Lat        *float64    `json:"lat"`
-	Lng        *float64    `json:"lng"`
-	Device     string      `json:"device"`
-	Assets     []AssetItem `json:"assets"`
+	Id         int64           `json:"id"`
+	Content    string          `json:"content"`
+	Visibility string          `json:"visibility"`
+	CreatedAt  string          `json:"createdAt"`
+	City       string          `json:"city"`
+	Lat        *float64        `json:"lat"`
+	Lng        *float64        `json:"lng"`
+	Device     string          `json:"device"`
+	Assets     []AssetItem     `json:"assets"`
+	Author     *AuthorBrief    `json:"author"`
+	Entities   []ContentEntity `json:"entities"` // 正文中的话题与提及
 }
 
 type ListRes struct {
@@ -88,16 +112,19 @@ type DetailReq struct {
 }
 
 type DetailRes struct {
-	Id         int64       `json:"id"`
-	Content    string      `json:"content"`
-	Visibility string      `json:"visibility"`
-	CreatedAt  string      `json:"createdAt"`
-	UpdatedAt  string      `json:"updatedAt"`
-	City       string      `json:"city"`
-	Lat        *float64    `json:"lat"`
-	Lng        *float64    `json:"lng"`
-	Device     string      `json:"device"`
-	Assets     []AssetItem `json:"assets"`
+	Id         int64           `json:"id"`
+	Content    string          `json:"content"`
+	Visibility string          `json:"visibility"`
+	CreatedAt  string          `json:"createdAt"`
+	UpdatedAt  string          `json:"updatedAt"`
+	City       string          `json:"city"`
+	Lat        *float64        `json:"lat"`
+	Lng        *float64        `json:"lng"`
+	Device     string          `json:"device"`
+	Assets     []AssetItem     `json:"assets"`
+	Author     *AuthorBrief    `json:"author"`
+	Entities   []ContentEntity `json:"entities"`          // 正文中的话题与提及
+	OgImage    string          `json:"ogImage,omitempty"` // 自动生成的分享卡片（开启 og_image_weibo_enabled 且微博不带图片时）
 }
 
 // 快照列表
@@ -147,6 +174,105 @@ type DeleteRes struct {
 	Ok bool `json:"ok"`
 }
 
+// 按月归档
+type ArchiveMonthItem struct {
+	Month int `json:"month"`
+	Count int `json:"count"`
+}
+
+type ArchiveYearItem struct {
+	Year   int                `json:"year"`
+	Count  int                `json:"count"`
+	Months []ArchiveMonthItem `json:"months"` // 按时间倒序
+}
+
+type ArchiveReq struct {
+	g.Meta     `path:"/weibo/archive" tags:"Weibo" method:"get" summary:"Year/month archive buckets of weibo posts" noAuth:"true"`
+	Visibility string `json:"visibility" v:"in:public,private"` // 仅登录后生效，匿名访客只统计公开微博
+}
+
+type ArchiveRes struct {
+	Total int               `json:"total"`
+	Years []ArchiveYearItem `json:"years"` // 按时间倒序
+}
+
+type ArchivePostsReq struct {
+	g.Meta     `path:"/weibo/archive/posts" tags:"Weibo" method:"get" summary:"List weibo posts within a month" noAuth:"true"`
+	Year       int    `json:"year" v:"required|between:1970,9999"`
+	Month      int    `json:"month" v:"required|between:1,12"`
+	Visibility string `json:"visibility" v:"in:public,private"` // 仅登录后生效
+	Page       int    `json:"page" d:"1"`
+	Size       int    `json:"size" d:"10" v:"max:100"`
+}
+
+type ArchivePostsRes struct {
+	Year  int         `json:"year"`
+	Month int         `json:"month"`
+	Page  int         `json:"page"`
+	Size  int         `json:"size"`
+	Total int         `json:"total"`
+	List  []WeiboItem `json:"list"`
+}
+
+// 话题
+type TopicItem struct {
+	Id         int64  `json:"id"`
+	Name       string `json:"name"`
+	PostCount  int    `json:"postCount"`  // 可见微博数（匿名访客只统计公开微博）
+	LastPostAt string `json:"lastPostAt"` // 最近一条微博的时间
+}
+
+// 话题列表（按微博数倒序）
+type TopicsReq struct {
+	g.Meta  `path:"/weibo/topics" tags:"Weibo" method:"get" summary:"List weibo topics with post counts" noAuth:"true"`
+	Keyword string `json:"keyword" dc:"按话题名称包含匹配"`
+	Page    int    `json:"page" d:"1"`
+	Size    int    `json:"size" d:"20" v:"max:100"`
+}
+
+type TopicsRes struct {
+	Page  int         `json:"page"`
+	Size  int         `json:"size"`
+	Total int         `json:"total"`
+	List  []TopicItem `json:"list"`
+}
+
+// 话题下的微博
+type TopicPostsReq struct {
+	g.Meta `path:"/weibo/topics/posts" tags:"Weibo" method:"get" summary:"List weibo posts of a topic" noAuth:"true"`
+	Name   string `json:"name" v:"required|length:1,100" dc:"话题名称，大小写不敏感"`
+	Page   int    `json:"page" d:"1"`
+	Size   int    `json:"size" d:"10" v:"max:100"`
+}
+
+type TopicPostsRes struct {
+	Topic TopicItem   `json:"topic"`
+	Page  int         `json:"page"`
+	Size  int         `json:"size"`
+	Total int         `json:"total"`
+	List  []WeiboItem `json:"list"`
+}
+
+// 热门话题（最近一段时间内被最多微博使用）
+type TrendingTopicsReq struct {
+	g.Meta `path:"/weibo/topics/trending" tags:"Weibo" method:"get" summary:"Trending weibo topics over a sliding window" noAuth:"true"`
+	Hours  int `json:"hours" v:"min:0" dc:"统计最近多少小时，为0时使用配置 weibo_trending_hours"`
+	Limit  int `json:"limit" v:"between:0,100" dc:"返回数量，为0时使用配置 weibo_trending_limit"`
+}
+
+type TrendingTopicsRes struct {
+	List []TopicItem `json:"list"`
+}
+
+// 重新解析全部微博的话题与提及（迁移后或解析规则变化时使用）
+type ReindexTopicsReq struct {
+	g.Meta `path:"/weibo/topics/reindex" tags:"Weibo" method:"post" summary:"Re-parse hashtags and mentions of all weibo posts" roles:"admin"`
+}
+
+type ReindexTopicsRes struct {
+	Posts int `json:"posts"` // 处理的微博数
+}
+
 // IWeiboV1 接口声明（用于 gf gen ctrl 生成控制器）
 type IWeiboV1 interface {
 	Create(ctx g.Ctx, req *CreateReq) (res *CreateRes, err error)
@@ -156,4 +282,10 @@ type IWeiboV1 interface {
 	Snapshots(ctx g.Ctx, req *SnapshotsReq) (res *SnapshotsRes, err error)
 	Snapshot(ctx g.Ctx, req *SnapshotReq) (res *SnapshotRes, err error)
 	Delete(ctx g.Ctx, req *DeleteReq) (res *DeleteRes, err error)
+	Archive(ctx g.Ctx, req *ArchiveReq) (res *ArchiveRes, err error)
+	ArchivePosts(ctx g.Ctx, req *ArchivePostsReq) (res *ArchivePostsRes, err error)
+	Topics(ctx g.Ctx, req *TopicsReq) (res *TopicsRes, err error)
+	TopicPosts(ctx g.Ctx, req *TopicPostsReq) (res *TopicPostsRes, err error)
+	TrendingTopics(ctx g.Ctx, req *TrendingTopicsReq) (res *TrendingTopicsRes, err error)
+	ReindexTopics(ctx g.Ctx, req *ReindexTopicsReq) (res *ReindexTopicsRes, err error)
 }
@@ -18,4 +18,10 @@ type IWeiboV1 interface {
 	Snapshots(ctx context.Context, req *v1.SnapshotsReq) (res *v1.SnapshotsRes, err error)
 	Snapshot(ctx context.Context, req *v1.SnapshotReq) (res *v1.SnapshotRes, err error)
 	Delete(ctx context.Context, req *v1.DeleteReq) (res *v1.DeleteRes, err error)
+	Archive(ctx context.Context, req *v1.ArchiveReq) (res *v1.ArchiveRes, err error)
+	ArchivePosts(ctx context.Context, req *v1.ArchivePostsReq) (res *v1.ArchivePostsRes, err error)
+	Topics(ctx context.Context, req *v1.TopicsReq) (res *v1.TopicsRes, err error)
+	TopicPosts(ctx context.Context, req *v1.TopicPostsReq) (res *v1.TopicPostsRes, err error)
+	TrendingTopics(ctx context.Context, req *v1.TrendingTopicsReq) (res *v1.TrendingTopicsRes, err error)
+	ReindexTopics(ctx context.Context, req *v1.ReindexTopicsReq) (res *v1.ReindexTopicsRes, err error)
 }
@@ -50,6 +50,104 @@ INSERT INTO dynamic_configs (namespace, env, key, type, value, enabled, descript
 
 ON CONFLICT (namespace, env, key) DO NOTHING;
 
+-- 6. 博客配置 (blog namespace)
+INSERT INTO dynamic_configs (namespace, env, key, type, value, enabled, description, updated_by) VALUES
+-- 请求来源
+('blog', 'default', 'trusted_proxies', 'string', '', true, '可信反向代理的 IP 或 CIDR（逗号分隔），只有来自这些地址的请求才采用 X-Forwarded-For 中的客户端地址', 'system'),
+-- 评论反垃圾配置
+('blog', 'default', 'comment_rate_ip_per_minute', 'number', '3', true, '同一IP每分钟最多评论数', 'system'),
+('blog', 'default', 'comment_rate_ip_per_hour', 'number', '20', true, '同一IP每小时最多评论数', 'system'),
+('blog', 'default', 'comment_rate_email_per_hour', 'number', '10', true, '同一邮箱每小时最多评论数', 'system'),
+('blog', 'default', 'comment_max_links', 'number', '2', true, '评论允许的最大链接数，超出进入待审核', 'system'),
+('blog', 'default', 'comment_keyword_blocklist', 'json', '[]', true, '评论关键词黑名单，命中进入待审核', 'system'),
+('blog', 'default', 'comment_form_token_required', 'boolean', 'true', true, '提交评论是否要求表单令牌', 'system'),
+('blog', 'default', 'comment_form_min_seconds', 'number', '3', true, '表单令牌签发后最少等待秒数', 'system'),
+('blog', 'default', 'comment_form_ttl_seconds', 'number', '86400', true, '表单令牌有效期（秒）', 'system'),
+('blog', 'default', 'comment_pow_difficulty', 'number', '0', true, '评论工作量证明难度（前导零比特数，0为关闭）', 'system'),
+('blog', 'default', 'akismet_enabled', 'boolean', 'false', true, '是否启用Akismet兼容的外部垃圾检查', 'system'),
+('blog', 'default', 'akismet_api_key', 'string', '""', true, 'Akismet API Key', 'system'),
+('blog', 'default', 'akismet_endpoint', 'string', '"https://rest.akismet.com"', true, 'Akismet兼容服务地址（可指向本地桩服务）', 'system'),
+('blog', 'default', 'akismet_blog_url', 'string', '"http://localhost:53000"', true, '提交给Akismet的站点地址', 'system'),
+-- 站点信息与订阅源
+('blog', 'default', 'site_title', 'string', '"JieCool"', true, '站点标题（订阅源、站点地图等使用）', 'system'),
+('blog', 'default', 'site_description', 'string', '"JieCool 博客"', true, '站点描述', 'system'),
+('blog', 'default', 'site_url', 'string', '"http://localhost:53000"', true, '前端站点根地址，用于生成文章链接', 'system'),
+('blog', 'default', 'api_url', 'string', '"http://localhost:8080"', true, '后端API根地址，用于生成订阅源自身链接', 'system'),
+('blog', 'default', 'site_author', 'string', '"JieCool"', true, '默认作者名', 'system'),
+('blog', 'default', 'site_language', 'string', '"zh-CN"', true, '站点语言', 'system'),
+('blog', 'default', 'feed_item_count', 'number', '20', true, '订阅源输出的文章数量（1-100）', 'system'),
+-- 站点地图与 robots.txt
+('blog', 'default', 'sitemap_max_urls', 'number', '5000', true, '单个站点地图文件的URL上限，超出时输出站点地图索引', 'system'),
+('blog', 'default', 'sitemap_cache_seconds', 'number', '3600', true, '站点地图与robots.txt缓存时间（秒），内容变更时自动失效', 'system'),
+('blog', 'default', 'robots_txt', 'string', '""', true, '自定义robots.txt全文，为空时按robots_disallow生成', 'system'),
+('blog', 'default', 'robots_disallow', 'json', '["/admin", "/login", "/blog/edit/", "/blog/create", "/weibo/new", "/file-management", "/test"]', true, 'robots.txt 默认禁止抓取的路径', 'system'),
+-- 定时发布
+('blog', 'default', 'scheduled_publish_interval_seconds', 'number', '60', true, '定时发布检查间隔（秒，最小5）', 'system'),
+-- 回收站
+('blog', 'default', 'trash_retention_days', 'number', '30', true, '回收站文章保留天数，过期后永久删除', 'system'),
+-- 浏览、点赞、分享计数
+('blog', 'default', 'view_dedup_window_minutes', 'number', '30', true, '同一访客重复浏览、分享的去重窗口（分钟）', 'system'),
+('blog', 'default', 'counter_flush_interval_seconds', 'number', '10', true, '计数增量批量写入数据库的间隔（秒）', 'system'),
+('blog', 'default', 'related_cache_seconds', 'number', '600', true, '相关文章与系列导航缓存时长（秒），内容变更时立即失效', 'system'),
+-- 草稿预览链接
+('blog', 'default', 'preview_token_ttl_hours', 'number', '72', true, '草稿预览链接默认有效期（小时），最长 30 天', 'system'),
+-- 密码保护文章
+('blog', 'default', 'article_unlock_ttl_minutes', 'number', '120', true, '输入密码解锁文章后的有效期（分钟）', 'system'),
+('blog', 'default', 'article_unlock_attempts_per_hour', 'number', '10', true, '同一IP对同一文章每小时最多尝试密码次数', 'system'),
+('blog', 'default', 'article_unlock_attempts_per_article_per_hour', 'number', '100', true, '同一文章每小时最多尝试密码次数（不区分IP），0表示不限制', 'system'),
+-- 邮件发送（SMTP）
+('blog', 'default', 'smtp_host', 'string', '""', true, 'SMTP服务器地址，为空时邮件保留在发件箱中不发送', 'system'),
+('blog', 'default', 'smtp_port', 'number', '587', true, 'SMTP端口（本地测试服务如Mailpit通常为1025）', 'system'),
+('blog', 'default', 'smtp_username', 'string', '""', true, 'SMTP用户名，为空时不认证', 'system'),
+('blog', 'default', 'smtp_password', 'string', '""', true, 'SMTP密码', 'system'),
+('blog', 'default', 'smtp_from', 'string', '""', true, '发件地址', 'system'),
+('blog', 'default', 'smtp_from_name', 'string', '""', true, '发件人名称，为空时使用站点标题', 'system'),
+('blog', 'default', 'smtp_tls', 'string', '"starttls"', true, '连接方式：none/starttls/tls', 'system'),
+('blog', 'default', 'smtp_timeout_seconds', 'number', '30', true, 'SMTP连接与发送超时（秒）', 'system'),
+('blog', 'default', 'smtp_rate_per_minute', 'number', '30', true, '每分钟最多发送邮件数', 'system'),
+('blog', 'default', 'smtp_max_attempts', 'number', '5', true, '临时失败的最大尝试次数，按指数退避重试', 'system'),
+('blog', 'default', 'mail_worker_interval_seconds', 'number', '15', true, '发件箱检查间隔（秒）', 'system'),
+-- 邮件订阅
+('blog', 'default', 'newsletter_enabled', 'boolean', 'true', true, '是否开启邮件订阅与新文章通知', 'system'),
+('blog', 'default', 'newsletter_confirm_ttl_hours', 'number', '48', true, '订阅确认链接有效期（小时）', 'system'),
+('blog', 'default', 'newsletter_subscribe_per_hour', 'number', '5', true, '同一IP每小时最多提交订阅次数', 'system'),
+('blog', 'default', 'newsletter_max_age_hours', 'number', '48', true, '只通知该时间内发布的文章，避免导入或开启订阅时群发旧文章', 'system'),
+('blog', 'default', 'newsletter_digest_hour', 'number', '8', true, '每日、每周摘要的发送时刻（0-23，北京时间）', 'system'),
+('blog', 'default', 'newsletter_digest_weekday', 'number', '1', true, '每周摘要的发送日（0为周日）', 'system'),
+('blog', 'default', 'newsletter_bounce_limit', 'number', '3', true, '连续退信达到该次数后停止向该订阅者发送', 'system'),
+-- 评论通知
+('blog', 'default', 'comment_notify_admin_email', 'string', '""', true, '接收新评论与待审核评论通知的邮箱，为空时不通知管理员', 'system'),
+('blog', 'default', 'comment_notify_admin_new', 'boolean', 'true', true, '是否通知管理员新发布的评论', 'system'),
+('blog', 'default', 'comment_notify_admin_pending', 'boolean', 'true', true, '是否通知管理员待审核的评论', 'system'),
+('blog', 'default', 'comment_notify_batch_minutes', 'number', '5', true, '管理员通知延迟发送的分钟数，期间的同类通知合并为一封', 'system'),
+('blog', 'default', 'comment_notify_replies', 'boolean', 'true', true, '评论收到回复时是否邮件通知评论者', 'system'),
+('blog', 'default', 'comment_notify_reply_per_hour', 'number', '5', true, '同一邮箱每小时最多收到的回复通知数（0为不限）', 'system'),
+-- Webmention / Pingback
+('blog', 'default', 'webmention_enabled', 'boolean', 'true', true, '是否接收 Webmention 与 Pingback', 'system'),
+('blog', 'default', 'webmention_send_enabled', 'boolean', 'true', true, '文章发布或更新后是否向文中链接发送 Webmention/Pingback', 'system'),
+('blog', 'default', 'webmention_receive_per_hour', 'number', '30', true, '同一IP每小时最多提交的通知数', 'system'),
+('blog', 'default', 'webmention_timeout_seconds', 'number', '10', true, '抓取来源页面与发送通知的超时秒数', 'system'),
+('blog', 'default', 'webmention_max_attempts', 'number', '3', true, '网络错误或5xx时的最大尝试次数', 'system'),
+('blog', 'default', 'webmention_scan_window_hours', 'number', '48', true, '只为该小时数内发布或更新的文章发送通知', 'system'),
+('blog', 'default', 'webmention_worker_interval_seconds', 'number', '30', true, '校验与发送任务的执行间隔秒数', 'system'),
+('blog', 'default', 'webmention_allow_private', 'boolean', 'false', true, '是否允许访问内网地址（仅用于本地测试）', 'system'),
+-- 文章导入
+('blog', 'default', 'import_allow_private', 'boolean', 'false', true, '导入时下载远程图片是否允许访问内网地址', 'system'),
+-- 分享卡片
+('blog', 'default', 'og_image_enabled', 'boolean', 'true', true, '是否为没有分享图片与特色图片的文章自动生成 Open Graph 分享卡片', 'system'),
+('blog', 'default', 'og_image_weibo_enabled', 'boolean', 'false', true, '是否为不带图片的公开微博生成分享卡片', 'system'),
+('blog', 'default', 'og_image_accent', 'string', '"#38bdf8"', true, '分享卡片强调色（#RRGGBB）', 'system'),
+('blog', 'default', 'og_image_font_paths', 'string', '""', true, '额外的字体文件路径（逗号分隔），用于内置字体缺少的中日韩字形', 'system'),
+('blog', 'default', 'og_image_worker_interval_seconds', 'number', '60', true, '分享卡片生成任务的执行间隔秒数', 'system'),
+-- 多语言
+('blog', 'default', 'site_languages', 'string', '""', true, '允许的翻译语言（逗号分隔，如 zh-CN,en），为空时不限制', 'system'),
+('blog', 'default', 'translation_fallback', 'string', '"original"', true, '请求的语言没有对应版本时：original 回退到原文，none 视为不存在（列表与订阅源中排除）', 'system'),
+-- 微博话题
+('blog', 'default', 'weibo_trending_hours', 'number', '24', true, '热门话题统计的时间窗口（小时）', 'system'),
+('blog', 'default', 'weibo_trending_limit', 'number', '10', true, '热门话题默认返回数量', 'system')
+
+ON CONFLICT (namespace, env, key) DO NOTHING;
+
 -- 显示初始化结果
 DO $$
 DECLARE
@@ -66,6 +164,7 @@ BEGIN
     RAISE NOTICE '- system: 系统配置';
     RAISE NOTICE '- auth: 认证配置';
     RAISE NOTICE '- core: 核心功能配置';
+    RAISE NOTICE '- blog: 博客配置';
     RAISE NOTICE '';
     RAISE NOTICE '重要提醒：';
     RAISE NOTICE '1. 生产环境请修改 JWT_SECRET';
@@ -0,0 +1,17 @@
+-- 评论反垃圾字段迁移脚本
+-- 迁移版本：0011
+-- ===== 清理现有对象 =====
+
+DROP INDEX IF EXISTS idx_blog_comments_article_status_created;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-18
+-- 描述: 为匿名评论增加反垃圾判定原因字段，可疑评论以 pending 状态入库等待审核
+
+ALTER TABLE blog_comments ADD COLUMN IF NOT EXISTS spam_reasons TEXT;
+COMMENT ON COLUMN blog_comments.spam_reasons IS '反垃圾检查命中原因（为空表示未命中）';
+
+-- 评论列表按文章+状态分页查询
+CREATE INDEX idx_blog_comments_article_status_created ON blog_comments(article_id, status, created_at);
@@ -0,0 +1,90 @@
+-- 博客文章全文搜索迁移脚本
+-- 迁移版本：0012
+-- ===== 清理现有对象 =====
+
+DROP TRIGGER IF EXISTS trigger_blog_articles_search_vector ON blog_articles;
+DROP FUNCTION IF EXISTS blog_articles_search_vector_update() CASCADE;
+DROP INDEX IF EXISTS idx_blog_articles_search_vector;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-18
+-- 描述: 为文章增加加权 search_vector 列（标题A > 摘要B > 正文C），
+--       中日韩文本以重叠二元组（bigram）切分后写入，解决 simple/chinese 配置无法切分中文的问题。
+--       应用层构造查询时使用相同的切分规则（见 internal/service/blog_search.go）。
+
+-- 1. CJK 字符范围：CJK统一汉字及扩展A、兼容汉字、日文假名、韩文音节
+-- 将文本中的 CJK 连续片段展开为重叠二元组，单个字符原样保留；其他文本保持不变
+CREATE OR REPLACE FUNCTION blog_cjk_bigram(input TEXT)
+RETURNS TEXT AS $$
+DECLARE
+    result TEXT := '';
+    seg TEXT;
+    i INTEGER;
+BEGIN
+    IF input IS NULL OR input = '' THEN
+        RETURN '';
+    END IF;
+    FOR seg IN
+        SELECT m[1] FROM regexp_matches(
+            input,
+            '([㐀-䶿一-鿿豈-﫿぀-ヿ가-힯]+|[^㐀-䶿一-鿿豈-﫿぀-ヿ가-힯]+)',
+            'g') AS m
+    LOOP
+        IF seg ~ '^[㐀-䶿一-鿿豈-﫿぀-ヿ가-힯]' THEN
+            IF char_length(seg) = 1 THEN
+                result := result || ' ' || seg;
+            ELSE
+                FOR i IN 1 .. char_length(seg) - 1 LOOP
+                    result := result || ' ' || substr(seg, i, 2);
+                END LOOP;
+            END IF;
+        ELSE
+            result := result || ' ' || seg;
+        END IF;
+    END LOOP;
+    RETURN result;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- 2. 将每个 CJK 字符用空格隔开，仅用于 ts_headline 生成高亮摘要
+CREATE OR REPLACE FUNCTION blog_cjk_split(input TEXT)
+RETURNS TEXT AS $$
+    SELECT regexp_replace(
+        coalesce(input, ''),
+        '([㐀-䶿一-鿿豈-﫿぀-ヿ가-힯])',
+        ' \1 ',
+        'g');
+$$ LANGUAGE sql IMMUTABLE;
+
+-- 3. 加权搜索向量列
+ALTER TABLE blog_articles ADD COLUMN IF NOT EXISTS search_vector TSVECTOR;
+COMMENT ON COLUMN blog_articles.search_vector IS '全文搜索向量（标题A/摘要B/正文C，CJK二元组切分）';
+
+CREATE OR REPLACE FUNCTION blog_articles_search_vector_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('simple', blog_cjk_bigram(NEW.title)), 'A') ||
+        setweight(to_tsvector('simple', blog_cjk_bigram(coalesce(NEW.summary, ''))), 'B') ||
+        setweight(to_tsvector('simple', blog_cjk_bigram(NEW.content)), 'C');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_blog_articles_search_vector
+    BEFORE INSERT OR UPDATE OF title, summary, content ON blog_articles
+    FOR EACH ROW
+    EXECUTE FUNCTION blog_articles_search_vector_update();
+
+-- 4. 回填已有文章
+UPDATE blog_articles SET search_vector =
+    setweight(to_tsvector('simple', blog_cjk_bigram(title)), 'A') ||
+    setweight(to_tsvector('simple', blog_cjk_bigram(coalesce(summary, ''))), 'B') ||
+    setweight(to_tsvector('simple', blog_cjk_bigram(content)), 'C');
+
+-- 5. 搜索索引；0009 中按标题/正文分别建立的 simple 索引无法处理中文，由本索引取代
+DROP INDEX IF EXISTS idx_blog_articles_title;
+DROP INDEX IF EXISTS idx_blog_articles_content;
+CREATE INDEX idx_blog_articles_search_vector ON blog_articles USING gin(search_vector);
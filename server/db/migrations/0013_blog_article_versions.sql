@@ -0,0 +1,35 @@
+-- 博客文章版本历史迁移脚本
+-- 迁移版本：0013
+-- ===== 清理现有对象 =====
+
+DROP INDEX IF EXISTS uk_blog_article_versions_article_version;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-18
+-- 描述: 文章的创建、更新、删除、恢复均写入 blog_article_versions，
+--       version 为每篇文章内递增的修订号；diff_data 记录与上一版本的行级增删统计及元数据快照。
+
+-- 1. 修订号在文章内唯一，防止并发写入产生重复版本
+CREATE UNIQUE INDEX uk_blog_article_versions_article_version ON blog_article_versions(article_id, version);
+
+COMMENT ON COLUMN blog_article_versions.change_type IS '变更类型：create, update, delete, restore';
+COMMENT ON COLUMN blog_article_versions.diff_data IS '与上一版本的差异统计及元数据快照（JSON）';
+
+-- 2. 为尚无版本记录的已有文章补写初始版本，使历史从当前内容开始
+INSERT INTO blog_article_versions (article_id, version, title, content, html_content, summary, change_type, change_summary, diff_data, operator_id, created_at)
+SELECT a.id, 1, a.title, a.content, a.html_content, a.summary, 'create', '初始版本（迁移补录）',
+       jsonb_build_object(
+           'added', 0,
+           'removed', 0,
+           'meta', jsonb_build_object(
+               'slug', a.slug,
+               'categoryId', a.category_id,
+               'status', a.status,
+               'featuredImage', COALESCE(a.featured_image, '')
+           )
+       ),
+       a.author_id, COALESCE(a.updated_at, a.created_at, NOW())
+FROM blog_articles a
+WHERE NOT EXISTS (SELECT 1 FROM blog_article_versions v WHERE v.article_id = a.id);
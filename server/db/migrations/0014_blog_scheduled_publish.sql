@@ -0,0 +1,17 @@
+-- 博客文章定时发布迁移脚本
+-- 迁移版本：0014
+-- ===== 清理现有对象 =====
+
+DROP INDEX IF EXISTS idx_blog_articles_scheduled_publish_at;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-18
+-- 描述: 新增 scheduled 文章状态，后台调度器按 publish_at 到点将其发布为 published。
+
+COMMENT ON COLUMN blog_articles.status IS '文章状态：draft, published, scheduled, private, archive';
+
+-- 定时发布扫描只关心待发布文章，使用部分索引
+CREATE INDEX idx_blog_articles_scheduled_publish_at ON blog_articles(publish_at)
+    WHERE status = 'scheduled' AND deleted_at IS NULL;
@@ -0,0 +1,101 @@
+-- 博客标签计数维护迁移脚本
+-- 迁移版本：0015
+-- ===== 清理现有对象 =====
+
+DROP TRIGGER IF EXISTS trigger_blog_article_tags_count ON blog_article_tags;
+DROP TRIGGER IF EXISTS trigger_blog_articles_tag_count ON blog_articles;
+DROP FUNCTION IF EXISTS blog_article_tags_count_update() CASCADE;
+DROP FUNCTION IF EXISTS blog_articles_tag_count_update() CASCADE;
+DROP FUNCTION IF EXISTS blog_refresh_tag_counts(BIGINT[]) CASCADE;
+DROP INDEX IF EXISTS uk_blog_tags_name_lower;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-18
+-- 描述: blog_tags.article_count 统计关联的可见文章数（已发布、非私密、未删除），
+--       关联变更或文章状态、私密、删除标记变化时由触发器重新计算。
+
+-- 1. 标签名称忽略大小写唯一，按名称自动建标签时据此去重
+--    先合并已有的仅大小写不同的标签（如 Go 与 go）：保留ID最小的一个，
+--    把其余标签的文章关联改到保留的标签上，再删除其余标签（其关联随外键级联删除）
+INSERT INTO blog_article_tags (article_id, tag_id)
+SELECT bat.article_id, dup.keep_id
+FROM blog_article_tags bat
+INNER JOIN (
+    SELECT id, MIN(id) OVER (PARTITION BY LOWER(name)) AS keep_id FROM blog_tags
+) dup ON dup.id = bat.tag_id
+WHERE dup.id <> dup.keep_id
+ON CONFLICT (article_id, tag_id) DO NOTHING;
+
+DELETE FROM blog_tags t
+USING (
+    SELECT id, MIN(id) OVER (PARTITION BY LOWER(name)) AS keep_id FROM blog_tags
+) dup
+WHERE t.id = dup.id AND dup.id <> dup.keep_id;
+
+CREATE UNIQUE INDEX uk_blog_tags_name_lower ON blog_tags(LOWER(name));
+
+-- 2. 重新计算指定标签的文章数
+CREATE OR REPLACE FUNCTION blog_refresh_tag_counts(tag_ids BIGINT[])
+RETURNS VOID AS $$
+BEGIN
+    UPDATE blog_tags t
+    SET article_count = (
+        SELECT COUNT(1)
+        FROM blog_article_tags bat
+        INNER JOIN blog_articles a ON a.id = bat.article_id
+        WHERE bat.tag_id = t.id
+          AND a.status = 'published'
+          AND COALESCE(a.is_private, false) = false
+          AND a.deleted_at IS NULL
+    )
+    WHERE t.id = ANY(tag_ids);
+END;
+$$ LANGUAGE plpgsql;
+
+-- 3. 文章标签关联变更
+CREATE OR REPLACE FUNCTION blog_article_tags_count_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM blog_refresh_tag_counts(ARRAY[NEW.tag_id]);
+        RETURN NEW;
+    ELSIF TG_OP = 'DELETE' THEN
+        PERFORM blog_refresh_tag_counts(ARRAY[OLD.tag_id]);
+        RETURN OLD;
+    ELSIF TG_OP = 'UPDATE' THEN
+        PERFORM blog_refresh_tag_counts(ARRAY[OLD.tag_id, NEW.tag_id]);
+        RETURN NEW;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_blog_article_tags_count
+    AFTER INSERT OR UPDATE OR DELETE ON blog_article_tags
+    FOR EACH ROW
+    EXECUTE FUNCTION blog_article_tags_count_update();
+
+-- 4. 文章可见性变化（发布、私密、删除/恢复）
+CREATE OR REPLACE FUNCTION blog_articles_tag_count_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF OLD.status IS DISTINCT FROM NEW.status
+       OR OLD.is_private IS DISTINCT FROM NEW.is_private
+       OR OLD.deleted_at IS DISTINCT FROM NEW.deleted_at THEN
+        PERFORM blog_refresh_tag_counts(ARRAY(
+            SELECT tag_id FROM blog_article_tags WHERE article_id = NEW.id
+        ));
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_blog_articles_tag_count
+    AFTER UPDATE OF status, is_private, deleted_at ON blog_articles
+    FOR EACH ROW
+    EXECUTE FUNCTION blog_articles_tag_count_update();
+
+-- 5. 回填现有标签计数
+SELECT blog_refresh_tag_counts(ARRAY(SELECT id FROM blog_tags));
@@ -0,0 +1,92 @@
+-- 博客分类层级与文章计数迁移脚本
+-- 迁移版本：0016
+-- ===== 清理现有对象 =====
+
+DROP TRIGGER IF EXISTS trigger_update_category_article_count ON blog_articles;
+DROP TRIGGER IF EXISTS trigger_blog_categories_no_cycle ON blog_categories;
+DROP FUNCTION IF EXISTS update_category_article_count() CASCADE;
+DROP FUNCTION IF EXISTS blog_categories_check_cycle() CASCADE;
+DROP FUNCTION IF EXISTS blog_refresh_category_counts(BIGINT[]) CASCADE;
+DROP INDEX IF EXISTS idx_blog_categories_parent_sort;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: blog_categories.article_count 改为统计直接归属该分类的可见文章数（已发布、非私密、未删除），
+--       与标签计数口径一致；层级汇总由服务层在分类树上计算。同时在数据库层拒绝形成环的父分类。
+
+-- 1. 同级分类排序
+CREATE INDEX idx_blog_categories_parent_sort ON blog_categories(parent_id, sort_order);
+
+-- 2. 重新计算指定分类的文章数
+CREATE OR REPLACE FUNCTION blog_refresh_category_counts(category_ids BIGINT[])
+RETURNS VOID AS $$
+BEGIN
+    UPDATE blog_categories c
+    SET article_count = (
+        SELECT COUNT(1)
+        FROM blog_articles a
+        WHERE a.category_id = c.id
+          AND a.status = 'published'
+          AND COALESCE(a.is_private, false) = false
+          AND a.deleted_at IS NULL
+    )
+    WHERE c.id = ANY(category_ids);
+END;
+$$ LANGUAGE plpgsql;
+
+-- 3. 文章新增、删除或分类、可见性变化时刷新计数
+CREATE OR REPLACE FUNCTION update_category_article_count()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM blog_refresh_category_counts(ARRAY[NEW.category_id]);
+        RETURN NEW;
+    ELSIF TG_OP = 'UPDATE' THEN
+        IF OLD.category_id IS DISTINCT FROM NEW.category_id
+           OR OLD.status IS DISTINCT FROM NEW.status
+           OR OLD.is_private IS DISTINCT FROM NEW.is_private
+           OR OLD.deleted_at IS DISTINCT FROM NEW.deleted_at THEN
+            PERFORM blog_refresh_category_counts(ARRAY[OLD.category_id, NEW.category_id]);
+        END IF;
+        RETURN NEW;
+    ELSIF TG_OP = 'DELETE' THEN
+        PERFORM blog_refresh_category_counts(ARRAY[OLD.category_id]);
+        RETURN OLD;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_update_category_article_count
+    AFTER INSERT OR UPDATE OR DELETE ON blog_articles
+    FOR EACH ROW
+    EXECUTE FUNCTION update_category_article_count();
+
+-- 4. 移动分类时拒绝把自身或后代设为父分类
+CREATE OR REPLACE FUNCTION blog_categories_check_cycle()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.parent_id IS NOT NULL AND EXISTS (
+        WITH RECURSIVE ancestors AS (
+            SELECT id, parent_id FROM blog_categories WHERE id = NEW.parent_id
+            UNION
+            SELECT c.id, c.parent_id FROM blog_categories c
+            INNER JOIN ancestors a ON c.id = a.parent_id
+        )
+        SELECT 1 FROM ancestors WHERE id = NEW.id
+    ) THEN
+        RAISE EXCEPTION 'blog category % cannot be moved under its own descendant %', NEW.id, NEW.parent_id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_blog_categories_no_cycle
+    BEFORE UPDATE OF parent_id ON blog_categories
+    FOR EACH ROW
+    EXECUTE FUNCTION blog_categories_check_cycle();
+
+-- 5. 回填现有分类计数
+SELECT blog_refresh_category_counts(ARRAY(SELECT id FROM blog_categories));
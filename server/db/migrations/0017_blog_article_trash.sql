@@ -0,0 +1,17 @@
+-- 博客文章回收站迁移脚本
+-- 迁移版本：0017
+-- ===== 清理现有对象 =====
+
+DROP INDEX IF EXISTS idx_blog_articles_trash;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 软删除的文章进入回收站，超过 trash_retention_days 后由后台任务物理删除；
+--       版本、评论、标签关联与 SEO 数据随外键级联删除，标签与分类计数由 0015、0016 的触发器维护。
+
+-- 1. 回收站列表与过期清理按删除时间查询
+CREATE INDEX idx_blog_articles_trash ON blog_articles(deleted_at) WHERE deleted_at IS NOT NULL;
+
+COMMENT ON COLUMN blog_articles.deleted_at IS '删除时间（非空表示在回收站中）';
@@ -0,0 +1,24 @@
+-- 博客文章 slug 重定向迁移脚本
+-- 迁移版本：0018
+-- ===== 清理现有对象 =====
+
+DROP TABLE IF EXISTS blog_article_slug_redirects CASCADE;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 文章修改 slug 时记录旧 slug，访问旧地址时 301 重定向到当前 slug；
+--       旧 slug 被其他文章重新使用时由服务层删除对应记录，文章优先于重定向。
+
+CREATE TABLE blog_article_slug_redirects (
+    id BIGSERIAL PRIMARY KEY,
+    article_id BIGINT NOT NULL REFERENCES blog_articles(id) ON DELETE CASCADE,
+    old_slug VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_blog_article_slug_redirects_article_id ON blog_article_slug_redirects(article_id);
+
+COMMENT ON TABLE blog_article_slug_redirects IS '博客文章旧 slug 重定向表';
+COMMENT ON COLUMN blog_article_slug_redirects.old_slug IS '文章曾经使用的 slug';
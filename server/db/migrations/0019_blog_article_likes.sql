@@ -0,0 +1,24 @@
+-- 博客文章点赞记录迁移脚本
+-- 迁移版本：0019
+-- ===== 清理现有对象 =====
+
+DROP TABLE IF EXISTS blog_article_likes CASCADE;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 记录匿名访客对文章的点赞，访客以 Cookie 中的访客标识区分，用于点赞/取消点赞去重；
+--       blog_articles.like_count 由服务层缓冲后批量更新。
+
+CREATE TABLE blog_article_likes (
+    id BIGSERIAL PRIMARY KEY,
+    article_id BIGINT NOT NULL REFERENCES blog_articles(id) ON DELETE CASCADE,
+    visitor_id VARCHAR(64) NOT NULL,
+    ip INET,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE(article_id, visitor_id)
+);
+
+COMMENT ON TABLE blog_article_likes IS '博客文章点赞记录表';
+COMMENT ON COLUMN blog_article_likes.visitor_id IS '访客标识（Cookie）';
@@ -0,0 +1,37 @@
+-- 博客文章系列迁移脚本
+-- 迁移版本：0020
+-- ===== 清理现有对象 =====
+
+DROP TABLE IF EXISTS blog_series_articles CASCADE;
+DROP TABLE IF EXISTS blog_series CASCADE;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 文章系列是有序的文章集合，一篇文章最多属于一个系列；
+--       position 决定系列内的阅读顺序，用于上一篇/下一篇导航与系列索引页。
+
+CREATE TABLE blog_series (
+    id BIGSERIAL PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) UNIQUE NOT NULL,
+    description TEXT,
+    cover_image VARCHAR(255),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TABLE blog_series_articles (
+    id BIGSERIAL PRIMARY KEY,
+    series_id BIGINT NOT NULL REFERENCES blog_series(id) ON DELETE CASCADE,
+    article_id BIGINT NOT NULL UNIQUE REFERENCES blog_articles(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_blog_series_articles_series_position ON blog_series_articles(series_id, position);
+
+COMMENT ON TABLE blog_series IS '博客文章系列表';
+COMMENT ON TABLE blog_series_articles IS '博客系列文章关联表（一篇文章最多属于一个系列）';
+COMMENT ON COLUMN blog_series_articles.position IS '系列内顺序，从0开始';
@@ -0,0 +1,33 @@
+-- 博客草稿预览令牌迁移脚本
+-- 迁移版本：0021
+-- ===== 清理现有对象 =====
+
+DROP TABLE IF EXISTS blog_preview_tokens CASCADE;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 为单篇文章签发的预览令牌。令牌本身是带文章ID与过期时间的签名串，
+--       表中只保存其摘要，用于撤销与记录最近使用时间。
+
+CREATE TABLE blog_preview_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    article_id BIGINT NOT NULL REFERENCES blog_articles(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    note VARCHAR(200) NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    use_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_blog_preview_tokens_article ON blog_preview_tokens(article_id, created_at DESC);
+
+COMMENT ON TABLE blog_preview_tokens IS '博客草稿预览令牌表';
+COMMENT ON COLUMN blog_preview_tokens.token_hash IS '令牌的 SHA-256 摘要（十六进制）';
+COMMENT ON COLUMN blog_preview_tokens.note IS '备注，如分享对象';
+COMMENT ON COLUMN blog_preview_tokens.revoked_at IS '撤销时间，非空表示已撤销';
+COMMENT ON COLUMN blog_preview_tokens.last_used_at IS '最近一次使用时间';
+COMMENT ON COLUMN blog_preview_tokens.use_count IS '使用次数';
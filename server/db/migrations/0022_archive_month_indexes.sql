@@ -0,0 +1,22 @@
+-- 博客与微博按月归档索引迁移脚本
+-- 迁移版本：0022
+-- ===== 清理现有对象 =====
+
+DROP INDEX IF EXISTS idx_blog_articles_archive_month;
+DROP INDEX IF EXISTS idx_weibo_posts_archive_month;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 归档接口按 date_trunc('month', ...) 分组与筛选。timestamptz 上的 date_trunc 依赖会话时区，
+--       不能直接建索引，因此先以固定时区（Asia/Shanghai）转换为 timestamp。
+--       查询中的表达式必须与这里完全一致才能命中索引（见 service/archive.go）。
+
+CREATE INDEX idx_blog_articles_archive_month
+    ON blog_articles ((date_trunc('month', COALESCE(publish_at, created_at) AT TIME ZONE 'Asia/Shanghai')))
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_weibo_posts_archive_month
+    ON weibo_posts ((date_trunc('month', created_at AT TIME ZONE 'Asia/Shanghai')))
+    WHERE is_deleted = false;
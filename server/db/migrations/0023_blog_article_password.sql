@@ -0,0 +1,36 @@
+-- 博客文章密码保护迁移脚本
+-- 迁移版本：0023
+-- ===== 清理现有对象 =====
+
+DROP TRIGGER IF EXISTS trigger_blog_articles_search_vector ON blog_articles;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 文章可设置访问密码，匿名读者输入密码后获得短期解锁令牌；摘要保持公开。
+--       受保护文章的正文不写入搜索向量，避免通过搜索命中或高亮摘要泄露正文。
+
+-- 1. 密码摘要（PBKDF2-SHA256，格式见 internal/service/blog_protect.go），空字符串表示未设置密码
+ALTER TABLE blog_articles ADD COLUMN IF NOT EXISTS password_hash VARCHAR(255) NOT NULL DEFAULT '';
+COMMENT ON COLUMN blog_articles.password_hash IS '访问密码摘要，为空表示不需要密码';
+
+-- 2. 搜索向量：受保护文章只索引标题与摘要
+CREATE OR REPLACE FUNCTION blog_articles_search_vector_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('simple', blog_cjk_bigram(NEW.title)), 'A') ||
+        setweight(to_tsvector('simple', blog_cjk_bigram(coalesce(NEW.summary, ''))), 'B');
+    IF coalesce(NEW.password_hash, '') = '' THEN
+        NEW.search_vector := NEW.search_vector ||
+            setweight(to_tsvector('simple', blog_cjk_bigram(NEW.content)), 'C');
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_blog_articles_search_vector
+    BEFORE INSERT OR UPDATE OF title, summary, content, password_hash ON blog_articles
+    FOR EACH ROW
+    EXECUTE FUNCTION blog_articles_search_vector_update();
@@ -0,0 +1,94 @@
+-- 邮件发件箱与博客订阅迁移脚本
+-- 迁移版本：0024
+-- ===== 清理现有对象 =====
+
+DROP TABLE IF EXISTS blog_newsletter_posts CASCADE;
+DROP TABLE IF EXISTS blog_subscriber_categories CASCADE;
+DROP TABLE IF EXISTS blog_subscribers CASCADE;
+DROP TABLE IF EXISTS mail_outbox CASCADE;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 所有外发邮件先写入 mail_outbox，由后台任务按频率限制经 SMTP 发送，失败按指数退避重试，
+--       5xx 拒收记为退信；邮件内容在发送时按 kind 对应的模板渲染，payload 只保存渲染所需的引用。
+--       订阅者采用双重确认（带签名与有效期的确认链接），可按分类订阅，并选择即时或每日/每周摘要。
+
+-- 1. 发件箱
+CREATE TABLE mail_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    kind VARCHAR(40) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    subject VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'sending', 'sent', 'failed', 'bounced', 'skipped')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+-- 后台任务只扫描待发送（及发送中断超时）的邮件
+CREATE INDEX idx_mail_outbox_due ON mail_outbox(next_attempt_at) WHERE status IN ('pending', 'sending');
+CREATE INDEX idx_mail_outbox_recipient ON mail_outbox(recipient, created_at DESC);
+CREATE INDEX idx_mail_outbox_created ON mail_outbox(created_at DESC);
+
+COMMENT ON TABLE mail_outbox IS '邮件发件箱';
+COMMENT ON COLUMN mail_outbox.kind IS '邮件类型，决定渲染模板';
+COMMENT ON COLUMN mail_outbox.payload IS '渲染所需的数据引用（订阅者ID、文章ID等）';
+COMMENT ON COLUMN mail_outbox.status IS 'pending 待发送，sending 发送中，sent 已发送，failed 重试耗尽，bounced 退信，skipped 无需发送';
+COMMENT ON COLUMN mail_outbox.next_attempt_at IS '下次尝试时间；发送中的邮件超时后重新认领';
+
+-- 2. 订阅者
+CREATE TABLE blog_subscribers (
+    id BIGSERIAL PRIMARY KEY,
+    email VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'active', 'unsubscribed', 'bounced')),
+    frequency VARCHAR(20) NOT NULL DEFAULT 'instant'
+        CHECK (frequency IN ('instant', 'daily', 'weekly')),
+    all_categories BOOLEAN NOT NULL DEFAULT true,
+    confirm_sent_at TIMESTAMPTZ,
+    unsubscribe_token VARCHAR(64) NOT NULL UNIQUE,
+    confirmed_at TIMESTAMPTZ,
+    unsubscribed_at TIMESTAMPTZ,
+    last_sent_at TIMESTAMPTZ,
+    last_digest_at TIMESTAMPTZ,
+    send_count INTEGER NOT NULL DEFAULT 0,
+    bounce_count INTEGER NOT NULL DEFAULT 0,
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_blog_subscribers_email ON blog_subscribers(lower(email));
+CREATE INDEX idx_blog_subscribers_active ON blog_subscribers(frequency) WHERE status = 'active';
+
+COMMENT ON TABLE blog_subscribers IS '博客邮件订阅者';
+COMMENT ON COLUMN blog_subscribers.status IS 'pending 待确认，active 已订阅，unsubscribed 已退订，bounced 多次退信已停用';
+COMMENT ON COLUMN blog_subscribers.frequency IS 'instant 每篇文章单独发送，daily/weekly 定期摘要';
+COMMENT ON COLUMN blog_subscribers.all_categories IS '是否订阅全部分类，否则只接收 blog_subscriber_categories 中的分类（含子分类）';
+COMMENT ON COLUMN blog_subscribers.confirm_sent_at IS '最近一次发送确认邮件的时间，用于限制重复发送';
+COMMENT ON COLUMN blog_subscribers.unsubscribe_token IS '退订与管理订阅使用的令牌';
+
+-- 3. 按分类订阅
+CREATE TABLE blog_subscriber_categories (
+    subscriber_id BIGINT NOT NULL REFERENCES blog_subscribers(id) ON DELETE CASCADE,
+    category_id BIGINT NOT NULL REFERENCES blog_categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (subscriber_id, category_id)
+);
+
+CREATE INDEX idx_blog_subscriber_categories_category ON blog_subscriber_categories(category_id);
+
+-- 4. 已通知的文章，保证每篇文章只向即时订阅者发送一次
+CREATE TABLE blog_newsletter_posts (
+    article_id BIGINT PRIMARY KEY REFERENCES blog_articles(id) ON DELETE CASCADE,
+    recipients INTEGER NOT NULL DEFAULT 0,
+    announced_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+COMMENT ON TABLE blog_newsletter_posts IS '已向订阅者发送通知的文章';
@@ -0,0 +1,28 @@
+-- 评论通知退订迁移脚本
+-- 迁移版本：0025
+-- ===== 清理现有对象 =====
+
+DROP TABLE IF EXISTS blog_comment_unsubscribes CASCADE;
+DROP INDEX IF EXISTS idx_mail_outbox_comment;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 评论回复通知按评论串退订。评论串以顶级评论标识，thread_id 为 0 表示退订该邮箱的全部回复通知；
+--       退订链接为签名令牌，不需要在评论表中保存额外状态。
+
+CREATE TABLE blog_comment_unsubscribes (
+    email VARCHAR(255) NOT NULL,
+    thread_id BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (email, thread_id)
+);
+
+COMMENT ON TABLE blog_comment_unsubscribes IS '评论回复通知退订记录';
+COMMENT ON COLUMN blog_comment_unsubscribes.email IS '退订邮箱（小写）';
+COMMENT ON COLUMN blog_comment_unsubscribes.thread_id IS '评论串的顶级评论ID，0 表示全部评论串';
+
+-- 同一条回复只通知一次（评论被重新审核通过时据此去重）
+CREATE INDEX idx_mail_outbox_comment ON mail_outbox(((payload->>'commentId')::BIGINT))
+    WHERE kind = 'comment_reply';
@@ -0,0 +1,85 @@
+-- Webmention 与 Pingback 迁移脚本
+-- 迁移版本：0026
+-- ===== 清理现有对象 =====
+
+DROP TABLE IF EXISTS blog_webmention_scans CASCADE;
+DROP TABLE IF EXISTS blog_webmention_sends CASCADE;
+DROP TABLE IF EXISTS blog_webmentions CASCADE;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 接收的 Webmention/Pingback 先以 pending 状态入库，由后台任务抓取来源页面校验链接并解析 h-entry 微格式；
+--       发布的文章由后台任务扫描 html_content 中的外部链接，向声明了端点的目标发送 Webmention（没有时退回 Pingback）。
+
+-- 1. 收到的提及，与评论一起展示在文章下方
+CREATE TABLE blog_webmentions (
+    id BIGSERIAL PRIMARY KEY,
+    article_id BIGINT NOT NULL REFERENCES blog_articles(id) ON DELETE CASCADE,
+    source VARCHAR(2048) NOT NULL,
+    target VARCHAR(2048) NOT NULL,
+    protocol VARCHAR(20) NOT NULL DEFAULT 'webmention'
+        CHECK (protocol IN ('webmention', 'pingback')),
+    mention_type VARCHAR(20) NOT NULL DEFAULT 'mention'
+        CHECK (mention_type IN ('mention', 'reply', 'like', 'repost', 'bookmark')),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'verified', 'rejected', 'deleted', 'blocked')),
+    url VARCHAR(2048) NOT NULL DEFAULT '',
+    title VARCHAR(500) NOT NULL DEFAULT '',
+    content TEXT NOT NULL DEFAULT '',
+    author_name VARCHAR(200) NOT NULL DEFAULT '',
+    author_url VARCHAR(2048) NOT NULL DEFAULT '',
+    author_photo VARCHAR(2048) NOT NULL DEFAULT '',
+    published_at TIMESTAMPTZ,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT NOT NULL DEFAULT '',
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    verified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (source, target)
+);
+
+CREATE INDEX idx_blog_webmentions_article ON blog_webmentions(article_id, created_at) WHERE status = 'verified';
+CREATE INDEX idx_blog_webmentions_due ON blog_webmentions(next_attempt_at) WHERE status = 'pending';
+
+COMMENT ON TABLE blog_webmentions IS '收到的 Webmention 与 Pingback';
+COMMENT ON COLUMN blog_webmentions.mention_type IS '按来源 h-entry 判断：mention 提及，reply 回复，like 喜欢，repost 转发，bookmark 收藏';
+COMMENT ON COLUMN blog_webmentions.status IS 'pending 待校验，verified 已校验（公开展示），rejected 校验失败，deleted 来源已删除或不再链接，blocked 管理员屏蔽';
+COMMENT ON COLUMN blog_webmentions.content IS '来源条目的纯文本内容摘录';
+
+-- 2. 发出的通知，每篇文章的每个外部链接一条
+CREATE TABLE blog_webmention_sends (
+    id BIGSERIAL PRIMARY KEY,
+    article_id BIGINT NOT NULL REFERENCES blog_articles(id) ON DELETE CASCADE,
+    target VARCHAR(2048) NOT NULL,
+    endpoint VARCHAR(2048) NOT NULL DEFAULT '',
+    protocol VARCHAR(20) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'sent', 'failed', 'no_endpoint')),
+    status_code INTEGER NOT NULL DEFAULT 0,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT NOT NULL DEFAULT '',
+    link_removed BOOLEAN NOT NULL DEFAULT false,
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (article_id, target)
+);
+
+CREATE INDEX idx_blog_webmention_sends_due ON blog_webmention_sends(next_attempt_at) WHERE status = 'pending';
+
+COMMENT ON TABLE blog_webmention_sends IS '向文章中外部链接发送的 Webmention/Pingback';
+COMMENT ON COLUMN blog_webmention_sends.status IS 'pending 待发送，sent 已发送，failed 重试耗尽，no_endpoint 目标未声明端点';
+COMMENT ON COLUMN blog_webmention_sends.link_removed IS '链接已从文章中删除：再通知一次让目标移除提及，成功后删除该记录';
+
+-- 3. 文章扫描记录：文章在 scanned_at 之后更新时重新扫描并通知（包括被删除的链接）
+CREATE TABLE blog_webmention_scans (
+    article_id BIGINT PRIMARY KEY REFERENCES blog_articles(id) ON DELETE CASCADE,
+    scanned_at TIMESTAMPTZ NOT NULL
+);
+
+COMMENT ON TABLE blog_webmention_scans IS '已扫描外部链接的文章及其扫描时的更新时间';
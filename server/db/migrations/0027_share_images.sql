@@ -0,0 +1,35 @@
+-- 分享卡片迁移脚本
+-- 迁移版本：0027
+-- ===== 清理现有对象 =====
+
+DROP TABLE IF EXISTS share_images CASCADE;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 自动生成的 Open Graph 分享卡片（1200x630 PNG）。图片保存在文件服务中，这里只记录引用；
+--       fingerprint 是卡片内容（标题、分类、日期、站点信息与版式版本）的摘要，内容变化时重新生成。
+--       文章没有设置 og_image 与特色图片时，SEO 数据回退到这里的图片。
+
+CREATE TABLE share_images (
+    subject_type VARCHAR(20) NOT NULL CHECK (subject_type IN ('article', 'weibo')),
+    subject_id BIGINT NOT NULL,
+    file_uuid UUID NOT NULL,
+    url VARCHAR(500) NOT NULL,
+    fingerprint VARCHAR(64) NOT NULL,
+    checked_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (subject_type, subject_id)
+);
+
+COMMENT ON TABLE share_images IS '自动生成的分享卡片';
+COMMENT ON COLUMN share_images.subject_type IS '对象类型：article 文章，weibo 微博';
+COMMENT ON COLUMN share_images.subject_id IS '文章或微博ID';
+COMMENT ON COLUMN share_images.file_uuid IS '文件服务中的图片UUID';
+COMMENT ON COLUMN share_images.url IS '图片地址（/file/download/<uuid>）';
+COMMENT ON COLUMN share_images.fingerprint IS '卡片内容摘要，变化时重新生成';
+COMMENT ON COLUMN share_images.checked_at IS '最近一次检查时间，对象在此之后更新时重新检查';
+
+CREATE INDEX idx_share_images_file ON share_images(file_uuid);
@@ -0,0 +1,55 @@
+-- 用户与作者迁移脚本
+-- 迁移版本：0028
+-- ===== 清理现有对象 =====
+
+ALTER TABLE IF EXISTS blog_articles DROP CONSTRAINT IF EXISTS fk_blog_articles_author;
+ALTER TABLE IF EXISTS weibo_posts DROP CONSTRAINT IF EXISTS fk_weibo_posts_author;
+DROP TABLE IF EXISTS users CASCADE;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 后台用户与作者资料。JWT 的 sub 为用户ID，文章与微博的 author_id 指向这里。
+--       角色：admin 管理员（用户与站点配置）、editor 编辑（可修改任何人的内容）、author 作者（只能修改自己的内容）。
+--       id=1 的管理员沿用原有的单密码登录（core/dev/keyPassword），password_hash 为空时只能通过该方式登录。
+
+CREATE TABLE users (
+    id BIGSERIAL PRIMARY KEY,
+    username VARCHAR(50) NOT NULL UNIQUE CHECK (username ~ '^[a-z0-9][a-z0-9_-]*$'),
+    password_hash VARCHAR(255) NOT NULL DEFAULT '',
+    role VARCHAR(20) NOT NULL DEFAULT 'author' CHECK (role IN ('admin', 'editor', 'author')),
+    display_name VARCHAR(100) NOT NULL DEFAULT '',
+    avatar_file_uuid UUID REFERENCES files(file_uuid) ON DELETE SET NULL,
+    bio TEXT NOT NULL DEFAULT '',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    token_valid_after TIMESTAMPTZ,
+    last_login_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+COMMENT ON TABLE users IS '后台用户与作者资料';
+COMMENT ON COLUMN users.username IS '登录名，同时用于作者页地址（小写字母、数字、下划线与连字符）';
+COMMENT ON COLUMN users.password_hash IS '密码摘要（pbkdf2-sha256），为空时不能用用户名密码登录';
+COMMENT ON COLUMN users.role IS '角色：admin 管理员，editor 编辑，author 作者';
+COMMENT ON COLUMN users.display_name IS '显示名称，为空时使用用户名';
+COMMENT ON COLUMN users.avatar_file_uuid IS '头像文件UUID';
+COMMENT ON COLUMN users.bio IS '作者简介';
+COMMENT ON COLUMN users.is_active IS '是否启用，停用后不能登录且已签发的令牌失效';
+COMMENT ON COLUMN users.token_valid_after IS '早于该时间签发的令牌全部失效（修改密码、停用时更新）';
+COMMENT ON COLUMN users.last_login_at IS '最近登录时间';
+
+CREATE INDEX idx_users_avatar ON users(avatar_file_uuid) WHERE avatar_file_uuid IS NOT NULL;
+
+-- 原有内容全部归属于管理员
+INSERT INTO users (id, username, role, display_name) VALUES (1, 'admin', 'admin', '管理员');
+SELECT setval('users_id_seq', (SELECT MAX(id) FROM users));
+
+UPDATE weibo_posts SET author_id = 1 WHERE author_id IS NULL OR author_id = 0;
+UPDATE blog_articles SET author_id = 1 WHERE author_id NOT IN (SELECT id FROM users);
+
+ALTER TABLE blog_articles
+    ADD CONSTRAINT fk_blog_articles_author FOREIGN KEY (author_id) REFERENCES users(id);
+ALTER TABLE weibo_posts
+    ADD CONSTRAINT fk_weibo_posts_author FOREIGN KEY (author_id) REFERENCES users(id);
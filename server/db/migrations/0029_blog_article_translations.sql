@@ -0,0 +1,51 @@
+-- 文章多语言翻译迁移脚本
+-- 迁移版本：0029
+-- ===== 清理现有对象 =====
+
+DROP TABLE IF EXISTS blog_article_translations CASCADE;
+ALTER TABLE IF EXISTS blog_articles DROP COLUMN IF EXISTS language;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 文章的其他语言版本。每个翻译有独立的标题、slug、摘要、正文与SEO数据；
+--       翻译的 slug 与文章 slug 共用同一地址空间（/blog/<slug>），保存时检查两张表均不冲突。
+--       文章本身的语言记录在 blog_articles.language，为空表示站点默认语言（site_language）。
+--       翻译只有在文章与翻译都已发布时才对访客可见。
+
+ALTER TABLE blog_articles ADD COLUMN language VARCHAR(20) NOT NULL DEFAULT '';
+COMMENT ON COLUMN blog_articles.language IS '文章原文语言（BCP 47，如 zh-CN、en），为空表示站点默认语言';
+
+CREATE TABLE blog_article_translations (
+    id BIGSERIAL PRIMARY KEY,
+    article_id BIGINT NOT NULL REFERENCES blog_articles(id) ON DELETE CASCADE,
+    language VARCHAR(20) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) NOT NULL UNIQUE,
+    summary TEXT NOT NULL DEFAULT '',
+    content TEXT NOT NULL,
+    html_content TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published')),
+    meta_title VARCHAR(255) NOT NULL DEFAULT '',
+    meta_description TEXT NOT NULL DEFAULT '',
+    meta_keywords TEXT NOT NULL DEFAULT '',
+    og_title VARCHAR(255) NOT NULL DEFAULT '',
+    og_description TEXT NOT NULL DEFAULT '',
+    og_image VARCHAR(255) NOT NULL DEFAULT '',
+    twitter_title VARCHAR(255) NOT NULL DEFAULT '',
+    twitter_description TEXT NOT NULL DEFAULT '',
+    twitter_image VARCHAR(255) NOT NULL DEFAULT '',
+    canonical_url VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (article_id, language)
+);
+
+COMMENT ON TABLE blog_article_translations IS '文章翻译';
+COMMENT ON COLUMN blog_article_translations.language IS '翻译语言（BCP 47，如 en、zh-TW）';
+COMMENT ON COLUMN blog_article_translations.slug IS '翻译的URL标识，与文章 slug 不得重复';
+COMMENT ON COLUMN blog_article_translations.status IS '翻译状态：draft 草稿，published 已发布';
+COMMENT ON COLUMN blog_article_translations.meta_title IS 'SEO字段仅保存显式填写的值，缺失时按翻译的标题、摘要回退';
+
+CREATE INDEX idx_blog_article_translations_language ON blog_article_translations(language, status);
@@ -0,0 +1,51 @@
+-- 微博话题与提及迁移脚本
+-- 迁移版本：0030
+-- ===== 清理现有对象 =====
+
+DROP TABLE IF EXISTS weibo_post_mentions CASCADE;
+DROP TABLE IF EXISTS weibo_post_topics CASCADE;
+DROP TABLE IF EXISTS weibo_topics CASCADE;
+
+-- ===== 创建新对象 =====
+
+
+-- 创建时间: 2026-10-19
+-- 描述: 微博正文中的话题（#话题# 与 #tag）和 @提及，在微博创建、编辑时解析写入。
+--       话题按 name_key（去空白、小写）去重，name 保留首次出现时的写法；提及只记录能对应到站内用户的用户名。
+--       微博数在查询时按可见性统计，不在表中冗余保存。
+--       已有微博在迁移后调用 POST /weibo/topics/reindex 重新解析。
+
+CREATE TABLE weibo_topics (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    name_key VARCHAR(100) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+COMMENT ON TABLE weibo_topics IS '微博话题';
+COMMENT ON COLUMN weibo_topics.name IS '话题名称（首次出现时的写法）';
+COMMENT ON COLUMN weibo_topics.name_key IS '规范化名称（去首尾空白、小写），用于去重与查询';
+COMMENT ON COLUMN weibo_topics.updated_at IS '最近一次被微博使用的时间';
+
+CREATE TABLE weibo_post_topics (
+    post_id BIGINT NOT NULL REFERENCES weibo_posts(id) ON DELETE CASCADE,
+    topic_id BIGINT NOT NULL REFERENCES weibo_topics(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (post_id, topic_id)
+);
+
+COMMENT ON TABLE weibo_post_topics IS '微博与话题的关联';
+
+CREATE INDEX idx_weibo_post_topics_topic ON weibo_post_topics(topic_id);
+
+CREATE TABLE weibo_post_mentions (
+    post_id BIGINT NOT NULL REFERENCES weibo_posts(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (post_id, user_id)
+);
+
+COMMENT ON TABLE weibo_post_mentions IS '微博中 @提及的站内用户';
+
+CREATE INDEX idx_weibo_post_mentions_user ON weibo_post_mentions(user_id);
@@ -13,6 +13,7 @@ import (
 	"server/internal/controller/daily"
 	"server/internal/controller/file"
 	"server/internal/controller/hello"
+	"server/internal/controller/seo"
 	"server/internal/controller/visit"
 	"server/internal/controller/weibo"
 	"server/internal/middleware"
@@ -34,6 +35,25 @@ var (
 			// 启动文件清理调度器
 			service.StartCleanupScheduler(ctx)
 			g.Log().Info(ctx, "文件清理调度器已启动")
+			// 启动文章定时发布调度器
+			service.StartScheduledPublisher(ctx)
+			g.Log().Info(ctx, "定时发布调度器已启动")
+			// 启动回收站清理调度器
+			service.StartTrashPurger(ctx)
+			g.Log().Info(ctx, "回收站清理调度器已启动")
+			// 启动文章计数批量写入任务
+			service.StartCounterFlusher(ctx)
+			g.Log().Info(ctx, "文章计数写入任务已启动")
+			// 启动邮件发送与订阅通知任务
+			service.StartMailWorker(ctx)
+			service.StartNewsletterWorker(ctx)
+			g.Log().Info(ctx, "邮件发送与订阅通知任务已启动")
+			// 启动 Webmention 校验与发送任务
+			service.StartWebmentionWorker(ctx)
+			g.Log().Info(ctx, "Webmention 任务已启动")
+			// 启动分享卡片生成任务
+			service.StartShareImageWorker(ctx)
+			g.Log().Info(ctx, "分享卡片任务已启动")
 			swaggerEnabled, swaggerErr := g.Cfg().Get(ctx, "swagger.enabled")
 			if swaggerErr == nil && !swaggerEnabled.Bool() {
 				swaggerPath, _ := g.Cfg().Get(ctx, "swagger.swaggerPath")
@@ -57,6 +77,7 @@ var (
 					daily.NewV1(),
 					file.NewV1(),
 					weibo.NewV1(),
+					seo.NewV1(),
 				)
 			})
 			s.Run()
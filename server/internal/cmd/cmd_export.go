@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gcmd"
+
+	"server/internal/service"
+	"server/internal/service/configcache"
+)
+
+// Export 命令行导出博客内容：main export [--format=markdown|html] [--drafts] [--output=FILE]
+var Export = gcmd.Command{
+	Name:  "export",
+	Usage: "export [--format=markdown|html] [--drafts] [--output=FILE]",
+	Brief: "export blog articles as a markdown zip or a static html site",
+	Arguments: []gcmd.Argument{
+		{Name: "format", Short: "f", Default: service.ExportFormatMarkdown, Brief: "markdown or html"},
+		{Name: "drafts", Short: "d", Orphan: true, Brief: "include drafts, scheduled and private articles (markdown only)"},
+		{Name: "output", Short: "o", Brief: "output file, defaults to the generated name in the working directory"},
+	},
+	Func: func(ctx context.Context, parser *gcmd.Parser) (err error) {
+		if _, err = configcache.PreloadAll(ctx); err != nil {
+			return gerror.Wrap(err, "加载动态配置失败")
+		}
+		format := parser.GetOpt("format", service.ExportFormatMarkdown).String()
+		output := parser.GetOpt("output", service.ExportFileName(format)).String()
+		f, err := os.Create(output)
+		if err != nil {
+			return gerror.Wrap(err, "创建导出文件失败")
+		}
+		result, err := service.BlogExport().Export(ctx, &service.ExportInput{
+			Format:        format,
+			IncludeDrafts: parser.GetOpt("drafts") != nil,
+		}, f)
+		if closeErr := f.Close(); err == nil && closeErr != nil {
+			err = gerror.Wrap(closeErr, "写入导出文件失败")
+		}
+		if err != nil {
+			_ = os.Remove(output)
+			return err
+		}
+		fmt.Printf("已导出 %d 篇文章、%d 个图片到 %s\n", result.Articles, result.Assets, output)
+		return nil
+	},
+}
+
+func init() {
+	if err := Main.AddCommand(&Export); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gcmd"
+
+	"server/internal/service"
+	"server/internal/service/configcache"
+)
+
+// Import 命令行导入博客内容：main import [--dry-run] [--category=未分类] [--author=1] [--skip-remote] FILE
+var Import = gcmd.Command{
+	Name:  "import",
+	Usage: "import [--dry-run] [--category=NAME] [--author=USER_ID] [--skip-remote] FILE",
+	Brief: "import blog articles from a Hexo/Hugo markdown zip or a WordPress WXR export",
+	Arguments: []gcmd.Argument{
+		{Name: "dry-run", Short: "n", Orphan: true, Brief: "only print the report, do not write anything"},
+		{Name: "category", Short: "c", Brief: "category for articles without one"},
+		{Name: "author", Short: "a", Brief: "user id of the author of imported articles, default 1"},
+		{Name: "skip-remote", Orphan: true, Brief: "keep remote image links instead of downloading them"},
+	},
+	Func: func(ctx context.Context, parser *gcmd.Parser) (err error) {
+		file := parser.GetArg(2).String()
+		if file == "" {
+			return gerror.New("请指定要导入的文件，例如: main import --dry-run posts.zip")
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return gerror.Wrap(err, "读取导入文件失败")
+		}
+		if _, err = configcache.PreloadAll(ctx); err != nil {
+			return gerror.Wrap(err, "加载动态配置失败")
+		}
+
+		report, err := service.BlogImport().Import(ctx, &service.ImportInput{
+			Data:             data,
+			DryRun:           parser.GetOpt("dry-run") != nil,
+			DefaultCategory:  parser.GetOpt("category").String(),
+			SkipRemoteImages: parser.GetOpt("skip-remote") != nil,
+			AuthorId:         importAuthor(parser),
+			UserAgent:        "blog-import-cli",
+		})
+		if err != nil {
+			return err
+		}
+		printImportReport(report)
+		return nil
+	},
+}
+
+func init() {
+	if err := Main.AddCommand(&Import); err != nil {
+		panic(err)
+	}
+}
+
+// importAuthor 命令行没有登录态，导入文章的作者由 --author 指定，默认为初始管理员（ID 为 1）
+func importAuthor(parser *gcmd.Parser) int64 {
+	return parser.GetOpt("author", 1).Int64()
+}
+
+// printImportReport 输出导入报告
+func printImportReport(report *service.ImportReport) {
+	mode := "导入"
+	if report.DryRun {
+		mode = "演练（未写入）"
+	}
+	fmt.Printf("%s: 格式=%s 共 %d 篇，新建 %d，跳过 %d，失败 %d\n",
+		mode, report.Format, report.Total, report.Created, report.Skipped, report.Failed)
+	for _, item := range report.Items {
+		line := fmt.Sprintf("[%s] %s -> %s (%s, %s)", item.Action, item.Source, item.Slug, item.Status, item.Category)
+		if item.Images > 0 {
+			line += fmt.Sprintf(" 图片 %d", item.Images)
+		}
+		if item.Reason != "" {
+			line += ": " + item.Reason
+		}
+		fmt.Println(line)
+		for _, img := range item.MissingImages {
+			fmt.Println("    缺失图片:", img)
+		}
+	}
+	if len(report.NewCategories) > 0 {
+		fmt.Println("新分类:", strings.Join(report.NewCategories, ", "))
+	}
+	if len(report.NewTags) > 0 {
+		fmt.Println("新标签:", strings.Join(report.NewTags, ", "))
+	}
+	for _, w := range report.Warnings {
+		fmt.Println("警告:", w)
+	}
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/os/gcmd"
+)
+
+func TestImportAuthor(t *testing.T) {
+	cases := []struct {
+		args []string
+		want int64
+	}{
+		{[]string{"main", "import", "posts.zip"}, 1},
+		{[]string{"main", "import", "--author=3", "posts.zip"}, 3},
+		{[]string{"main", "import", "-a", "5", "--dry-run", "posts.zip"}, 5},
+	}
+	for _, tc := range cases {
+		parser, err := gcmd.ParseArgs(tc.args, map[string]bool{"author,a": true, "dry-run,n": false})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := importAuthor(parser); got != tc.want {
+			t.Errorf("%v: got %d, want %d", tc.args, got, tc.want)
+		}
+		if file := parser.GetArg(2).String(); file != "posts.zip" {
+			t.Errorf("%v: file %q", tc.args, file)
+		}
+	}
+}
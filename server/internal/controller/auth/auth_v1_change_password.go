@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+
+	v1 "server/api/auth/v1"
+	"server/internal/service"
+	srvAuth "server/internal/service/auth"
+)
+
+// ChangePassword 修改当前用户密码，旧令牌失效后签发新令牌，当前会话保持登录
+func (c *ControllerV1) ChangePassword(ctx context.Context, req *v1.ChangePasswordReq) (res *v1.ChangePasswordRes, err error) {
+	if err = service.User().ChangePassword(ctx, req.OldPassword, req.NewPassword); err != nil {
+		return nil, err
+	}
+	token, exp, err := srvAuth.GenerateToken(ctx, strconv.FormatInt(srvAuth.CurrentUserID(ctx), 10), 12*3600)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ChangePasswordRes{Token: token, ExpiresAt: exp}, nil
+}
@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"context"
+
+	v1 "server/api/auth/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) CreateUser(ctx context.Context, req *v1.CreateUserReq) (res *v1.CreateUserRes, err error) {
+	user, err := service.User().Create(ctx, &service.UserInput{
+		Username:       req.Username,
+		Password:       &req.Password,
+		Role:           &req.Role,
+		DisplayName:    &req.DisplayName,
+		AvatarFileUuid: &req.AvatarFileUuid,
+		Bio:            &req.Bio,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateUserRes{User: toUserInfo(user)}, nil
+}
@@ -0,0 +1,15 @@
+package auth
+
+import (
+	"context"
+
+	v1 "server/api/auth/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) DeleteUser(ctx context.Context, req *v1.DeleteUserReq) (res *v1.DeleteUserRes, err error) {
+	if err = service.User().Delete(ctx, req.Id, req.ReassignTo); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteUserRes{Deleted: true}, nil
+}
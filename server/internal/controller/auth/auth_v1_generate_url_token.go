@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/gogf/gf/v2/frame/g"
 
@@ -33,8 +34,8 @@ func (c *ControllerV1) GenerateUrlToken(ctx context.Context, req *v1.GenerateUrl
 		}
 	}
 
-	// 生成token
-	token, expiresAt, err := srvAuth.GenerateToken(ctx, ttl)
+	// 为当前用户生成token
+	token, expiresAt, err := srvAuth.GenerateToken(ctx, strconv.FormatInt(srvAuth.CurrentUserID(ctx), 10), ttl)
 	if err != nil {
 		g.Log().Errorf(ctx, "GenerateUrlToken failed: %v", err)
 		return nil, err
@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"context"
+
+	v1 "server/api/auth/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ListUsers(ctx context.Context, req *v1.ListUsersReq) (res *v1.ListUsersRes, err error) {
+	users, total, err := service.User().List(ctx, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]v1.UserInfo, 0, len(users))
+	for _, u := range users {
+		list = append(list, *toUserInfo(u))
+	}
+	return &v1.ListUsersRes{Page: req.Page, Size: req.Size, Total: total, List: list}, nil
+}
@@ -2,34 +2,34 @@ package auth
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/frame/g"
 
 	v1 "server/api/auth/v1"
+	"server/internal/service"
 	srvAuth "server/internal/service/auth"
 	"server/internal/service/configcache"
 )
 
-// 简单密码校验：从动态配置读取 core/dev/keyPassword，与请求密码匹配即登录成功
-// 成功后签发 JWT（HS256），密钥从动态配置 auth/<env>/jwt_secret 读取
+// 用户名密码登录；未提供用户名时沿用站点密码校验：从动态配置读取 core/dev/keyPassword，
+// 与请求密码匹配即以管理员（用户ID 1）身份登录
+// 成功后签发 JWT（HS256），sub 为用户ID，密钥从动态配置 auth/<env>/jwt_secret 读取
 func (c *ControllerV1) Login(ctx context.Context, req *v1.LoginReq) (res *v1.LoginRes, err error) {
-	// 从缓存读取密码
-	item, ok := configcache.Get(ctx, "core", "dev", "keyPassword")
-	if !ok {
-		return nil, gerror.New("password not configured")
-	}
-	pass, _ := item.Value.(string)
-	// value 为 JSON 字符串，可能包含引号，做兼容处理
-	pass = strings.Trim(pass, " \t\r\n\"")
-	if pass == "" {
-		return nil, gerror.New("password empty")
-	}
-	if strings.TrimSpace(req.Password) != pass {
-		return nil, gerror.New("invalid password")
+	var userId int64
+	if strings.TrimSpace(req.Username) != "" {
+		user, err := service.User().Authenticate(ctx, req.Username, req.Password)
+		if err != nil {
+			return nil, err
+		}
+		userId = user.Id
+	} else if userId, err = c.loginWithSitePassword(ctx, req.Password); err != nil {
+		return nil, err
 	}
-	// 登录成功，签发 JWT。默认 12 小时；若提供 TTL 且当前非生产环境，则使用 TTL（限制范围 1~7200 秒）
+
+	// 签发 JWT。默认 12 小时；若提供 TTL 且当前非生产环境，则使用 TTL（限制范围 1~7200 秒）
 	var ttl int64 = int64(12 * 3600)
 	// 读取当前环境
 	env := ""
@@ -51,7 +51,7 @@ func (c *ControllerV1) Login(ctx context.Context, req *v1.LoginReq) (res *v1.Log
 			ttl = int64(req.TTL)
 		}
 	}
-	token, exp, err := srvAuth.GenerateToken(ctx, ttl)
+	token, exp, err := srvAuth.GenerateToken(ctx, strconv.FormatInt(userId, 10), ttl)
 	if err != nil {
 		return nil, err
 	}
@@ -79,3 +79,31 @@ func (c *ControllerV1) Login(ctx context.Context, req *v1.LoginReq) (res *v1.Log
 
 	return &v1.LoginRes{Token: token, ExpiresAt: exp}, nil
 }
+
+// loginWithSitePassword 站点密码登录，成功时返回管理员用户ID
+func (c *ControllerV1) loginWithSitePassword(ctx context.Context, password string) (int64, error) {
+	// 从缓存读取密码
+	item, ok := configcache.Get(ctx, "core", "dev", "keyPassword")
+	if !ok {
+		return 0, gerror.New("password not configured")
+	}
+	pass, _ := item.Value.(string)
+	// value 为 JSON 字符串，可能包含引号，做兼容处理
+	pass = strings.Trim(pass, " \t\r\n\"")
+	if pass == "" {
+		return 0, gerror.New("password empty")
+	}
+	if strings.TrimSpace(password) != pass {
+		return 0, gerror.New("invalid password")
+	}
+	// 站点密码对应 ID 为 1 的管理员，该账号被停用后站点密码同样失效
+	user, err := service.User().Get(ctx, 1)
+	if err != nil {
+		return 0, err
+	}
+	if !user.IsActive {
+		return 0, gerror.New("invalid password")
+	}
+	service.User().TouchLogin(ctx, user.Id)
+	return user.Id, nil
+}
@@ -2,26 +2,39 @@ package auth
 
 import (
 	"context"
-	"strings"
 
-	"github.com/gogf/gf/v2/errors/gerror"
-	"github.com/gogf/gf/v2/frame/g"
 	v1 "server/api/auth/v1"
-	srvAuth "server/internal/service/auth"
+	"server/internal/model/entity"
+	"server/internal/service"
 )
 
-// 校验 Bearer JWT 并返回当前用户信息
+// 返回当前登录用户的资料（令牌已由中间件校验）
 func (c *ControllerV1) Me(ctx context.Context, req *v1.MeReq) (res *v1.MeRes, err error) {
-	r := g.RequestFromCtx(ctx)
-	authz := r.Header.Get("Authorization")
-	if len(authz) < 8 || strings.ToLower(authz[0:7]) != "bearer " {
-		return nil, gerror.New("missing bearer token")
-	}
-	token := strings.TrimSpace(authz[7:])
-	claims, err := srvAuth.ValidateToken(ctx, token)
-	if err != nil || claims == nil {
-		return nil, gerror.New("invalid token")
+	user, err := service.User().Current(ctx)
+	if err != nil {
+		return nil, err
 	}
+	return &v1.MeRes{User: toUserInfo(user)}, nil
+}
 
-	return &v1.MeRes{User: &v1.UserInfo{Username: claims.Subject, Roles: []string{"admin"}}}, nil
+// toUserInfo 转换用户信息（辅助方法）
+func toUserInfo(u *entity.Users) *v1.UserInfo {
+	info := &v1.UserInfo{
+		Id:          u.Id,
+		Username:    u.Username,
+		DisplayName: service.UserDisplayName(u),
+		AvatarUrl:   service.UserAvatarURL(u),
+		Bio:         u.Bio,
+		Role:        u.Role,
+		Roles:       []string{u.Role},
+		IsActive:    u.IsActive,
+		HasPassword: u.PasswordHash != "",
+	}
+	if u.LastLoginAt != nil {
+		info.LastLoginAt = u.LastLoginAt.String()
+	}
+	if u.CreatedAt != nil {
+		info.CreatedAt = u.CreatedAt.String()
+	}
+	return info
 }
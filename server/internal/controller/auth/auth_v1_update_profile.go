@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+
+	v1 "server/api/auth/v1"
+	"server/internal/service"
+	srvAuth "server/internal/service/auth"
+)
+
+// UpdateProfile 修改当前用户的显示名称、头像与简介
+func (c *ControllerV1) UpdateProfile(ctx context.Context, req *v1.UpdateProfileReq) (res *v1.UpdateProfileRes, err error) {
+	id := srvAuth.CurrentUserID(ctx)
+	err = service.User().Update(ctx, id, &service.UserInput{
+		DisplayName:    req.DisplayName,
+		AvatarFileUuid: req.AvatarFileUuid,
+		Bio:            req.Bio,
+	})
+	if err != nil {
+		return nil, err
+	}
+	user, err := service.User().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateProfileRes{User: toUserInfo(user)}, nil
+}
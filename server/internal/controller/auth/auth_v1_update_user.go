@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+
+	v1 "server/api/auth/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) UpdateUser(ctx context.Context, req *v1.UpdateUserReq) (res *v1.UpdateUserRes, err error) {
+	err = service.User().Update(ctx, req.Id, &service.UserInput{
+		Password:       req.Password,
+		Role:           req.Role,
+		DisplayName:    req.DisplayName,
+		AvatarFileUuid: req.AvatarFileUuid,
+		Bio:            req.Bio,
+		IsActive:       req.IsActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	user, err := service.User().Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateUserRes{User: toUserInfo(user)}, nil
+}
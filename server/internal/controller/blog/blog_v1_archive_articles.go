@@ -0,0 +1,27 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ArchiveArticles(ctx context.Context, req *v1.ArchiveArticlesReq) (res *v1.ArchiveArticlesRes, err error) {
+	articles, total, err := service.BlogArchive().Articles(ctx, req.Year, req.Month, req.Status, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]v1.ArticleItem, 0, len(articles))
+	for _, article := range articles {
+		list = append(list, c.toArticleItem(ctx, article))
+	}
+	return &v1.ArchiveArticlesRes{
+		Year:  req.Year,
+		Month: req.Month,
+		Page:  req.Page,
+		Size:  req.Size,
+		Total: total,
+		List:  list,
+	}, nil
+}
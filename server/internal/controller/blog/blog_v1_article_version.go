@@ -0,0 +1,31 @@
+package blog
+
+import (
+	"context"
+	"encoding/json"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ArticleVersion(ctx context.Context, req *v1.ArticleVersionReq) (res *v1.ArticleVersionRes, err error) {
+	v, err := service.BlogVersion().Get(ctx, req.ArticleId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	var diff service.VersionDiffData
+	if v.DiffData != "" {
+		_ = json.Unmarshal([]byte(v.DiffData), &diff)
+	}
+	return &v1.ArticleVersionRes{
+		ArticleVersionItem: toArticleVersionItem(v),
+		Content:            v.Content,
+		HtmlContent:        v.HtmlContent,
+		Summary:            v.Summary,
+		Slug:               diff.Meta.Slug,
+		CategoryId:         diff.Meta.CategoryId,
+		Status:             diff.Meta.Status,
+		FeaturedImage:      diff.Meta.FeaturedImage,
+	}, nil
+}
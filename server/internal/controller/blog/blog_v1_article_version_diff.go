@@ -0,0 +1,29 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ArticleVersionDiff(ctx context.Context, req *v1.ArticleVersionDiffReq) (res *v1.ArticleVersionDiffRes, err error) {
+	diff, err := service.BlogVersion().Diff(ctx, req.ArticleId, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]v1.DiffLine, 0, len(diff.Lines))
+	for _, l := range diff.Lines {
+		lines = append(lines, v1.DiffLine{Op: l.Op, Text: l.Text, OldNo: l.OldNo, NewNo: l.NewNo})
+	}
+	return &v1.ArticleVersionDiffRes{
+		From:     req.From,
+		To:       req.To,
+		TitleOld: diff.TitleOld,
+		TitleNew: diff.TitleNew,
+		Added:    diff.Stats.Added,
+		Removed:  diff.Stats.Removed,
+		Lines:    lines,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package blog
+
+import (
+	"context"
+	"encoding/json"
+
+	"server/api/blog/v1"
+	"server/internal/model/entity"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ArticleVersions(ctx context.Context, req *v1.ArticleVersionsReq) (res *v1.ArticleVersionsRes, err error) {
+	items, total, err := service.BlogVersion().List(ctx, req.ArticleId, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]v1.ArticleVersionItem, 0, len(items))
+	for _, v := range items {
+		list = append(list, toArticleVersionItem(v))
+	}
+	return &v1.ArticleVersionsRes{
+		Page:  req.Page,
+		Size:  req.Size,
+		Total: total,
+		Items: list,
+	}, nil
+}
+
+// toArticleVersionItem 转换版本记录，增删统计取自 diff_data
+func toArticleVersionItem(v *entity.BlogArticleVersions) v1.ArticleVersionItem {
+	var diff service.VersionDiffData
+	if v.DiffData != "" {
+		_ = json.Unmarshal([]byte(v.DiffData), &diff)
+	}
+	item := v1.ArticleVersionItem{
+		Id:            v.Id,
+		Version:       v.Version,
+		Title:         v.Title,
+		ChangeType:    v.ChangeType,
+		ChangeSummary: v.ChangeSummary,
+		OperatorId:    v.OperatorId,
+		Added:         diff.Added,
+		Removed:       diff.Removed,
+	}
+	if v.CreatedAt != nil {
+		item.CreatedAt = v.CreatedAt.String()
+	}
+	return item
+}
@@ -0,0 +1,16 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) AuthorDetail(ctx context.Context, req *v1.AuthorDetailReq) (res *v1.AuthorDetailRes, err error) {
+	author, err := service.User().Author(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AuthorDetailRes{Author: toAuthorItem(author)}, nil
+}
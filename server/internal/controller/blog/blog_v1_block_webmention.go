@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) BlockWebmention(ctx context.Context, req *v1.BlockWebmentionReq) (res *v1.BlockWebmentionRes, err error) {
+	if err = service.BlogWebmention().Block(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.BlockWebmentionRes{Blocked: true}, nil
+}
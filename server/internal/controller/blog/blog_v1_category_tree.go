@@ -0,0 +1,31 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+	"server/internal/service/auth"
+)
+
+func (c *ControllerV1) CategoryTree(ctx context.Context, req *v1.CategoryTreeReq) (res *v1.CategoryTreeRes, err error) {
+	// 停用分类仅对登录用户可见
+	roots, err := service.BlogCategory().Tree(ctx, req.IncludeInactive && auth.IsAuthenticated(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CategoryTreeRes{List: toCategoryTreeNodes(roots)}, nil
+}
+
+// toCategoryTreeNodes 递归转换分类树节点（辅助方法）
+func toCategoryTreeNodes(nodes []*service.CategoryNode) []v1.CategoryTreeNode {
+	list := make([]v1.CategoryTreeNode, 0, len(nodes))
+	for _, node := range nodes {
+		list = append(list, v1.CategoryTreeNode{
+			CategoryItem:      toCategoryItem(node.Category),
+			TotalArticleCount: node.TotalCount,
+			Children:          toCategoryTreeNodes(node.Children),
+		})
+	}
+	return list
+}
@@ -0,0 +1,21 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service/antispam"
+)
+
+func (c *ControllerV1) CommentFormToken(ctx context.Context, req *v1.CommentFormTokenReq) (res *v1.CommentFormTokenRes, err error) {
+	token, err := antispam.IssueFormToken(ctx, req.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CommentFormTokenRes{
+		Token:      token.Token,
+		IssuedAt:   token.IssuedAt,
+		ExpiresAt:  token.ExpiresAt,
+		Difficulty: token.Difficulty,
+	}, nil
+}
@@ -0,0 +1,16 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ConfirmSubscription(ctx context.Context, req *v1.ConfirmSubscriptionReq) (res *v1.ConfirmSubscriptionRes, err error) {
+	sub, err := service.BlogNewsletter().Confirm(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ConfirmSubscriptionRes{Email: sub.Email, Frequency: sub.Frequency}, nil
+}
@@ -21,6 +21,9 @@ func (c *ControllerV1) Create(ctx context.Context, req *v1.CreateReq) (res *v1.C
 		"isPrivate":     req.IsPrivate,
 		"featuredImage": req.FeaturedImage,
 		"publishAt":     req.PublishAt,
+		"language":      req.Language,
+		"seo":           &req.SEO,
+		"tags":          req.Tags,
 	})
 	if err != nil {
 		return nil, err
@@ -3,12 +3,24 @@ package blog
 import (
 	"context"
 
-	"github.com/gogf/gf/v2/errors/gcode"
-	"github.com/gogf/gf/v2/errors/gerror"
-
 	"server/api/blog/v1"
+	"server/internal/service"
 )
 
 func (c *ControllerV1) CreateCategory(ctx context.Context, req *v1.CreateCategoryReq) (res *v1.CreateCategoryRes, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	category, err := service.BlogCategory().Create(ctx, &service.CategoryInput{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: &req.Description,
+		ParentId:    req.ParentId,
+		SortOrder:   req.SortOrder,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateCategoryRes{
+		Id:        category.Id,
+		CreatedAt: category.CreatedAt.String(),
+	}, nil
 }
@@ -3,12 +3,14 @@ package blog
 import (
 	"context"
 
-	"github.com/gogf/gf/v2/errors/gcode"
-	"github.com/gogf/gf/v2/errors/gerror"
-
 	"server/api/blog/v1"
+	"server/internal/service"
 )
 
 func (c *ControllerV1) CreateComment(ctx context.Context, req *v1.CreateCommentReq) (res *v1.CreateCommentRes, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	id, status, createdAt, err := service.BlogComment().Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateCommentRes{Id: id, Status: status, CreatedAt: createdAt}, nil
 }
@@ -0,0 +1,43 @@
+package blog
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"server/api/blog/v1"
+	"server/internal/model/entity"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) CreatePreviewToken(ctx context.Context, req *v1.CreatePreviewTokenReq) (res *v1.CreatePreviewTokenRes, err error) {
+	created, token, err := service.BlogPreview().Create(ctx, req.ArticleId, req.Ttl, req.Note)
+	if err != nil {
+		return nil, err
+	}
+	article, err := service.BlogSimple().GetArticle(ctx, req.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreatePreviewTokenRes{
+		PreviewTokenItem: toPreviewTokenItem(created),
+		Token:            token,
+		PreviewUrl:       service.LoadSiteInfo(ctx).ArticleURL(article.Slug) + "?preview=" + url.QueryEscape(token),
+	}, nil
+}
+
+// toPreviewTokenItem 转换预览令牌列表项（辅助方法）
+func toPreviewTokenItem(token *entity.BlogPreviewTokens) v1.PreviewTokenItem {
+	return v1.PreviewTokenItem{
+		Id:         token.Id,
+		ArticleId:  token.ArticleId,
+		Note:       token.Note,
+		ExpiresAt:  token.ExpiresAt.String(),
+		RevokedAt:  token.RevokedAt.String(),
+		LastUsedAt: token.LastUsedAt.String(),
+		UseCount:   token.UseCount,
+		Active:     token.RevokedAt == nil && token.ExpiresAt != nil && token.ExpiresAt.After(gtime.Now()),
+		CreatedAt:  token.CreatedAt.String(),
+	}
+}
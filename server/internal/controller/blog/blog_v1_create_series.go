@@ -0,0 +1,21 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) CreateSeries(ctx context.Context, req *v1.CreateSeriesReq) (res *v1.CreateSeriesRes, err error) {
+	series, err := service.BlogSeries().Create(ctx, &service.SeriesInput{
+		Title:       req.Title,
+		Slug:        req.Slug,
+		Description: req.Description,
+		CoverImage:  req.CoverImage,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateSeriesRes{Id: series.Id, Slug: series.Slug}, nil
+}
@@ -0,0 +1,21 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) CreateTag(ctx context.Context, req *v1.CreateTagReq) (res *v1.CreateTagRes, err error) {
+	tag, err := service.BlogTag().Create(ctx, &service.TagInput{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+		Color:       req.Color,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateTagRes{TagDetailItem: toTagDetailItem(tag)}, nil
+}
@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) DeleteCategory(ctx context.Context, req *v1.DeleteCategoryReq) (res *v1.DeleteCategoryRes, err error) {
+	if err = service.BlogCategory().Delete(ctx, req.Id, req.ReassignTo); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteCategoryRes{Deleted: true}, nil
+}
@@ -3,12 +3,13 @@ package blog
 import (
 	"context"
 
-	"github.com/gogf/gf/v2/errors/gcode"
-	"github.com/gogf/gf/v2/errors/gerror"
-
 	"server/api/blog/v1"
+	"server/internal/service"
 )
 
 func (c *ControllerV1) DeleteComment(ctx context.Context, req *v1.DeleteCommentReq) (res *v1.DeleteCommentRes, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	if err = service.BlogComment().Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteCommentRes{Deleted: true}, nil
 }
@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) DeleteSeries(ctx context.Context, req *v1.DeleteSeriesReq) (res *v1.DeleteSeriesRes, err error) {
+	if err = service.BlogSeries().Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteSeriesRes{Deleted: true}, nil
+}
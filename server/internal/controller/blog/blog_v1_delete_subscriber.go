@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) DeleteSubscriber(ctx context.Context, req *v1.DeleteSubscriberReq) (res *v1.DeleteSubscriberRes, err error) {
+	if err = service.BlogNewsletter().Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteSubscriberRes{Deleted: true}, nil
+}
@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) DeleteTag(ctx context.Context, req *v1.DeleteTagReq) (res *v1.DeleteTagRes, err error) {
+	if err = service.BlogTag().Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteTagRes{Deleted: true}, nil
+}
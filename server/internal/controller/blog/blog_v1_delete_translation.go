@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) DeleteTranslation(ctx context.Context, req *v1.DeleteTranslationReq) (res *v1.DeleteTranslationRes, err error) {
+	if err = service.BlogTranslation().Delete(ctx, req.ArticleId, req.Language); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteTranslationRes{Deleted: true}, nil
+}
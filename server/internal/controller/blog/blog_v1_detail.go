@@ -2,17 +2,85 @@ package blog
 
 import (
 	"context"
+	"net/http"
 	"time"
 
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+
 	"server/api/blog/v1"
+	"server/internal/model/entity"
 	"server/internal/service"
+	"server/internal/service/auth"
 )
 
 func (c *ControllerV1) Detail(ctx context.Context, req *v1.DetailReq) (res *v1.DetailRes, err error) {
-	// 调用简化版服务层获取文章详情
-	article, err := service.BlogSimple().GetArticle(ctx, req.Id)
-	if err != nil {
-		return nil, err
+	// 优先按 slug 查询，旧 slug 301 重定向到当前地址；不是文章 slug 时按翻译 slug 查询
+	var (
+		article *entity.BlogArticles
+		tr      *entity.BlogArticleTranslations
+	)
+	if req.Slug != "" {
+		var redirectTo string
+		article, redirectTo, err = service.BlogSimple().GetArticleBySlug(ctx, req.Slug)
+		if gerror.Code(err) == gcode.CodeNotFound {
+			article, tr, err = service.BlogTranslation().GetBySlug(ctx, req.Slug)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if redirectTo != "" {
+			redirectToSlug(g.RequestFromCtx(ctx), redirectTo)
+			return &v1.DetailRes{}, nil
+		}
+	} else {
+		// 调用简化版服务层获取文章详情
+		article, err = service.BlogSimple().GetArticle(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 未发布的文章仅对已登录用户或持有有效预览令牌的访客可见；私密文章仅对已登录用户可见
+	preview := false
+	if article.Status != service.ArticleStatusPublished && !auth.IsAuthenticated(ctx) {
+		if req.Preview == "" {
+			return nil, gerror.NewCode(gcode.CodeNotFound, "文章不存在")
+		}
+		if err = service.BlogPreview().Verify(ctx, req.Preview, article.Id); err != nil {
+			return nil, err
+		}
+		preview = true
+		// 预览内容不应被搜索引擎收录或被中间缓存保存
+		if r := g.RequestFromCtx(ctx); r != nil {
+			r.Response.Header().Set("X-Robots-Tag", "noindex, nofollow")
+			r.Response.Header().Set("Cache-Control", "no-store")
+		}
+	} else if service.IsArticlePrivate(article) && !auth.IsAuthenticated(ctx) {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "文章不存在")
+	}
+
+	// 未通过翻译 slug 访问时按请求的语言选择翻译
+	if tr == nil && req.Lang != "" {
+		if tr, err = service.BlogTranslation().Select(ctx, article, req.Lang); err != nil {
+			return nil, err
+		}
+	}
+	original := article
+	article = service.ApplyTranslation(article, tr)
+
+	// 密码保护：未解锁时只返回摘要等公开信息，正文留空
+	protected := service.IsArticleProtected(article)
+	locked := protected && !preview && !service.BlogProtect().Unlocked(ctx, article, req.Unlock)
+	if locked {
+		article.Content, article.HtmlContent = "", ""
+	}
+	if protected {
+		if r := g.RequestFromCtx(ctx); r != nil {
+			r.Response.Header().Set("Cache-Control", "private, no-store")
+		}
 	}
 
 	// 获取文章标签
@@ -41,6 +109,35 @@ func (c *ControllerV1) Detail(ctx context.Context, req *v1.DetailReq) (res *v1.D
 		publishAt = &article.PublishAt.Time
 	}
 
+	// 记录浏览（同一访客窗口期内只计一次），计数叠加尚未写入数据库的增量
+	counters := service.BlogCounter()
+	if req.IncrementView && article.Status == service.ArticleStatusPublished {
+		counters.RecordView(ctx, article.Id)
+	}
+
+	// 获取SEO数据（缺失字段已回退）
+	seo, err := service.BlogSEO().Resolve(ctx, original, tr)
+	if err != nil {
+		return nil, err
+	}
+
+	// 全部语言版本，已登录用户可以看到草稿翻译
+	language := service.ArticleLanguage(ctx, article)
+	alternates, err := service.BlogTranslation().Alternates(ctx, []int64{article.Id}, auth.IsAuthenticated(ctx))
+	if err != nil {
+		return nil, err
+	}
+	var translations []v1.TranslationLink
+	for _, v := range alternates[article.Id] {
+		translations = append(translations, v1.TranslationLink{
+			Language: v.Language,
+			Slug:     v.Slug,
+			Title:    v.Title,
+			Original: v.Original,
+			Current:  v.Slug == article.Slug,
+		})
+	}
+
 	// 构建响应
 	return &v1.DetailRes{
 		Id:            article.Id,
@@ -55,27 +152,31 @@ func (c *ControllerV1) Detail(ctx context.Context, req *v1.DetailReq) (res *v1.D
 		IsDraft:       article.IsDraft,
 		IsTop:         article.IsTop,
 		IsPrivate:     article.IsPrivate,
-		ViewCount:     article.ViewCount,
-		LikeCount:     article.LikeCount,
+		ViewCount:     article.ViewCount + counters.Pending(article.Id, service.CounterViews),
+		LikeCount:     article.LikeCount + counters.Pending(article.Id, service.CounterLikes),
 		CommentCount:  article.CommentCount,
-		ShareCount:    article.ShareCount,
+		ShareCount:    article.ShareCount + counters.Pending(article.Id, service.CounterShares),
+		Liked:         counters.IsLiked(ctx, article.Id),
 		FeaturedImage: article.FeaturedImage,
 		ReadTime:      article.ReadTime,
 		PublishAt:     publishAt,
 		CreatedAt:     article.CreatedAt.Time,
 		UpdatedAt:     article.UpdatedAt.Time,
 		Tags:          tagItems,
-		SEO: v1.SEOData{
-			MetaTitle:       "", // TODO: 从SEO表获取
-			MetaDescription: "",
-			MetaKeywords:    "",
-			OGTitle:         "",
-			OGDescription:   "",
-			OGImage:         "",
-			TwitterTitle:    "",
-			TwitterDesc:     "",
-			TwitterImage:    "",
-			CanonicalURL:    "",
-		},
+		Author:        toAuthorBrief(service.User().Brief(ctx, article.AuthorId)),
+		Language:      language,
+		Translations:  translations,
+		SEO:           *seo,
+		Preview:       preview,
+		Protected:     protected,
+		Locked:        locked,
 	}, nil
 }
+
+// redirectToSlug 以 301 将当前请求重定向到使用新 slug 的同一地址（辅助方法）
+func redirectToSlug(r *ghttp.Request, slug string) {
+	query := r.URL.Query()
+	query.Set("slug", slug)
+	r.Response.Header().Set("Location", r.URL.Path+"?"+query.Encode())
+	r.Response.WriteStatus(http.StatusMovedPermanently)
+}
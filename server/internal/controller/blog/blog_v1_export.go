@@ -0,0 +1,47 @@
+package blog
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Export(ctx context.Context, req *v1.ExportReq) (res *v1.ExportRes, err error) {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return nil, gerror.New("无法获取HTTP请求对象")
+	}
+
+	// 先写入临时文件再输出，避免整个压缩包驻留内存
+	tmp, err := os.CreateTemp("", "blog-export-*.zip")
+	if err != nil {
+		return nil, gerror.Wrap(err, "创建临时文件失败")
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err = service.BlogExport().Export(ctx, &service.ExportInput{
+		Format:        req.Format,
+		IncludeDrafts: req.IncludeDrafts,
+	}, tmp); err != nil {
+		return nil, err
+	}
+	if _, err = tmp.Seek(0, 0); err != nil {
+		return nil, gerror.Wrap(err, "读取导出文件失败")
+	}
+
+	fileName := service.ExportFileName(req.Format)
+	r.Response.Header().Set("Content-Type", "application/zip")
+	r.Response.Header().Set("Content-Disposition", `attachment; filename="`+fileName+`"`)
+	r.Response.Header().Set("Cache-Control", "no-store")
+	r.Response.ServeContent(fileName, time.Now(), tmp)
+	return &v1.ExportRes{}, nil
+}
@@ -0,0 +1,32 @@
+package blog
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+	"server/utility"
+)
+
+func (c *ControllerV1) Feed(ctx context.Context, req *v1.FeedReq) (res *v1.FeedRes, err error) {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return nil, gerror.New("无法获取HTTP请求对象")
+	}
+
+	doc, err := service.BlogFeed().Render(ctx, &service.FeedInput{
+		Format:   req.Format,
+		Category: req.Category,
+		Tag:      req.Tag,
+		Lang:     req.Lang,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	utility.ServeContent(r, doc.ContentType, doc.Body, doc.ETag, doc.LastModified, 600)
+	return &v1.FeedRes{}, nil
+}
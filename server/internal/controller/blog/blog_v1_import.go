@@ -0,0 +1,69 @@
+package blog
+
+import (
+	"context"
+	"io"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Import(ctx context.Context, req *v1.ImportReq) (res *v1.ImportRes, err error) {
+	src, err := req.File.Open()
+	if err != nil {
+		return nil, gerror.Wrap(err, "打开导入文件失败")
+	}
+	defer src.Close()
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return nil, gerror.Wrap(err, "读取导入文件失败")
+	}
+
+	in := &service.ImportInput{
+		Data:             data,
+		DryRun:           req.DryRun,
+		DefaultCategory:  req.DefaultCategory,
+		SkipRemoteImages: req.SkipRemoteImages,
+	}
+	if r := g.RequestFromCtx(ctx); r != nil {
+		in.UploaderIP = r.GetClientIp()
+		in.UserAgent = r.Header.Get("User-Agent")
+	}
+	report, err := service.BlogImport().Import(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.ImportRes{
+		Format:        report.Format,
+		DryRun:        report.DryRun,
+		Total:         report.Total,
+		Created:       report.Created,
+		Skipped:       report.Skipped,
+		Failed:        report.Failed,
+		NewCategories: report.NewCategories,
+		NewTags:       report.NewTags,
+		Warnings:      report.Warnings,
+		Items:         make([]v1.ImportItem, 0, len(report.Items)),
+	}
+	for _, item := range report.Items {
+		res.Items = append(res.Items, v1.ImportItem{
+			Source:        item.Source,
+			Title:         item.Title,
+			Slug:          item.Slug,
+			Status:        item.Status,
+			Category:      item.Category,
+			Tags:          item.Tags,
+			PublishAt:     item.PublishAt,
+			Images:        item.Images,
+			MissingImages: item.MissingImages,
+			Action:        item.Action,
+			Reason:        item.Reason,
+			ArticleId:     item.ArticleId,
+		})
+	}
+	return res, nil
+}
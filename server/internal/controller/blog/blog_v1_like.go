@@ -0,0 +1,16 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Like(ctx context.Context, req *v1.LikeReq) (res *v1.LikeRes, err error) {
+	count, err := service.BlogCounter().Like(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.LikeRes{Liked: true, LikeCount: count}, nil
+}
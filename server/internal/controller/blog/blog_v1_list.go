@@ -12,7 +12,18 @@ import (
 
 func (c *ControllerV1) List(ctx context.Context, req *v1.ListReq) (res *v1.ListRes, err error) {
 	// 调用简化版服务层获取文章列表
-	articles, total, err := service.BlogSimple().ListArticles(ctx, req.Page, req.Size, req.Status)
+	articles, total, err := service.BlogSimple().ListArticles(ctx, &service.ArticleListInput{
+		Page:               req.Page,
+		Size:               req.Size,
+		Status:             req.Status,
+		CategoryId:         req.CategoryId,
+		Tag:                req.Tag,
+		Search:             req.Search,
+		AuthorId:           req.AuthorId,
+		Author:             req.Author,
+		Lang:               req.Lang,
+		IncludeDescendants: req.IncludeChildren,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -20,47 +31,7 @@ func (c *ControllerV1) List(ctx context.Context, req *v1.ListReq) (res *v1.ListR
 	// 转换为响应格式
 	var list []v1.ArticleItem
 	for _, article := range articles {
-		// 获取文章标签
-		tags, _ := c.getArticleTags(ctx, article.Id)
-
-		// 时间转换
-		var publishAt *time.Time
-		if article.PublishAt != nil {
-			publishAt = &article.PublishAt.Time
-		}
-
-		item := v1.ArticleItem{
-			Id:            article.Id,
-			Title:         article.Title,
-			Slug:          article.Slug,
-			Summary:       article.Summary,
-			CategoryId:    article.CategoryId,
-			CategoryName:  "", // 需要通过关联查询获取
-			Status:        article.Status,
-			IsDraft:       article.IsDraft,
-			IsTop:         article.IsTop,
-			IsPrivate:     article.IsPrivate,
-			ViewCount:     article.ViewCount,
-			LikeCount:     article.LikeCount,
-			CommentCount:  article.CommentCount,
-			ShareCount:    article.ShareCount,
-			FeaturedImage: article.FeaturedImage,
-			ReadTime:      article.ReadTime,
-			PublishAt:     publishAt,
-			CreatedAt:     article.CreatedAt.Time,
-			UpdatedAt:     article.UpdatedAt.Time,
-		}
-
-		// 转换标签
-		for _, tag := range tags {
-			item.Tags = append(item.Tags, v1.TagItem{
-				Id:   tag.Id,
-				Name: tag.Name,
-				Slug: tag.Slug,
-			})
-		}
-
-		list = append(list, item)
+		list = append(list, c.toArticleItem(ctx, article))
 	}
 
 	return &v1.ListRes{
@@ -71,6 +42,61 @@ func (c *ControllerV1) List(ctx context.Context, req *v1.ListReq) (res *v1.ListR
 	}, nil
 }
 
+// toArticleItem 转换文章列表项（辅助方法）
+func (c *ControllerV1) toArticleItem(ctx context.Context, article *entity.BlogArticles) v1.ArticleItem {
+	// 获取文章标签
+	tags, _ := c.getArticleTags(ctx, article.Id)
+
+	// 时间转换
+	var publishAt *time.Time
+	if article.PublishAt != nil {
+		publishAt = &article.PublishAt.Time
+	}
+
+	item := v1.ArticleItem{
+		Id:            article.Id,
+		Title:         article.Title,
+		Slug:          article.Slug,
+		Summary:       article.Summary,
+		CategoryId:    article.CategoryId,
+		CategoryName:  "", // 需要通过关联查询获取
+		Status:        article.Status,
+		IsDraft:       article.IsDraft,
+		IsTop:         article.IsTop,
+		IsPrivate:     article.IsPrivate,
+		Protected:     service.IsArticleProtected(article),
+		ViewCount:     article.ViewCount,
+		LikeCount:     article.LikeCount,
+		CommentCount:  article.CommentCount,
+		ShareCount:    article.ShareCount,
+		FeaturedImage: article.FeaturedImage,
+		ReadTime:      article.ReadTime,
+		PublishAt:     publishAt,
+		CreatedAt:     article.CreatedAt.Time,
+		UpdatedAt:     article.UpdatedAt.Time,
+		Author:        toAuthorBrief(service.User().Brief(ctx, article.AuthorId)),
+		Language:      service.ArticleLanguage(ctx, article),
+	}
+
+	// 转换标签
+	for _, tag := range tags {
+		item.Tags = append(item.Tags, v1.TagItem{
+			Id:   tag.Id,
+			Name: tag.Name,
+			Slug: tag.Slug,
+		})
+	}
+	return item
+}
+
+// toAuthorBrief 转换作者简要信息（辅助方法）
+func toAuthorBrief(a *service.AuthorBrief) *v1.AuthorBrief {
+	if a == nil {
+		return nil
+	}
+	return &v1.AuthorBrief{Id: a.Id, Username: a.Username, DisplayName: a.DisplayName, AvatarUrl: a.AvatarUrl}
+}
+
 // getArticleTags 获取文章标签（辅助方法）
 func (c *ControllerV1) getArticleTags(ctx context.Context, articleId int64) ([]*entity.BlogTags, error) {
 	var tags []*entity.BlogTags
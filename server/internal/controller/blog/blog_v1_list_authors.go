@@ -0,0 +1,33 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ListAuthors(ctx context.Context, req *v1.ListAuthorsReq) (res *v1.ListAuthorsRes, err error) {
+	authors, err := service.User().Authors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]v1.AuthorItem, 0, len(authors))
+	for _, a := range authors {
+		list = append(list, toAuthorItem(a))
+	}
+	return &v1.ListAuthorsRes{List: list}, nil
+}
+
+// toAuthorItem 转换作者信息（辅助方法）
+func toAuthorItem(a *service.AuthorStat) v1.AuthorItem {
+	return v1.AuthorItem{
+		Id:           a.Id,
+		Username:     a.Username,
+		DisplayName:  service.UserDisplayName(&a.Users),
+		AvatarUrl:    service.UserAvatarURL(&a.Users),
+		Bio:          a.Bio,
+		ArticleCount: a.ArticleCount,
+		WeiboCount:   a.WeiboCount,
+	}
+}
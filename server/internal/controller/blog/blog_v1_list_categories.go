@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"server/api/blog/v1"
+	"server/internal/model/entity"
 	"server/internal/service"
 )
 
@@ -17,29 +18,33 @@ func (c *ControllerV1) ListCategories(ctx context.Context, req *v1.ListCategorie
 	// 转换为响应格式
 	var list []v1.CategoryItem
 	for _, category := range categories {
-		// 处理父分类ID
-		var parentId *int64
-		if category.ParentId > 0 {
-			parentId = &category.ParentId
-		}
-
-		item := v1.CategoryItem{
-			Id:           category.Id,
-			CategoryId:   category.CategoryId,
-			Name:         category.Name,
-			Slug:         category.Slug,
-			ParentId:     parentId,
-			SortOrder:    category.SortOrder,
-			Description:  category.Description,
-			ArticleCount: category.ArticleCount,
-			IsActive:     category.IsActive,
-			CreatedAt:    category.CreatedAt.String(),
-			UpdatedAt:    category.UpdatedAt.String(),
-		}
-		list = append(list, item)
+		list = append(list, toCategoryItem(category))
 	}
 
 	return &v1.ListCategoriesRes{
 		List: list,
 	}, nil
 }
+
+// toCategoryItem 转换分类列表项（辅助方法）
+func toCategoryItem(category *entity.BlogCategories) v1.CategoryItem {
+	// 处理父分类ID
+	var parentId *int64
+	if category.ParentId > 0 {
+		parentId = &category.ParentId
+	}
+
+	return v1.CategoryItem{
+		Id:           category.Id,
+		CategoryId:   category.CategoryId,
+		Name:         category.Name,
+		Slug:         category.Slug,
+		ParentId:     parentId,
+		SortOrder:    category.SortOrder,
+		Description:  category.Description,
+		ArticleCount: category.ArticleCount,
+		IsActive:     category.IsActive,
+		CreatedAt:    category.CreatedAt.String(),
+		UpdatedAt:    category.UpdatedAt.String(),
+	}
+}
@@ -3,12 +3,71 @@ package blog
 import (
 	"context"
 
-	"github.com/gogf/gf/v2/errors/gcode"
-	"github.com/gogf/gf/v2/errors/gerror"
-
 	"server/api/blog/v1"
+	"server/internal/model/entity"
+	"server/internal/service"
+	"server/internal/service/auth"
 )
 
 func (c *ControllerV1) ListComments(ctx context.Context, req *v1.ListCommentsReq) (res *v1.ListCommentsRes, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	roots, replies, total, err := service.BlogComment().List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按父评论分组，递归组装回复树
+	showSpam := auth.IsAuthenticated(ctx)
+	children := make(map[int64][]*entity.BlogComments)
+	for _, r := range replies {
+		children[r.ParentId] = append(children[r.ParentId], r)
+	}
+	var build func(cm *entity.BlogComments, depth int) v1.CommentItem
+	build = func(cm *entity.BlogComments, depth int) v1.CommentItem {
+		item := toCommentItem(cm, showSpam)
+		item.Replies = make([]v1.CommentItem, 0)
+		// 防御异常数据导致的环
+		if depth < 32 {
+			for _, child := range children[cm.Id] {
+				item.Replies = append(item.Replies, build(child, depth+1))
+			}
+		}
+		return item
+	}
+
+	list := make([]v1.CommentItem, 0, len(roots))
+	for _, r := range roots {
+		list = append(list, build(r, 0))
+	}
+
+	return &v1.ListCommentsRes{
+		Page:  req.Page,
+		Size:  req.Size,
+		Total: total,
+		List:  list,
+	}, nil
+}
+
+// toCommentItem 评论实体转换为响应结构；匿名访客不返回邮箱与反垃圾原因
+func toCommentItem(cm *entity.BlogComments, withPrivate bool) v1.CommentItem {
+	item := v1.CommentItem{
+		Id:             cm.Id,
+		ArticleId:      cm.ArticleId,
+		VisitorName:    cm.VisitorName,
+		VisitorWebsite: cm.VisitorWebsite,
+		Content:        cm.Content,
+		HtmlContent:    cm.HtmlContent,
+		Status:         cm.Status,
+	}
+	if cm.ParentId > 0 {
+		parentId := cm.ParentId
+		item.ParentId = &parentId
+	}
+	if withPrivate {
+		item.VisitorEmail = cm.VisitorEmail
+		item.SpamReasons = cm.SpamReasons
+	}
+	if cm.CreatedAt != nil {
+		item.CreatedAt = cm.CreatedAt.Time
+	}
+	return item
 }
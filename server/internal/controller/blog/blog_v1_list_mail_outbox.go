@@ -0,0 +1,31 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ListMailOutbox(ctx context.Context, req *v1.ListMailOutboxReq) (res *v1.ListMailOutboxRes, err error) {
+	items, total, err := service.MailOutbox().List(ctx, req.Status, req.Kind, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]v1.MailOutboxItem, 0, len(items))
+	for _, item := range items {
+		list = append(list, v1.MailOutboxItem{
+			Id:            item.Id,
+			Kind:          item.Kind,
+			Recipient:     item.Recipient,
+			Subject:       item.Subject,
+			Status:        item.Status,
+			Attempts:      item.Attempts,
+			NextAttemptAt: item.NextAttemptAt.String(),
+			LastError:     item.LastError,
+			CreatedAt:     item.CreatedAt.String(),
+			SentAt:        item.SentAt.String(),
+		})
+	}
+	return &v1.ListMailOutboxRes{Page: req.Page, Size: req.Size, Total: total, List: list}, nil
+}
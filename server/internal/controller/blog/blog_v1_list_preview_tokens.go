@@ -0,0 +1,20 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ListPreviewTokens(ctx context.Context, req *v1.ListPreviewTokensReq) (res *v1.ListPreviewTokensRes, err error) {
+	tokens, err := service.BlogPreview().List(ctx, req.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]v1.PreviewTokenItem, 0, len(tokens))
+	for _, token := range tokens {
+		items = append(items, toPreviewTokenItem(token))
+	}
+	return &v1.ListPreviewTokensRes{Items: items}, nil
+}
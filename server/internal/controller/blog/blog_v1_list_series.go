@@ -0,0 +1,35 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/model/entity"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ListSeries(ctx context.Context, req *v1.ListSeriesReq) (res *v1.ListSeriesRes, err error) {
+	series, err := service.BlogSeries().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]v1.SeriesItem, 0, len(series))
+	for _, item := range series {
+		list = append(list, toSeriesItem(item.Series, item.ArticleCount))
+	}
+	return &v1.ListSeriesRes{List: list}, nil
+}
+
+// toSeriesItem 转换系列列表项（辅助方法）
+func toSeriesItem(series *entity.BlogSeries, articleCount int) v1.SeriesItem {
+	return v1.SeriesItem{
+		Id:           series.Id,
+		Title:        series.Title,
+		Slug:         series.Slug,
+		Description:  series.Description,
+		CoverImage:   series.CoverImage,
+		ArticleCount: articleCount,
+		CreatedAt:    series.CreatedAt.String(),
+		UpdatedAt:    series.UpdatedAt.String(),
+	}
+}
@@ -0,0 +1,37 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/model/entity"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ListSubscribers(ctx context.Context, req *v1.ListSubscribersReq) (res *v1.ListSubscribersRes, err error) {
+	subs, total, err := service.BlogNewsletter().List(ctx, req.Status, req.Keyword, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]v1.SubscriberItem, 0, len(subs))
+	for _, sub := range subs {
+		list = append(list, toSubscriberItem(sub))
+	}
+	return &v1.ListSubscribersRes{Page: req.Page, Size: req.Size, Total: total, List: list}, nil
+}
+
+func toSubscriberItem(sub *entity.BlogSubscribers) v1.SubscriberItem {
+	return v1.SubscriberItem{
+		Id:            sub.Id,
+		Email:         sub.Email,
+		Name:          sub.Name,
+		Status:        sub.Status,
+		Frequency:     sub.Frequency,
+		AllCategories: sub.AllCategories,
+		ConfirmedAt:   sub.ConfirmedAt.String(),
+		LastSentAt:    sub.LastSentAt.String(),
+		SendCount:     sub.SendCount,
+		BounceCount:   sub.BounceCount,
+		CreatedAt:     sub.CreatedAt.String(),
+	}
+}
@@ -0,0 +1,50 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/model/entity"
+	"server/internal/service"
+	"server/internal/service/auth"
+)
+
+func (c *ControllerV1) ListTags(ctx context.Context, req *v1.ListTagsReq) (res *v1.ListTagsRes, err error) {
+	// 停用标签仅对登录用户可见
+	tags, total, err := service.BlogTag().List(ctx, &service.TagListInput{
+		Page:            req.Page,
+		Size:            req.Size,
+		Keyword:         req.Keyword,
+		Sort:            req.Sort,
+		IncludeInactive: req.IncludeInactive && auth.IsAuthenticated(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]v1.TagDetailItem, 0, len(tags))
+	for _, tag := range tags {
+		list = append(list, toTagDetailItem(tag))
+	}
+	return &v1.ListTagsRes{
+		Page:  req.Page,
+		Size:  req.Size,
+		Total: total,
+		List:  list,
+	}, nil
+}
+
+// toTagDetailItem 转换标签详情（辅助方法）
+func toTagDetailItem(tag *entity.BlogTags) v1.TagDetailItem {
+	return v1.TagDetailItem{
+		Id:           tag.Id,
+		Name:         tag.Name,
+		Slug:         tag.Slug,
+		Description:  tag.Description,
+		Color:        tag.Color,
+		ArticleCount: tag.ArticleCount,
+		IsActive:     tag.IsActive,
+		CreatedAt:    tag.CreatedAt.String(),
+		UpdatedAt:    tag.UpdatedAt.String(),
+	}
+}
@@ -0,0 +1,55 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/model/entity"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ListTranslations(ctx context.Context, req *v1.ListTranslationsReq) (res *v1.ListTranslationsRes, err error) {
+	translations, err := service.BlogTranslation().List(ctx, req.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]v1.TranslationItem, 0, len(translations))
+	for _, tr := range translations {
+		list = append(list, toTranslationItem(tr))
+	}
+	return &v1.ListTranslationsRes{List: list}, nil
+}
+
+// toTranslationItem 转换翻译信息（辅助方法）
+func toTranslationItem(tr *entity.BlogArticleTranslations) v1.TranslationItem {
+	item := v1.TranslationItem{
+		Id:          tr.Id,
+		ArticleId:   tr.ArticleId,
+		Language:    tr.Language,
+		Title:       tr.Title,
+		Slug:        tr.Slug,
+		Summary:     tr.Summary,
+		Content:     tr.Content,
+		HtmlContent: tr.HtmlContent,
+		Status:      tr.Status,
+		SEO: v1.SEOInput{
+			MetaTitle:       tr.MetaTitle,
+			MetaDescription: tr.MetaDescription,
+			MetaKeywords:    tr.MetaKeywords,
+			OGTitle:         tr.OgTitle,
+			OGDescription:   tr.OgDescription,
+			OGImage:         tr.OgImage,
+			TwitterTitle:    tr.TwitterTitle,
+			TwitterDesc:     tr.TwitterDescription,
+			TwitterImage:    tr.TwitterImage,
+			CanonicalURL:    tr.CanonicalUrl,
+		},
+	}
+	if tr.CreatedAt != nil {
+		item.CreatedAt = tr.CreatedAt.Time
+	}
+	if tr.UpdatedAt != nil {
+		item.UpdatedAt = tr.UpdatedAt.Time
+	}
+	return item
+}
@@ -0,0 +1,30 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ListWebmentionSends(ctx context.Context, req *v1.ListWebmentionSendsReq) (res *v1.ListWebmentionSendsRes, err error) {
+	items, err := service.BlogWebmention().Sends(ctx, req.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]v1.WebmentionSendItem, 0, len(items))
+	for _, item := range items {
+		list = append(list, v1.WebmentionSendItem{
+			Id:         item.Id,
+			Target:     item.Target,
+			Endpoint:   item.Endpoint,
+			Protocol:   item.Protocol,
+			Status:     item.Status,
+			StatusCode: item.StatusCode,
+			Attempts:   item.Attempts,
+			LastError:  item.LastError,
+			SentAt:     item.SentAt.String(),
+		})
+	}
+	return &v1.ListWebmentionSendsRes{List: list}, nil
+}
@@ -0,0 +1,39 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ListWebmentions(ctx context.Context, req *v1.ListWebmentionsReq) (res *v1.ListWebmentionsRes, err error) {
+	items, total, err := service.BlogWebmention().List(ctx, req.ArticleId, req.Status, req.Type, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := service.BlogWebmention().Counts(ctx, req.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]v1.WebmentionItem, 0, len(items))
+	for _, item := range items {
+		list = append(list, v1.WebmentionItem{
+			Id:          item.Id,
+			Source:      item.Source,
+			Protocol:    item.Protocol,
+			Type:        item.MentionType,
+			Status:      item.Status,
+			URL:         item.Url,
+			Title:       item.Title,
+			Content:     item.Content,
+			AuthorName:  item.AuthorName,
+			AuthorURL:   item.AuthorUrl,
+			AuthorPhoto: item.AuthorPhoto,
+			PublishedAt: item.PublishedAt.String(),
+			LastError:   item.LastError,
+			CreatedAt:   item.CreatedAt.String(),
+		})
+	}
+	return &v1.ListWebmentionsRes{Page: req.Page, Size: req.Size, Total: total, Counts: counts, List: list}, nil
+}
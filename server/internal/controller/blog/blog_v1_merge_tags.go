@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) MergeTags(ctx context.Context, req *v1.MergeTagsReq) (res *v1.MergeTagsRes, err error) {
+	if err = service.BlogTag().Merge(ctx, req.SourceId, req.TargetId); err != nil {
+		return nil, err
+	}
+	return &v1.MergeTagsRes{Merged: true}, nil
+}
@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ModerateComment(ctx context.Context, req *v1.ModerateCommentReq) (res *v1.ModerateCommentRes, err error) {
+	if err = service.BlogComment().Moderate(ctx, req.Id, req.Status); err != nil {
+		return nil, err
+	}
+	return &v1.ModerateCommentRes{Updated: true}, nil
+}
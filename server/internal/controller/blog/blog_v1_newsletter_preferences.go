@@ -0,0 +1,27 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) NewsletterPreferences(ctx context.Context, req *v1.NewsletterPreferencesReq) (res *v1.NewsletterPreferencesRes, err error) {
+	prefs, err := service.BlogNewsletter().Preferences(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.NewsletterPreferencesRes{NewsletterPreferencesItem: toNewsletterPreferencesItem(prefs)}, nil
+}
+
+func toNewsletterPreferencesItem(prefs *service.NewsletterPreferences) v1.NewsletterPreferencesItem {
+	return v1.NewsletterPreferencesItem{
+		Email:         prefs.Subscriber.Email,
+		Name:          prefs.Subscriber.Name,
+		Status:        prefs.Subscriber.Status,
+		Frequency:     prefs.Subscriber.Frequency,
+		AllCategories: prefs.Subscriber.AllCategories,
+		CategoryIds:   prefs.CategoryIds,
+	}
+}
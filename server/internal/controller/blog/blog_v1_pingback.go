@@ -0,0 +1,48 @@
+package blog
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+	"server/internal/service/webmention"
+)
+
+// Pingback XML-RPC 错误也以 200 状态返回 fault 文档
+func (c *ControllerV1) Pingback(ctx context.Context, req *v1.PingbackReq) (res *v1.PingbackRes, err error) {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return nil, gerror.New("无法获取HTTP请求对象")
+	}
+
+	r.Response.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	source, target, err := webmention.ParsePingback(r.GetBody())
+	if err == nil {
+		err = service.BlogWebmention().Receive(ctx, source, target, service.ProtocolPingback)
+	}
+	if err == nil {
+		r.Response.Write(webmention.EncodeResponse("Pingback 已接收"))
+		return &v1.PingbackRes{}, nil
+	}
+	var fault *webmention.Fault
+	switch {
+	case errors.As(err, &fault):
+		// ParsePingback 返回的错误原样输出
+	case gerror.Code(err) == gcode.CodeNotFound:
+		err = &webmention.Fault{Code: webmention.FaultTargetNotFound, Message: err.Error()}
+	case gerror.Code(err) == gcode.CodeInvalidParameter, gerror.Code(err) == gcode.CodeNotSupported:
+		err = &webmention.Fault{Code: webmention.FaultTargetInvalid, Message: err.Error()}
+	case gerror.Code(err) == gcode.CodeOperationFailed:
+		err = &webmention.Fault{Code: webmention.FaultAccessDenied, Message: err.Error()}
+	default:
+		g.Log().Errorf(ctx, "接收 Pingback 失败: %v", err)
+		err = &webmention.Fault{Code: webmention.FaultGeneric, Message: "服务器内部错误"}
+	}
+	r.Response.Write(webmention.EncodeFault(err))
+	return &v1.PingbackRes{}, nil
+}
@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Purge(ctx context.Context, req *v1.PurgeReq) (res *v1.PurgeRes, err error) {
+	if err = service.BlogTrash().Purge(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.PurgeRes{Purged: true}, nil
+}
@@ -0,0 +1,38 @@
+package blog
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+// ReceiveWebmention 按 Webmention 规范返回状态码与纯文本，而不是统一的 JSON 包装
+func (c *ControllerV1) ReceiveWebmention(ctx context.Context, req *v1.ReceiveWebmentionReq) (res *v1.ReceiveWebmentionRes, err error) {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return nil, gerror.New("无法获取HTTP请求对象")
+	}
+
+	r.Response.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	err = service.BlogWebmention().Receive(ctx, req.Source, req.Target, service.ProtocolWebmention)
+	if err == nil {
+		r.Response.WriteStatus(http.StatusAccepted, "Webmention 已接收，将异步校验")
+		return &v1.ReceiveWebmentionRes{}, nil
+	}
+	switch gerror.Code(err) {
+	case gcode.CodeInvalidParameter, gcode.CodeNotFound, gcode.CodeNotSupported:
+		r.Response.WriteStatus(http.StatusBadRequest, err.Error())
+	case gcode.CodeOperationFailed:
+		r.Response.WriteStatus(http.StatusTooManyRequests, err.Error())
+	default:
+		g.Log().Errorf(ctx, "接收 Webmention 失败: %v", err)
+		r.Response.WriteStatus(http.StatusInternalServerError, "服务器内部错误")
+	}
+	return &v1.ReceiveWebmentionRes{}, nil
+}
@@ -0,0 +1,16 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) RegenerateShareImage(ctx context.Context, req *v1.RegenerateShareImageReq) (res *v1.RegenerateShareImageRes, err error) {
+	item, err := service.ShareImage().Generate(ctx, req.Type, req.Id, true)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RegenerateShareImageRes{URL: item.Url}, nil
+}
@@ -0,0 +1,23 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Related(ctx context.Context, req *v1.RelatedReq) (res *v1.RelatedRes, err error) {
+	related, err := service.BlogRelated().List(ctx, req.Id, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]v1.RelatedItem, 0, len(related))
+	for _, item := range related {
+		list = append(list, v1.RelatedItem{
+			ArticleItem: c.toArticleItem(ctx, item.Article),
+			Score:       item.Score,
+		})
+	}
+	return &v1.RelatedRes{List: list}, nil
+}
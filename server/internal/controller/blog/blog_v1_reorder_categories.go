@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ReorderCategories(ctx context.Context, req *v1.ReorderCategoriesReq) (res *v1.ReorderCategoriesRes, err error) {
+	if err = service.BlogCategory().Reorder(ctx, req.ParentId, req.Ids); err != nil {
+		return nil, err
+	}
+	return &v1.ReorderCategoriesRes{Reordered: true}, nil
+}
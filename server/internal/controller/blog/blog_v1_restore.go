@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Restore(ctx context.Context, req *v1.RestoreReq) (res *v1.RestoreRes, err error) {
+	if err = service.BlogTrash().Restore(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.RestoreRes{Restored: true}, nil
+}
@@ -0,0 +1,16 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) RestoreArticleVersion(ctx context.Context, req *v1.RestoreArticleVersionReq) (res *v1.RestoreArticleVersionRes, err error) {
+	version, err := service.BlogVersion().Restore(ctx, req.ArticleId, req.Version, req.ChangeSummary)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RestoreArticleVersionRes{Version: version}, nil
+}
@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) RetryMail(ctx context.Context, req *v1.RetryMailReq) (res *v1.RetryMailRes, err error) {
+	if err = service.MailOutbox().Retry(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.RetryMailRes{Queued: true}, nil
+}
@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) RevokePreviewToken(ctx context.Context, req *v1.RevokePreviewTokenReq) (res *v1.RevokePreviewTokenRes, err error) {
+	if err = service.BlogPreview().Revoke(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.RevokePreviewTokenRes{Revoked: true}, nil
+}
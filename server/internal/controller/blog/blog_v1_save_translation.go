@@ -0,0 +1,25 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) SaveTranslation(ctx context.Context, req *v1.SaveTranslationReq) (res *v1.SaveTranslationRes, err error) {
+	tr, err := service.BlogTranslation().Save(ctx, &service.TranslationInput{
+		ArticleId: req.ArticleId,
+		Language:  req.Language,
+		Title:     req.Title,
+		Slug:      req.Slug,
+		Summary:   req.Summary,
+		Content:   req.Content,
+		Status:    req.Status,
+		SEO:       &req.SEO,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SaveTranslationRes{TranslationItem: toTranslationItem(tr)}, nil
+}
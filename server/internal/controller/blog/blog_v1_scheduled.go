@@ -0,0 +1,26 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Scheduled(ctx context.Context, req *v1.ScheduledReq) (res *v1.ScheduledRes, err error) {
+	articles, total, err := service.BlogSchedule().ListScheduled(ctx, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]v1.ArticleItem, 0, len(articles))
+	for _, article := range articles {
+		list = append(list, c.toArticleItem(ctx, article))
+	}
+	return &v1.ScheduledRes{
+		Page:  req.Page,
+		Size:  req.Size,
+		Total: total,
+		List:  list,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package blog
+
+import (
+	"context"
+	"time"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Search(ctx context.Context, req *v1.SearchReq) (res *v1.SearchRes, err error) {
+	hits, total, err := service.BlogSearch().Search(ctx, &service.SearchInput{
+		Query:              req.Q,
+		Page:               req.Page,
+		Size:               req.Size,
+		CategoryId:         req.CategoryId,
+		Tag:                req.Tag,
+		IncludeDescendants: req.IncludeChildren,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]v1.SearchItem, 0, len(hits))
+	for _, h := range hits {
+		var publishAt *time.Time
+		if h.PublishAt != nil {
+			publishAt = &h.PublishAt.Time
+		}
+		item := v1.SearchItem{
+			Id:             h.Id,
+			Title:          h.Title,
+			TitleHighlight: h.TitleHighlight,
+			Slug:           h.Slug,
+			Summary:        h.Summary,
+			Headline:       h.Headline,
+			CategoryId:     h.CategoryId,
+			FeaturedImage:  h.FeaturedImage,
+			PublishAt:      publishAt,
+			Rank:           h.Rank,
+		}
+		tags, _ := c.getArticleTags(ctx, h.Id)
+		for _, tag := range tags {
+			item.Tags = append(item.Tags, v1.TagItem{Id: tag.Id, Name: tag.Name, Slug: tag.Slug})
+		}
+		list = append(list, item)
+	}
+
+	return &v1.SearchRes{
+		Page:  req.Page,
+		Size:  req.Size,
+		Total: total,
+		List:  list,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) SendTestMail(ctx context.Context, req *v1.SendTestMailReq) (res *v1.SendTestMailRes, err error) {
+	if err = service.MailOutbox().SendTest(ctx, req.To); err != nil {
+		return nil, err
+	}
+	return &v1.SendTestMailRes{Sent: true}, nil
+}
@@ -0,0 +1,23 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) SeriesDetail(ctx context.Context, req *v1.SeriesDetailReq) (res *v1.SeriesDetailRes, err error) {
+	index, err := service.BlogSeries().Index(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	articles := make([]v1.ArticleItem, 0, len(index.Articles))
+	for _, article := range index.Articles {
+		articles = append(articles, c.toArticleItem(ctx, article))
+	}
+	return &v1.SeriesDetailRes{
+		SeriesItem: toSeriesItem(index.Series, len(index.Articles)),
+		Articles:   articles,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/model/entity"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) SeriesNav(ctx context.Context, req *v1.SeriesNavReq) (res *v1.SeriesNavRes, err error) {
+	nav, err := service.BlogSeries().Navigation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	res = &v1.SeriesNavRes{}
+	if nav == nil {
+		return res, nil
+	}
+	item := toSeriesItem(nav.Series, nav.Total)
+	res.Series = &item
+	res.Position = nav.Position
+	res.Total = nav.Total
+	res.Prev = toSeriesNavLink(nav.Prev)
+	res.Next = toSeriesNavLink(nav.Next)
+	return res, nil
+}
+
+// toSeriesNavLink 转换上一篇/下一篇链接（辅助方法）
+func toSeriesNavLink(article *entity.BlogArticles) *v1.SeriesNavLink {
+	if article == nil {
+		return nil
+	}
+	return &v1.SeriesNavLink{Id: article.Id, Title: article.Title, Slug: article.Slug}
+}
@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) SetArticlePassword(ctx context.Context, req *v1.SetArticlePasswordReq) (res *v1.SetArticlePasswordRes, err error) {
+	if err = service.BlogProtect().SetPassword(ctx, req.Id, req.Password); err != nil {
+		return nil, err
+	}
+	return &v1.SetArticlePasswordRes{Protected: req.Password != ""}, nil
+}
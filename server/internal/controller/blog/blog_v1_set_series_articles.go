@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) SetSeriesArticles(ctx context.Context, req *v1.SetSeriesArticlesReq) (res *v1.SetSeriesArticlesRes, err error) {
+	if err = service.BlogSeries().SetArticles(ctx, req.Id, req.ArticleIds); err != nil {
+		return nil, err
+	}
+	return &v1.SetSeriesArticlesRes{Updated: true}, nil
+}
@@ -0,0 +1,16 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Share(ctx context.Context, req *v1.ShareReq) (res *v1.ShareRes, err error) {
+	count, err := service.BlogCounter().RecordShare(ctx, req.Id, req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ShareRes{ShareCount: count}, nil
+}
@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Subscribe(ctx context.Context, req *v1.SubscribeReq) (res *v1.SubscribeRes, err error) {
+	if err = service.BlogNewsletter().Subscribe(ctx, req.Email, req.Name, req.Frequency, req.CategoryIds); err != nil {
+		return nil, err
+	}
+	return &v1.SubscribeRes{Pending: true}, nil
+}
@@ -0,0 +1,44 @@
+package blog
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) TagArticles(ctx context.Context, req *v1.TagArticlesReq) (res *v1.TagArticlesRes, err error) {
+	tag, err := service.BlogTag().GetBySlug(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	if !tag.IsActive {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "标签不存在")
+	}
+
+	// 仅列出已发布文章
+	articles, total, err := service.BlogSimple().ListArticles(ctx, &service.ArticleListInput{
+		Page:   req.Page,
+		Size:   req.Size,
+		Status: service.ArticleStatusPublished,
+		Tag:    tag.Slug,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]v1.ArticleItem, 0, len(articles))
+	for _, article := range articles {
+		list = append(list, c.toArticleItem(ctx, article))
+	}
+	return &v1.TagArticlesRes{
+		Tag:   toTagDetailItem(tag),
+		Page:  req.Page,
+		Size:  req.Size,
+		Total: total,
+		List:  list,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) TagCloud(ctx context.Context, req *v1.TagCloudReq) (res *v1.TagCloudRes, err error) {
+	items, err := service.BlogTag().Cloud(ctx, req.Limit, req.Levels)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]v1.TagCloudItem, 0, len(items))
+	for _, item := range items {
+		list = append(list, v1.TagCloudItem{
+			Id:           item.Tag.Id,
+			Name:         item.Tag.Name,
+			Slug:         item.Tag.Slug,
+			Color:        item.Tag.Color,
+			ArticleCount: item.Tag.ArticleCount,
+			Weight:       item.Weight,
+		})
+	}
+	return &v1.TagCloudRes{List: list}, nil
+}
@@ -0,0 +1,39 @@
+package blog
+
+import (
+	"context"
+	"time"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Trash(ctx context.Context, req *v1.TrashReq) (res *v1.TrashRes, err error) {
+	items, total, err := service.BlogTrash().List(ctx, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]v1.TrashItem, 0, len(items))
+	for _, item := range items {
+		var deletedAt, purgeAt *time.Time
+		if item.Article.DeletedAt != nil {
+			deletedAt = &item.Article.DeletedAt.Time
+		}
+		if item.PurgeAt != nil {
+			purgeAt = &item.PurgeAt.Time
+		}
+		list = append(list, v1.TrashItem{
+			ArticleItem: c.toArticleItem(ctx, item.Article),
+			DeletedAt:   deletedAt,
+			PurgeAt:     purgeAt,
+		})
+	}
+	return &v1.TrashRes{
+		Page:          req.Page,
+		Size:          req.Size,
+		Total:         total,
+		RetentionDays: service.BlogTrash().RetentionDays(ctx),
+		List:          list,
+	}, nil
+}
@@ -0,0 +1,16 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Unlike(ctx context.Context, req *v1.UnlikeReq) (res *v1.UnlikeRes, err error) {
+	count, err := service.BlogCounter().Unlike(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UnlikeRes{Liked: false, LikeCount: count}, nil
+}
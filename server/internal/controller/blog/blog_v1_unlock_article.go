@@ -0,0 +1,16 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) UnlockArticle(ctx context.Context, req *v1.UnlockArticleReq) (res *v1.UnlockArticleRes, err error) {
+	token, expiresAt, err := service.BlogProtect().Unlock(ctx, req.Id, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UnlockArticleRes{Token: token, ExpiresAt: expiresAt}, nil
+}
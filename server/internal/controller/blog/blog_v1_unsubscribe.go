@@ -0,0 +1,15 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Unsubscribe(ctx context.Context, req *v1.UnsubscribeReq) (res *v1.UnsubscribeRes, err error) {
+	if err = service.BlogNewsletter().Unsubscribe(ctx, req.Token); err != nil {
+		return nil, err
+	}
+	return &v1.UnsubscribeRes{Unsubscribed: true}, nil
+}
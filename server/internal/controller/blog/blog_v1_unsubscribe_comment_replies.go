@@ -0,0 +1,16 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) UnsubscribeCommentReplies(ctx context.Context, req *v1.UnsubscribeCommentRepliesReq) (res *v1.UnsubscribeCommentRepliesRes, err error) {
+	threadId, err := service.BlogCommentNotify().Unsubscribe(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UnsubscribeCommentRepliesRes{ThreadId: threadId}, nil
+}
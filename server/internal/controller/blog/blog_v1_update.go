@@ -26,6 +26,10 @@ func (c *ControllerV1) Update(ctx context.Context, req *v1.UpdateReq) (res *v1.U
 		"isPrivate":     req.IsPrivate,
 		"featuredImage": req.FeaturedImage,
 		"publishAt":     req.PublishAt,
+		"language":      req.Language,
+		"seo":           &req.SEO,
+		"tags":          req.Tags,
+		"changeSummary": req.ChangeSummary,
 	}
 
 	// 调用服务层更新文章
@@ -0,0 +1,23 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) UpdateCategory(ctx context.Context, req *v1.UpdateCategoryReq) (res *v1.UpdateCategoryRes, err error) {
+	err = service.BlogCategory().Update(ctx, req.Id, &service.CategoryInput{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+		ParentId:    req.ParentId,
+		SortOrder:   req.SortOrder,
+		IsActive:    req.IsActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateCategoryRes{Updated: true}, nil
+}
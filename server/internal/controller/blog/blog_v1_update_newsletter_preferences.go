@@ -0,0 +1,16 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) UpdateNewsletterPreferences(ctx context.Context, req *v1.UpdateNewsletterPreferencesReq) (res *v1.UpdateNewsletterPreferencesRes, err error) {
+	prefs, err := service.BlogNewsletter().UpdatePreferences(ctx, req.Token, req.Name, req.Frequency, req.CategoryIds)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateNewsletterPreferencesRes{NewsletterPreferencesItem: toNewsletterPreferencesItem(prefs)}, nil
+}
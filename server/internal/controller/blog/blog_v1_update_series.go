@@ -0,0 +1,21 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) UpdateSeries(ctx context.Context, req *v1.UpdateSeriesReq) (res *v1.UpdateSeriesRes, err error) {
+	err = service.BlogSeries().Update(ctx, req.Id, &service.SeriesInput{
+		Title:       req.Title,
+		Slug:        req.Slug,
+		Description: req.Description,
+		CoverImage:  req.CoverImage,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateSeriesRes{Updated: true}, nil
+}
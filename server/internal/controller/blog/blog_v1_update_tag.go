@@ -0,0 +1,22 @@
+package blog
+
+import (
+	"context"
+
+	"server/api/blog/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) UpdateTag(ctx context.Context, req *v1.UpdateTagReq) (res *v1.UpdateTagRes, err error) {
+	err = service.BlogTag().Update(ctx, req.Id, &service.TagInput{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+		Color:       req.Color,
+		IsActive:    req.IsActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateTagRes{Updated: true}, nil
+}
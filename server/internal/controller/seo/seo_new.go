@@ -0,0 +1,15 @@
+// =================================================================================
+// This is auto-generated by GoFrame CLI tool only once. Fill this file as you wish.
+// =================================================================================
+
+package seo
+
+import (
+	"server/api/seo"
+)
+
+type ControllerV1 struct{}
+
+func NewV1() seo.ISeoV1 {
+	return &ControllerV1{}
+}
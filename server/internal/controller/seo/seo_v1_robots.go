@@ -0,0 +1,27 @@
+package seo
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/api/seo/v1"
+	"server/internal/service"
+	"server/utility"
+)
+
+func (c *ControllerV1) Robots(ctx context.Context, req *v1.RobotsReq) (res *v1.RobotsRes, err error) {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return nil, gerror.New("无法获取HTTP请求对象")
+	}
+
+	doc, err := service.Sitemap().Robots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	utility.ServeContent(r, doc.ContentType, doc.Body, doc.ETag, doc.LastModified, 3600)
+	return &v1.RobotsRes{}, nil
+}
@@ -0,0 +1,24 @@
+package weibo
+
+import (
+	"context"
+
+	"server/api/weibo/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Archive(ctx context.Context, req *v1.ArchiveReq) (res *v1.ArchiveRes, err error) {
+	years, err := service.Weibo().ArchiveMonths(ctx, req.Visibility)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]v1.ArchiveYearItem, 0, len(years))
+	for _, y := range years {
+		months := make([]v1.ArchiveMonthItem, 0, len(y.Months))
+		for _, m := range y.Months {
+			months = append(months, v1.ArchiveMonthItem{Month: m.Month, Count: m.Count})
+		}
+		items = append(items, v1.ArchiveYearItem{Year: y.Year, Count: y.Count, Months: months})
+	}
+	return &v1.ArchiveRes{Total: service.ArchiveTotal(years), Years: items}, nil
+}
@@ -0,0 +1,31 @@
+package weibo
+
+import (
+	"context"
+
+	"server/api/weibo/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ArchivePosts(ctx context.Context, req *v1.ArchivePostsReq) (res *v1.ArchivePostsRes, err error) {
+	posts, assetsMap, total, err := service.Weibo().ArchiveList(ctx, req.Year, req.Month, req.Visibility, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	entities, err := service.WeiboTopic().Entities(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]v1.WeiboItem, 0, len(posts))
+	for _, p := range posts {
+		items = append(items, toWeiboItem(ctx, p, assetsMap[p.Id], entities[p.Id]))
+	}
+	return &v1.ArchivePostsRes{
+		Year:  req.Year,
+		Month: req.Month,
+		Page:  req.Page,
+		Size:  req.Size,
+		Total: total,
+		List:  items,
+	}, nil
+}
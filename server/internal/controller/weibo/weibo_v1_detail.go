@@ -6,6 +6,7 @@ import (
 	"github.com/gogf/gf/v2/errors/gerror"
 
 	"server/api/weibo/v1"
+	"server/internal/model/entity"
 	"server/internal/service"
 )
 
@@ -28,6 +29,17 @@ func (c *ControllerV1) Detail(ctx context.Context, req *v1.DetailReq) (res *v1.D
 	for _, a := range assets {
 		aset = append(aset, v1.AssetItem{FileId: a.FileId, Kind: a.Kind})
 	}
+	entities, err := service.WeiboTopic().Entities(ctx, []*entity.WeiboPosts{post})
+	if err != nil {
+		return nil, err
+	}
+	ogImage, err := service.ShareImage().URL(ctx, service.ShareSubjectWeibo, post.Id)
+	if err != nil {
+		return nil, err
+	}
+	if ogImage != "" {
+		ogImage = service.LoadSiteInfo(ctx).AbsURL(ogImage)
+	}
 
 	return &v1.DetailRes{
 		Id:         post.Id,
@@ -45,10 +57,13 @@ func (c *ControllerV1) Detail(ctx context.Context, req *v1.DetailReq) (res *v1.D
 			}
 			return ""
 		}(),
-		City:   post.City,
-		Lat:    latPtr,
-		Lng:    lngPtr,
-		Device: post.Device,
-		Assets: aset,
+		City:     post.City,
+		Lat:      latPtr,
+		Lng:      lngPtr,
+		Device:   post.Device,
+		Assets:   aset,
+		Author:   toAuthorBrief(service.User().Brief(ctx, post.AuthorId)),
+		Entities: toContentEntities(entities[post.Id]),
+		OgImage:  ogImage,
 	}, nil
 }
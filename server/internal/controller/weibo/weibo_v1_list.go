@@ -6,7 +6,9 @@ import (
 	"github.com/gogf/gf/v2/errors/gerror"
 
 	"server/api/weibo/v1"
+	"server/internal/model/entity"
 	"server/internal/service"
+	"server/internal/service/weibotext"
 )
 
 func (c *ControllerV1) List(ctx context.Context, req *v1.ListReq) (res *v1.ListRes, err error) {
@@ -14,39 +16,14 @@ func (c *ControllerV1) List(ctx context.Context, req *v1.ListReq) (res *v1.ListR
 	if err != nil {
 		return nil, gerror.Wrap(err, "查询微博列表失败")
 	}
+	entities, err := service.WeiboTopic().Entities(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
 
 	items := make([]v1.WeiboItem, 0, len(posts))
 	for _, p := range posts {
-		var latPtr, lngPtr *float64
-		if p.Lat != 0 {
-			lat := p.Lat
-			latPtr = &lat
-		}
-		if p.Lng != 0 {
-			lng := p.Lng
-			lngPtr = &lng
-		}
-		assets := make([]v1.AssetItem, 0)
-		for _, a := range assetsMap[p.Id] {
-			assets = append(assets, v1.AssetItem{FileId: a.FileId, Kind: a.Kind})
-		}
-		item := v1.WeiboItem{
-			Id:         p.Id,
-			Content:    p.Content,
-			Visibility: p.Visibility,
-			CreatedAt: func() string {
-				if p.CreatedAt != nil {
-					return p.CreatedAt.String()
-				}
-				return ""
-			}(),
-			City:   p.City,
-			Lat:    latPtr,
-			Lng:    lngPtr,
-			Device: p.Device,
-			Assets: assets,
-		}
-		items = append(items, item)
+		items = append(items, toWeiboItem(ctx, p, assetsMap[p.Id], entities[p.Id]))
 	}
 
 	page := req.Page
@@ -60,3 +37,61 @@ func (c *ControllerV1) List(ctx context.Context, req *v1.ListReq) (res *v1.ListR
 
 	return &v1.ListRes{Page: page, Size: size, Total: total, List: items}, nil
 }
+
+// toWeiboItem 转换微博列表项（辅助方法）
+func toWeiboItem(ctx context.Context, p *entity.WeiboPosts, postAssets []*entity.WeiboAssets, entities []*service.WeiboEntity) v1.WeiboItem {
+	var latPtr, lngPtr *float64
+	if p.Lat != 0 {
+		lat := p.Lat
+		latPtr = &lat
+	}
+	if p.Lng != 0 {
+		lng := p.Lng
+		lngPtr = &lng
+	}
+	assets := make([]v1.AssetItem, 0)
+	for _, a := range postAssets {
+		assets = append(assets, v1.AssetItem{FileId: a.FileId, Kind: a.Kind})
+	}
+	return v1.WeiboItem{
+		Id:         p.Id,
+		Content:    p.Content,
+		Visibility: p.Visibility,
+		CreatedAt: func() string {
+			if p.CreatedAt != nil {
+				return p.CreatedAt.String()
+			}
+			return ""
+		}(),
+		City:     p.City,
+		Lat:      latPtr,
+		Lng:      lngPtr,
+		Device:   p.Device,
+		Assets:   assets,
+		Author:   toAuthorBrief(service.User().Brief(ctx, p.AuthorId)),
+		Entities: toContentEntities(entities),
+	}
+}
+
+// toContentEntities 转换正文实体（辅助方法）
+func toContentEntities(entities []*service.WeiboEntity) []v1.ContentEntity {
+	out := make([]v1.ContentEntity, 0, len(entities))
+	for _, e := range entities {
+		item := v1.ContentEntity{Type: e.Type, Start: e.Start, End: e.End, Text: e.Text}
+		if e.Type == weibotext.TypeMention {
+			item.Username, item.UserId = e.Value, e.UserId
+		} else {
+			item.Topic = e.Value
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// toAuthorBrief 转换作者简要信息（辅助方法）
+func toAuthorBrief(a *service.AuthorBrief) *v1.AuthorBrief {
+	if a == nil {
+		return nil
+	}
+	return &v1.AuthorBrief{Id: a.Id, Username: a.Username, DisplayName: a.DisplayName, AvatarUrl: a.AvatarUrl}
+}
@@ -0,0 +1,16 @@
+package weibo
+
+import (
+	"context"
+
+	"server/api/weibo/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) ReindexTopics(ctx context.Context, req *v1.ReindexTopicsReq) (res *v1.ReindexTopicsRes, err error) {
+	n, err := service.WeiboTopic().Reindex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ReindexTopicsRes{Posts: n}, nil
+}
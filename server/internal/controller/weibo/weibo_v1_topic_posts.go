@@ -0,0 +1,34 @@
+package weibo
+
+import (
+	"context"
+
+	"server/api/weibo/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) TopicPosts(ctx context.Context, req *v1.TopicPostsReq) (res *v1.TopicPostsRes, err error) {
+	topic, err := service.WeiboTopic().Get(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	posts, assetsMap, total, err := service.WeiboTopic().Posts(ctx, topic.Id, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	entities, err := service.WeiboTopic().Entities(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]v1.WeiboItem, 0, len(posts))
+	for _, p := range posts {
+		items = append(items, toWeiboItem(ctx, p, assetsMap[p.Id], entities[p.Id]))
+	}
+	return &v1.TopicPostsRes{
+		Topic: toTopicItem(topic),
+		Page:  req.Page,
+		Size:  req.Size,
+		Total: total,
+		List:  items,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package weibo
+
+import (
+	"context"
+
+	"server/api/weibo/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) Topics(ctx context.Context, req *v1.TopicsReq) (res *v1.TopicsRes, err error) {
+	topics, total, err := service.WeiboTopic().List(ctx, req.Keyword, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TopicsRes{Page: req.Page, Size: req.Size, Total: total, List: toTopicItems(topics)}, nil
+}
+
+// toTopicItem 转换话题信息（辅助方法）
+func toTopicItem(t *service.TopicStat) v1.TopicItem {
+	item := v1.TopicItem{Id: t.Id, Name: t.Name, PostCount: t.PostCount}
+	if t.LastPostAt != nil {
+		item.LastPostAt = t.LastPostAt.String()
+	}
+	return item
+}
+
+// toTopicItems 批量转换话题信息（辅助方法）
+func toTopicItems(topics []*service.TopicStat) []v1.TopicItem {
+	items := make([]v1.TopicItem, 0, len(topics))
+	for _, t := range topics {
+		items = append(items, toTopicItem(t))
+	}
+	return items
+}
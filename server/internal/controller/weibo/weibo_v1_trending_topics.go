@@ -0,0 +1,16 @@
+package weibo
+
+import (
+	"context"
+
+	"server/api/weibo/v1"
+	"server/internal/service"
+)
+
+func (c *ControllerV1) TrendingTopics(ctx context.Context, req *v1.TrendingTopicsReq) (res *v1.TrendingTopicsRes, err error) {
+	topics, err := service.WeiboTopic().Trending(ctx, req.Hours, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TrendingTopicsRes{List: toTopicItems(topics)}, nil
+}
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogArticleLikesDao is the data access object for the table blog_article_likes.
+// You can define custom methods on it to extend its functionality as needed.
+type blogArticleLikesDao struct {
+	*internal.BlogArticleLikesDao
+}
+
+var (
+	// BlogArticleLikes is a globally accessible object for table blog_article_likes operations.
+	BlogArticleLikes = blogArticleLikesDao{internal.NewBlogArticleLikesDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogArticleSlugRedirectsDao is the data access object for the table blog_article_slug_redirects.
+// You can define custom methods on it to extend its functionality as needed.
+type blogArticleSlugRedirectsDao struct {
+	*internal.BlogArticleSlugRedirectsDao
+}
+
+var (
+	// BlogArticleSlugRedirects is a globally accessible object for table blog_article_slug_redirects operations.
+	BlogArticleSlugRedirects = blogArticleSlugRedirectsDao{internal.NewBlogArticleSlugRedirectsDao()}
+)
+
+// Add your custom methods and functionality below.
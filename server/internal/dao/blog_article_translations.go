@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogArticleTranslationsDao is the data access object for the table blog_article_translations.
+// You can define custom methods on it to extend its functionality as needed.
+type blogArticleTranslationsDao struct {
+	*internal.BlogArticleTranslationsDao
+}
+
+var (
+	// BlogArticleTranslations is a globally accessible object for table blog_article_translations operations.
+	BlogArticleTranslations = blogArticleTranslationsDao{internal.NewBlogArticleTranslationsDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogArticleVersionsDao is the data access object for the table blog_article_versions.
+// You can define custom methods on it to extend its functionality as needed.
+type blogArticleVersionsDao struct {
+	*internal.BlogArticleVersionsDao
+}
+
+var (
+	// BlogArticleVersions is a globally accessible object for table blog_article_versions operations.
+	BlogArticleVersions = blogArticleVersionsDao{internal.NewBlogArticleVersionsDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogCommentUnsubscribesDao is the data access object for the table blog_comment_unsubscribes.
+// You can define custom methods on it to extend its functionality as needed.
+type blogCommentUnsubscribesDao struct {
+	*internal.BlogCommentUnsubscribesDao
+}
+
+var (
+	// BlogCommentUnsubscribes is a globally accessible object for table blog_comment_unsubscribes operations.
+	BlogCommentUnsubscribes = blogCommentUnsubscribesDao{internal.NewBlogCommentUnsubscribesDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogNewsletterPostsDao is the data access object for the table blog_newsletter_posts.
+// You can define custom methods on it to extend its functionality as needed.
+type blogNewsletterPostsDao struct {
+	*internal.BlogNewsletterPostsDao
+}
+
+var (
+	// BlogNewsletterPosts is a globally accessible object for table blog_newsletter_posts operations.
+	BlogNewsletterPosts = blogNewsletterPostsDao{internal.NewBlogNewsletterPostsDao()}
+)
+
+// Add your custom methods and functionality below.
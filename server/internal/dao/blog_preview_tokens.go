@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogPreviewTokensDao is the data access object for the table blog_preview_tokens.
+// You can define custom methods on it to extend its functionality as needed.
+type blogPreviewTokensDao struct {
+	*internal.BlogPreviewTokensDao
+}
+
+var (
+	// BlogPreviewTokens is a globally accessible object for table blog_preview_tokens operations.
+	BlogPreviewTokens = blogPreviewTokensDao{internal.NewBlogPreviewTokensDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogSeoDataDao is the data access object for the table blog_seo_data.
+// You can define custom methods on it to extend its functionality as needed.
+type blogSeoDataDao struct {
+	*internal.BlogSeoDataDao
+}
+
+var (
+	// BlogSeoData is a globally accessible object for table blog_seo_data operations.
+	BlogSeoData = blogSeoDataDao{internal.NewBlogSeoDataDao()}
+)
+
+// Add your custom methods and functionality below.
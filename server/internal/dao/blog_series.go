@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogSeriesDao is the data access object for the table blog_series.
+// You can define custom methods on it to extend its functionality as needed.
+type blogSeriesDao struct {
+	*internal.BlogSeriesDao
+}
+
+var (
+	// BlogSeries is a globally accessible object for table blog_series operations.
+	BlogSeries = blogSeriesDao{internal.NewBlogSeriesDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogSeriesArticlesDao is the data access object for the table blog_series_articles.
+// You can define custom methods on it to extend its functionality as needed.
+type blogSeriesArticlesDao struct {
+	*internal.BlogSeriesArticlesDao
+}
+
+var (
+	// BlogSeriesArticles is a globally accessible object for table blog_series_articles operations.
+	BlogSeriesArticles = blogSeriesArticlesDao{internal.NewBlogSeriesArticlesDao()}
+)
+
+// Add your custom methods and functionality below.
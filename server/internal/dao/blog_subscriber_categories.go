@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogSubscriberCategoriesDao is the data access object for the table blog_subscriber_categories.
+// You can define custom methods on it to extend its functionality as needed.
+type blogSubscriberCategoriesDao struct {
+	*internal.BlogSubscriberCategoriesDao
+}
+
+var (
+	// BlogSubscriberCategories is a globally accessible object for table blog_subscriber_categories operations.
+	BlogSubscriberCategories = blogSubscriberCategoriesDao{internal.NewBlogSubscriberCategoriesDao()}
+)
+
+// Add your custom methods and functionality below.
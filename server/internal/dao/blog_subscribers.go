@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogSubscribersDao is the data access object for the table blog_subscribers.
+// You can define custom methods on it to extend its functionality as needed.
+type blogSubscribersDao struct {
+	*internal.BlogSubscribersDao
+}
+
+var (
+	// BlogSubscribers is a globally accessible object for table blog_subscribers operations.
+	BlogSubscribers = blogSubscribersDao{internal.NewBlogSubscribersDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogWebmentionScansDao is the data access object for the table blog_webmention_scans.
+// You can define custom methods on it to extend its functionality as needed.
+type blogWebmentionScansDao struct {
+	*internal.BlogWebmentionScansDao
+}
+
+var (
+	// BlogWebmentionScans is a globally accessible object for table blog_webmention_scans operations.
+	BlogWebmentionScans = blogWebmentionScansDao{internal.NewBlogWebmentionScansDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogWebmentionSendsDao is the data access object for the table blog_webmention_sends.
+// You can define custom methods on it to extend its functionality as needed.
+type blogWebmentionSendsDao struct {
+	*internal.BlogWebmentionSendsDao
+}
+
+var (
+	// BlogWebmentionSends is a globally accessible object for table blog_webmention_sends operations.
+	BlogWebmentionSends = blogWebmentionSendsDao{internal.NewBlogWebmentionSendsDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// blogWebmentionsDao is the data access object for the table blog_webmentions.
+// You can define custom methods on it to extend its functionality as needed.
+type blogWebmentionsDao struct {
+	*internal.BlogWebmentionsDao
+}
+
+var (
+	// BlogWebmentions is a globally accessible object for table blog_webmentions operations.
+	BlogWebmentions = blogWebmentionsDao{internal.NewBlogWebmentionsDao()}
+)
+
+// Add your custom methods and functionality below.
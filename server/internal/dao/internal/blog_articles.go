@@ -44,6 +44,9 @@ type BlogArticlesColumns struct {
 	CreatedAt     string //
 	UpdatedAt     string //
 	DeletedAt     string //
+	SearchVector  string //
+	PasswordHash  string //
+	Language      string //
 }
 
 // blogArticlesColumns holds the columns for the table blog_articles.
@@ -71,6 +74,9 @@ var blogArticlesColumns = BlogArticlesColumns{
 	CreatedAt:     "created_at",
 	UpdatedAt:     "updated_at",
 	DeletedAt:     "deleted_at",
+	SearchVector:  "search_vector",
+	PasswordHash:  "password_hash",
+	Language:      "language",
 }
 
 // NewBlogArticlesDao creates and returns a new DAO object for table data access.
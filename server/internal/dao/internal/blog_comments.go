@@ -21,36 +21,42 @@ type BlogCommentsDao struct {
 
 // BlogCommentsColumns defines and stores column names for the table blog_comments.
 type BlogCommentsColumns struct {
-	Id             string //
-	CommentId      string //
-	ArticleId      string //
-	ParentId       string //
-	VisitorName    string //
-	VisitorEmail   string //
-	VisitorWebsite string //
-	Content        string //
-	HtmlContent    string //
-	Status         string //
-	IsDeleted      string //
-	CreatedAt      string //
-	UpdatedAt      string //
+	Id                string //
+	CommentId         string //
+	ArticleId         string //
+	ParentId          string //
+	VisitorName       string //
+	VisitorEmail      string //
+	VisitorWebsite    string //
+	Content           string //
+	HtmlContent       string //
+	Ip                string //
+	DownloadUserAgent string //
+	Status            string //
+	IsDeleted         string //
+	CreatedAt         string //
+	UpdatedAt         string //
+	SpamReasons       string //
 }
 
 // blogCommentsColumns holds the columns for the table blog_comments.
 var blogCommentsColumns = BlogCommentsColumns{
-	Id:             "id",
-	CommentId:      "comment_id",
-	ArticleId:      "article_id",
-	ParentId:       "parent_id",
-	VisitorName:    "visitor_name",
-	VisitorEmail:   "visitor_email",
-	VisitorWebsite: "visitor_website",
-	Content:        "content",
-	HtmlContent:    "html_content",
-	Status:         "status",
-	IsDeleted:      "is_deleted",
-	CreatedAt:      "created_at",
-	UpdatedAt:      "updated_at",
+	Id:                "id",
+	CommentId:         "comment_id",
+	ArticleId:         "article_id",
+	ParentId:          "parent_id",
+	VisitorName:       "visitor_name",
+	VisitorEmail:      "visitor_email",
+	VisitorWebsite:    "visitor_website",
+	Content:           "content",
+	HtmlContent:       "html_content",
+	Ip:                "ip",
+	DownloadUserAgent: "download_user_agent",
+	Status:            "status",
+	IsDeleted:         "is_deleted",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+	SpamReasons:       "spam_reasons",
 }
 
 // NewBlogCommentsDao creates and returns a new DAO object for table data access.
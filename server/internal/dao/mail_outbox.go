@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// mailOutboxDao is the data access object for the table mail_outbox.
+// You can define custom methods on it to extend its functionality as needed.
+type mailOutboxDao struct {
+	*internal.MailOutboxDao
+}
+
+var (
+	// MailOutbox is a globally accessible object for table mail_outbox operations.
+	MailOutbox = mailOutboxDao{internal.NewMailOutboxDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// shareImagesDao is the data access object for the table share_images.
+// You can define custom methods on it to extend its functionality as needed.
+type shareImagesDao struct {
+	*internal.ShareImagesDao
+}
+
+var (
+	// ShareImages is a globally accessible object for table share_images operations.
+	ShareImages = shareImagesDao{internal.NewShareImagesDao()}
+)
+
+// Add your custom methods and functionality below.
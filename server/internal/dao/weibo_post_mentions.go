@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// weiboPostMentionsDao is the data access object for the table weibo_post_mentions.
+// You can define custom methods on it to extend its functionality as needed.
+type weiboPostMentionsDao struct {
+	*internal.WeiboPostMentionsDao
+}
+
+var (
+	// WeiboPostMentions is a globally accessible object for table weibo_post_mentions operations.
+	WeiboPostMentions = weiboPostMentionsDao{internal.NewWeiboPostMentionsDao()}
+)
+
+// Add your custom methods and functionality below.
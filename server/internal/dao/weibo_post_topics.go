@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// weiboPostTopicsDao is the data access object for the table weibo_post_topics.
+// You can define custom methods on it to extend its functionality as needed.
+type weiboPostTopicsDao struct {
+	*internal.WeiboPostTopicsDao
+}
+
+var (
+	// WeiboPostTopics is a globally accessible object for table weibo_post_topics operations.
+	WeiboPostTopics = weiboPostTopicsDao{internal.NewWeiboPostTopicsDao()}
+)
+
+// Add your custom methods and functionality below.
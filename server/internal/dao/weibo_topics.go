@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"server/internal/dao/internal"
+)
+
+// weiboTopicsDao is the data access object for the table weibo_topics.
+// You can define custom methods on it to extend its functionality as needed.
+type weiboTopicsDao struct {
+	*internal.WeiboTopicsDao
+}
+
+var (
+	// WeiboTopics is a globally accessible object for table weibo_topics operations.
+	WeiboTopics = weiboTopicsDao{internal.NewWeiboTopicsDao()}
+)
+
+// Add your custom methods and functionality below.
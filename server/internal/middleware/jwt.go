@@ -23,6 +23,8 @@ import (
 
 	"github.com/gogf/gf/v2/net/ghttp"
 
+	"server/internal/model/entity"
+	"server/internal/service"
 	"server/internal/service/auth"
 )
 
@@ -66,8 +68,9 @@ func getMetaTag(r *ghttp.Request, key string) string {
 //  1. 检查路由是否标记为公开接口（noAuth:"true"）
 //  2. 从URL参数或Authorization头部提取JWT Token
 //  3. 验证Token的有效性和完整性
-//  4. 解析JWT Claims并注入请求上下文
-//  5. 继续执行后续中间件和控制器
+//  4. 加载令牌对应的用户（已停用或令牌已失效时拒绝），解析JWT Claims并注入请求上下文
+//  5. 检查接口要求的最低角色（roles:"editor"、roles:"admin"）
+//  6. 继续执行后续中间件和控制器
 //
 // Token获取优先级：
 //  1. URL查询参数 ?token=xxx（用于静默登录、调试等场景）
@@ -75,6 +78,8 @@ func getMetaTag(r *ghttp.Request, key string) string {
 //
 // 上下文注入的变量：
 //   - auth.subject: JWT的Subject字段，通常为用户ID
+//   - auth.user_id: 用户ID
+//   - auth.role: 用户角色（admin、editor、author）
 //   - auth.iat: Token签发时间（Unix时间戳）
 //   - auth.exp: Token过期时间（Unix时间戳）
 //   - auth.via: Token来源标识（"url"或"header"）
@@ -84,6 +89,7 @@ func getMetaTag(r *ghttp.Request, key string) string {
 //
 // 响应状态码：
 //   - 401: Token缺失、无效或已过期
+//   - 403: 用户角色低于接口要求
 //   - 继续执行: Token验证成功，继续后续处理
 //
 // 使用示例：
@@ -99,7 +105,7 @@ func getMetaTag(r *ghttp.Request, key string) string {
 //
 //	// 3. 在控制器中读取用户信息
 //	func (c *Controller) GetProfile(ctx context.Context, req *v1.ProfileReq) (res *v1.ProfileRes, err error) {
-//	    userID := gconv.String(g.RequestFromCtx(ctx).GetCtxVar("auth.subject"))
+//	    userID := auth.CurrentUserID(ctx)
 //	    tokenSource := gconv.String(g.RequestFromCtx(ctx).GetCtxVar("auth.via"))
 //	}
 //
@@ -110,25 +116,24 @@ func getMetaTag(r *ghttp.Request, key string) string {
 func MiddlewareJWT(r *ghttp.Request) {
 	// 第一步：检查是否为公开接口
 	// 通过读取控制器方法的g.Meta标签判断是否需要鉴权
-	// 如果标记了noAuth:"true"，则跳过所有鉴权逻辑
+	// 如果标记了noAuth:"true"，则不强制鉴权；但若请求携带了有效Token，
+	// 仍会注入用户信息，便于公开接口区分匿名访客与已登录用户
 	if strings.EqualFold(getMetaTag(r, "noAuth"), "true") {
+		if token, via := extractToken(r); token != "" {
+			if claims, err := auth.ValidateToken(r.GetCtx(), token); err == nil && claims != nil {
+				if user, err := service.User().Resolve(r.GetCtx(), claims); err == nil {
+					injectClaims(r, claims, user, via)
+				}
+			}
+		}
 		r.Middleware.Next()
 		return
 	}
 
 	// 第二步：提取JWT Token
-	// 优先从URL查询参数中获取token（用于静默登录、调试等场景）
-	token := r.GetQuery("token").String()
-
+	// 优先从URL查询参数中获取token（用于静默登录、调试等场景），
 	// 如果URL中没有token，则尝试从Authorization头部获取
-	if token == "" {
-		authz := r.Header.Get("Authorization")
-		// 检查是否为标准的Bearer Token格式
-		if strings.HasPrefix(strings.ToLower(authz), "bearer ") {
-			// 提取Bearer后面的token部分，并去除首尾空格
-			token = strings.TrimSpace(authz[7:])
-		}
-	}
+	token, via := extractToken(r)
 
 	// 如果两种方式都没有获取到token，返回401未授权
 	if token == "" {
@@ -146,11 +151,51 @@ func MiddlewareJWT(r *ghttp.Request) {
 		return
 	}
 
-	// 第四步：注入用户信息到请求上下文
-	// 将JWT Claims中的关键信息注入到请求上下文，便于后续控制器使用
+	// 第四步：加载用户并注入请求上下文
+	// 用户不存在、已停用或令牌签发早于用户的令牌失效时间时同样返回401
+	user, err := service.User().Resolve(r.GetCtx(), claims)
+	if err != nil {
+		g.Log().Info(r.GetCtx(), "Token用户无效: ", claims.Subject, "; err: ", err, ";")
+		r.Response.WriteStatusExit(401)
+		return
+	}
+	injectClaims(r, claims, user, via)
+
+	// 第五步：检查角色
+	// roles 标签声明接口要求的最低角色，例如站点配置仅管理员可用
+	if required := getMetaTag(r, "roles"); !auth.RoleAtLeast(user.Role, required) {
+		r.Response.WriteStatusExit(403)
+		return
+	}
+
+	// 第六步：继续执行后续中间件和控制器
+	// 鉴权成功，允许请求继续处理
+	r.Middleware.Next()
+}
+
+// extractToken 从请求中提取JWT Token
+//
+// 优先读取URL查询参数 ?token=xxx，其次读取 Authorization: Bearer <token> 头部。
+// 返回Token本身以及来源标识（"url"或"header"），未携带时返回空字符串。
+func extractToken(r *ghttp.Request) (token string, via string) {
+	if token = r.GetQuery("token").String(); token != "" {
+		return token, "url"
+	}
+	authz := r.Header.Get("Authorization")
+	// 检查是否为标准的Bearer Token格式
+	if strings.HasPrefix(strings.ToLower(authz), "bearer ") {
+		// 提取Bearer后面的token部分，并去除首尾空格
+		return strings.TrimSpace(authz[7:]), "header"
+	}
+	return "", ""
+}
 
+// injectClaims 将JWT Claims中的关键信息与用户信息注入请求上下文
+func injectClaims(r *ghttp.Request, claims *auth.Claims, user *entity.Users, via string) {
 	// 注入用户标识（通常为用户ID）
 	r.SetCtxVar("auth.subject", claims.Subject)
+	r.SetCtxVar("auth.user_id", user.Id)
+	r.SetCtxVar("auth.role", user.Role)
 
 	// 注入Token时间信息
 	iat := int64(0) // 签发时间（Issued At）
@@ -169,13 +214,5 @@ func MiddlewareJWT(r *ghttp.Request) {
 
 	// 标记Token来源，便于后续逻辑区分处理
 	// 这对于安全审计和调试非常有用
-	if r.GetQuery("token").String() != "" {
-		r.SetCtxVar("auth.via", "url") // Token来自URL参数
-	} else {
-		r.SetCtxVar("auth.via", "header") // Token来自Authorization头部
-	}
-
-	// 第五步：继续执行后续中间件和控制器
-	// 鉴权成功，允许请求继续处理
-	r.Middleware.Next()
+	r.SetCtxVar("auth.via", via)
 }
@@ -35,4 +35,7 @@ type BlogArticles struct {
 	CreatedAt     *gtime.Time //
 	UpdatedAt     *gtime.Time //
 	DeletedAt     *gtime.Time //
+	SearchVector  any         //
+	PasswordHash  any         //
+	Language      any         //
 }
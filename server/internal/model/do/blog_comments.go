@@ -11,18 +11,21 @@ import (
 
 // BlogComments is the golang structure of table blog_comments for DAO operations like Where/Data.
 type BlogComments struct {
-	g.Meta         `orm:"table:blog_comments, do:true"`
-	Id             any         //
-	CommentId      any         //
-	ArticleId      any         //
-	ParentId       any         //
-	VisitorName    any         //
-	VisitorEmail   any         //
-	VisitorWebsite any         //
-	Content        any         //
-	HtmlContent    any         //
-	Status         any         //
-	IsDeleted      any         //
-	CreatedAt      *gtime.Time //
-	UpdatedAt      *gtime.Time //
+	g.Meta            `orm:"table:blog_comments, do:true"`
+	Id                any         //
+	CommentId         any         //
+	ArticleId         any         //
+	ParentId          any         //
+	VisitorName       any         //
+	VisitorEmail      any         //
+	VisitorWebsite    any         //
+	Content           any         //
+	HtmlContent       any         //
+	Ip                any         //
+	DownloadUserAgent any         //
+	Status            any         //
+	IsDeleted         any         //
+	CreatedAt         *gtime.Time //
+	UpdatedAt         *gtime.Time //
+	SpamReasons       any         //
 }
@@ -33,4 +33,7 @@ type BlogArticles struct {
 	CreatedAt     *gtime.Time `json:"createdAt"     orm:"created_at"     description:""` //
 	UpdatedAt     *gtime.Time `json:"updatedAt"     orm:"updated_at"     description:""` //
 	DeletedAt     *gtime.Time `json:"deletedAt"     orm:"deleted_at"     description:""` //
+	SearchVector  string      `json:"searchVector"  orm:"search_vector"  description:""` //
+	PasswordHash  string      `json:"passwordHash"  orm:"password_hash"  description:""` //
+	Language      string      `json:"language"      orm:"language"       description:""` //
 }
@@ -0,0 +1,65 @@
+package antispam
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/gclient"
+)
+
+// AkismetChecker Akismet 兼容的外部垃圾检查
+//
+// 调用 {Endpoint}/1.1/comment-check，响应体为 "true" 表示垃圾评论。
+// Endpoint 与 Client 均可替换，便于指向本地桩服务进行测试。
+type AkismetChecker struct {
+	Endpoint string
+	ApiKey   string
+	BlogUrl  string
+	Client   *gclient.Client
+}
+
+// NewAkismetChecker 创建 Akismet 检查器
+func NewAkismetChecker(endpoint, apiKey, blogUrl string) *AkismetChecker {
+	client := gclient.New()
+	client.SetTimeout(5 * time.Second)
+	return &AkismetChecker{
+		Endpoint: strings.TrimRight(endpoint, "/"),
+		ApiKey:   apiKey,
+		BlogUrl:  blogUrl,
+		Client:   client,
+	}
+}
+
+func (a *AkismetChecker) Name() string { return "akismet" }
+
+func (a *AkismetChecker) Check(ctx context.Context, c *Comment) (Verdict, string, error) {
+	resp, err := a.Client.Post(ctx, a.Endpoint+"/1.1/comment-check", g.Map{
+		"api_key":              a.ApiKey,
+		"blog":                 a.BlogUrl,
+		"user_ip":              c.IP,
+		"user_agent":           c.UserAgent,
+		"referrer":             c.Referrer,
+		"comment_type":         "comment",
+		"comment_author":       c.Name,
+		"comment_author_email": c.Email,
+		"comment_author_url":   c.Website,
+		"comment_content":      c.Content,
+	})
+	if err != nil {
+		return Pass, "", gerror.Wrap(err, "请求Akismet失败")
+	}
+	defer resp.Close()
+	body := strings.TrimSpace(resp.ReadAllString())
+	switch body {
+	case "true":
+		return Suspect, "flagged as spam", nil
+	case "false":
+		return Pass, "", nil
+	default:
+		return Pass, "", gerror.Newf("Akismet返回异常: status=%d, body=%s, debug=%s",
+			resp.StatusCode, body, resp.Header.Get("X-akismet-debug-help"))
+	}
+}
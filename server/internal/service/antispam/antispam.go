@@ -0,0 +1,130 @@
+// Package antispam 提供匿名评论的反垃圾检查链
+//
+// 检查链由多个 Checker 顺序组成，每个 Checker 给出一个判定：
+//   - Pass: 未发现问题，继续执行后续检查
+//   - Suspect: 疑似垃圾，评论进入 pending 队列等待人工审核，但仍继续执行后续检查以收集原因
+//   - Reject: 明确的垃圾或滥用行为（蜜罐命中、表单令牌无效、超出频率限制），立即拒绝
+//
+// 检查器本身出错（如外部服务不可用）时记录日志并视为通过，避免误伤正常评论。
+package antispam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogf/gf/v2/frame/g"
+)
+
+// Verdict 检查判定结果
+type Verdict int
+
+const (
+	Pass    Verdict = iota // 通过
+	Suspect                // 疑似垃圾，进入待审核
+	Reject                 // 直接拒绝
+)
+
+// Comment 待检查的评论
+type Comment struct {
+	ArticleId int64
+	ParentId  int64
+	Name      string
+	Email     string
+	Website   string
+	Content   string
+	IP        string
+	UserAgent string
+	Referrer  string
+	Honeypot  string // 蜜罐字段，正常用户不会填写
+	FormToken string // 表单令牌（由 IssueFormToken 签发）
+	PowNonce  string // 工作量证明随机数
+}
+
+// Checker 单个反垃圾检查器
+type Checker interface {
+	// Name 检查器名称，用于记录判定原因
+	Name() string
+	// Check 执行检查，返回判定与原因描述
+	Check(ctx context.Context, c *Comment) (Verdict, string, error)
+}
+
+// Result 检查链执行结果
+type Result struct {
+	Verdict Verdict
+	Reasons []string
+}
+
+// Rejected 是否被拒绝
+func (r *Result) Rejected() bool {
+	return r.Verdict == Reject
+}
+
+// Suspicious 是否需要进入待审核队列
+func (r *Result) Suspicious() bool {
+	return r.Verdict == Suspect
+}
+
+// Reason 合并后的原因描述
+func (r *Result) Reason() string {
+	return strings.Join(r.Reasons, "; ")
+}
+
+// Pipeline 反垃圾检查链
+type Pipeline struct {
+	checkers []Checker
+}
+
+// NewPipeline 创建检查链
+func NewPipeline(checkers ...Checker) *Pipeline {
+	return &Pipeline{checkers: checkers}
+}
+
+// Use 追加检查器
+func (p *Pipeline) Use(checkers ...Checker) *Pipeline {
+	p.checkers = append(p.checkers, checkers...)
+	return p
+}
+
+// Run 依次执行检查器；遇到 Reject 立即返回
+func (p *Pipeline) Run(ctx context.Context, c *Comment) *Result {
+	res := &Result{Verdict: Pass}
+	for _, ck := range p.checkers {
+		v, reason, err := ck.Check(ctx, c)
+		if err != nil {
+			g.Log().Warningf(ctx, "antispam checker %s failed, treat as pass: %v", ck.Name(), err)
+			continue
+		}
+		if v == Pass {
+			continue
+		}
+		if reason == "" {
+			reason = ck.Name()
+		} else {
+			reason = ck.Name() + ": " + reason
+		}
+		res.Reasons = append(res.Reasons, reason)
+		if v > res.Verdict {
+			res.Verdict = v
+		}
+		if v == Reject {
+			return res
+		}
+	}
+	return res
+}
+
+// Default 根据动态配置构建默认检查链
+func Default(ctx context.Context) *Pipeline {
+	cfg := LoadConfig(ctx)
+	p := NewPipeline(
+		HoneypotChecker{},
+		&FormTokenChecker{Config: cfg, Used: defaultUsedTokens},
+		&RateLimitChecker{Config: cfg, Limiter: defaultLimiter},
+		&LinkChecker{MaxLinks: cfg.MaxLinks},
+		&KeywordChecker{Keywords: cfg.KeywordBlocklist},
+	)
+	if cfg.AkismetEnabled && cfg.AkismetApiKey != "" {
+		p.Use(NewAkismetChecker(cfg.AkismetEndpoint, cfg.AkismetApiKey, cfg.AkismetBlogUrl))
+	}
+	return p
+}
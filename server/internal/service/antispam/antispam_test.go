@@ -0,0 +1,204 @@
+package antispam
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"server/internal/service/configcache"
+)
+
+func TestHoneypotChecker(t *testing.T) {
+	ctx := context.Background()
+	p := NewPipeline(HoneypotChecker{}, &KeywordChecker{Keywords: []string{"casino"}})
+
+	res := p.Run(ctx, &Comment{Content: "casino", Honeypot: "x"})
+	if !res.Rejected() || res.Reason() != "honeypot: hidden field filled" {
+		t.Fatalf("honeypot: got verdict %d reason %q", res.Verdict, res.Reason())
+	}
+	res = p.Run(ctx, &Comment{Content: "hello"})
+	if res.Verdict != Pass {
+		t.Fatalf("clean comment: got verdict %d reason %q", res.Verdict, res.Reason())
+	}
+}
+
+func TestLinkChecker(t *testing.T) {
+	cases := []struct {
+		max     int
+		content string
+		want    Verdict
+	}{
+		{2, "see https://a.example and www.b.example", Pass},
+		{2, "http://a.example http://b.example http://c.example", Suspect},
+		{0, `<a href="x">x</a>`, Suspect},
+		{-1, "http://a.example http://b.example", Pass},
+	}
+	for _, tc := range cases {
+		got, _, err := (&LinkChecker{MaxLinks: tc.max}).Check(context.Background(), &Comment{Content: tc.content})
+		if err != nil || got != tc.want {
+			t.Errorf("max=%d %q: got %d, %v; want %d", tc.max, tc.content, got, err, tc.want)
+		}
+	}
+}
+
+func TestKeywordChecker(t *testing.T) {
+	k := &KeywordChecker{Keywords: []string{" Casino ", ""}}
+	cases := []struct {
+		c    Comment
+		want Verdict
+	}{
+		{Comment{Content: "best CASINO online"}, Suspect},
+		{Comment{Website: "https://casino.example"}, Suspect},
+		{Comment{Content: "nice post"}, Pass},
+	}
+	for _, tc := range cases {
+		got, _, _ := k.Check(context.Background(), &tc.c)
+		if got != tc.want {
+			t.Errorf("%+v: got %d, want %d", tc.c, got, tc.want)
+		}
+	}
+}
+
+func TestRateLimitChecker(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewLimiter()
+	l.now = func() time.Time { return now }
+	r := &RateLimitChecker{Config: &Config{IPPerMinute: 2, IPPerHour: 3, EmailPerHour: 10}, Limiter: l}
+	c := &Comment{IP: "192.0.2.1"}
+
+	for i, want := range []Verdict{Pass, Pass, Reject} {
+		if got, _, _ := r.Check(context.Background(), c); got != want {
+			t.Fatalf("request %d: got %d, want %d", i, got, want)
+		}
+	}
+	now = now.Add(time.Minute)
+	if got, _, _ := r.Check(context.Background(), c); got != Pass {
+		t.Fatalf("after a minute: got %d, want pass", got)
+	}
+	if got, reason, _ := r.Check(context.Background(), c); got != Reject || !strings.Contains(reason, "per hour") {
+		t.Fatalf("hourly limit: got %d %q", got, reason)
+	}
+}
+
+func TestFormTokenChecker(t *testing.T) {
+	ctx := context.Background()
+	configcache.Set("auth", "default", "jwt_secret", "test-secret")
+	configcache.Set(configNamespace, configEnv, "comment_pow_difficulty", 8)
+	defer configcache.Set(configNamespace, configEnv, "comment_pow_difficulty", 0)
+
+	ft, err := IssueFormToken(ctx, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ft.Difficulty != 8 {
+		t.Fatalf("difficulty: got %d", ft.Difficulty)
+	}
+	nonce := ""
+	for i := 0; ; i++ {
+		if n := strconv.Itoa(i); VerifyProofOfWork(ft.Token, n, ft.Difficulty) {
+			nonce = n
+			break
+		}
+	}
+
+	cfg := &Config{FormTokenRequire: true, FormTTLSeconds: 3600, PowDifficulty: ft.Difficulty}
+	f := &FormTokenChecker{Config: cfg, Used: NewUsedTokens()}
+	cases := []struct {
+		name   string
+		c      Comment
+		want   Verdict
+		reason string
+	}{
+		{"missing", Comment{ArticleId: 42}, Reject, "missing form token"},
+		{"tampered", Comment{ArticleId: 42, FormToken: ft.Token + "0", PowNonce: nonce}, Reject, "invalid signature"},
+		{"other article", Comment{ArticleId: 7, FormToken: ft.Token, PowNonce: nonce}, Reject, "token issued for another article"},
+		{"bad nonce", Comment{ArticleId: 42, FormToken: ft.Token, PowNonce: ""}, Reject, "invalid proof of work"},
+		{"ok", Comment{ArticleId: 42, FormToken: ft.Token, PowNonce: nonce}, Pass, ""},
+		{"replay", Comment{ArticleId: 42, FormToken: ft.Token, PowNonce: nonce}, Reject, "token already used"},
+	}
+	for _, tc := range cases {
+		got, reason, err := f.Check(ctx, &tc.c)
+		if err != nil || got != tc.want || reason != tc.reason {
+			t.Errorf("%s: got %d %q %v; want %d %q", tc.name, got, reason, err, tc.want, tc.reason)
+		}
+	}
+
+	cfg.FormMinSeconds = 60
+	fresh, _ := IssueFormToken(ctx, 42)
+	if got, reason, _ := f.Check(ctx, &Comment{ArticleId: 42, FormToken: fresh.Token, PowNonce: nonce}); got != Reject || reason != "submitted too fast" {
+		t.Errorf("too fast: got %d %q", got, reason)
+	}
+}
+
+func TestUsedTokensExpire(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	u := NewUsedTokens()
+	u.now = func() time.Time { return now }
+
+	if !u.Consume("t", now.Add(time.Hour)) {
+		t.Fatal("first use rejected")
+	}
+	if u.Consume("t", now.Add(time.Hour)) {
+		t.Fatal("reuse accepted")
+	}
+	now = now.Add(2 * time.Hour)
+	if !u.Consume("other", now.Add(time.Hour)) {
+		t.Fatal("other token rejected")
+	}
+	if len(u.expires) != 1 {
+		t.Fatalf("expired entry not swept: %d entries", len(u.expires))
+	}
+}
+
+func TestAkismetChecker(t *testing.T) {
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/1.1/comment-check" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = r.ParseForm()
+		got = map[string]string{}
+		for k := range r.PostForm {
+			got[k] = r.PostForm.Get(k)
+		}
+		switch r.PostForm.Get("comment_content") {
+		case "spam":
+			_, _ = w.Write([]byte("true"))
+		case "ham":
+			_, _ = w.Write([]byte("false"))
+		default:
+			w.Header().Set("X-akismet-debug-help", "bad request")
+			_, _ = w.Write([]byte("invalid"))
+		}
+	}))
+	defer srv.Close()
+
+	a := NewAkismetChecker(srv.URL+"/", "key", "https://blog.example")
+	ctx := context.Background()
+
+	v, _, err := a.Check(ctx, &Comment{Content: "spam", IP: "192.0.2.1", Email: "a@example.com"})
+	if err != nil || v != Suspect {
+		t.Fatalf("spam: got %d, %v", v, err)
+	}
+	if got["api_key"] != "key" || got["blog"] != "https://blog.example" || got["user_ip"] != "192.0.2.1" ||
+		got["comment_author_email"] != "a@example.com" || got["comment_type"] != "comment" {
+		t.Fatalf("unexpected form: %v", got)
+	}
+	if v, _, err = a.Check(ctx, &Comment{Content: "ham"}); err != nil || v != Pass {
+		t.Fatalf("ham: got %d, %v", v, err)
+	}
+	if v, _, err = a.Check(ctx, &Comment{Content: "?"}); err == nil || v != Pass || !strings.Contains(err.Error(), "bad request") {
+		t.Fatalf("invalid response: got %d, %v", v, err)
+	}
+
+	// 检查器出错时检查链视为通过
+	res := NewPipeline(a).Run(ctx, &Comment{Content: "?"})
+	if res.Verdict != Pass {
+		t.Fatalf("pipeline on checker error: got %d", res.Verdict)
+	}
+}
@@ -0,0 +1,48 @@
+package antispam
+
+import (
+	"context"
+
+	"server/internal/service/configcache"
+)
+
+const (
+	configNamespace = "blog"
+	configEnv       = "default"
+)
+
+// Config 反垃圾配置，全部来自动态配置 blog/default/*
+type Config struct {
+	IPPerMinute      int      // 同一IP每分钟最多评论数
+	IPPerHour        int      // 同一IP每小时最多评论数
+	EmailPerHour     int      // 同一邮箱每小时最多评论数
+	MaxLinks         int      // 评论内容允许的最大链接数，超出进入待审核
+	KeywordBlocklist []string // 关键词黑名单，命中进入待审核
+	FormTokenRequire bool     // 是否要求表单令牌
+	FormMinSeconds   int      // 表单令牌签发后最少等待秒数（过快提交视为机器人）
+	FormTTLSeconds   int      // 表单令牌有效期（秒）
+	PowDifficulty    int      // 工作量证明难度（前导零比特数），0 表示关闭
+	AkismetEnabled   bool     // 是否启用 Akismet 兼容的外部检查
+	AkismetApiKey    string   // Akismet API Key
+	AkismetEndpoint  string   // Akismet 兼容服务地址（可指向本地桩服务）
+	AkismetBlogUrl   string   // 站点地址
+}
+
+// LoadConfig 读取反垃圾配置
+func LoadConfig(ctx context.Context) *Config {
+	return &Config{
+		IPPerMinute:      configcache.GetInt(ctx, configNamespace, configEnv, "comment_rate_ip_per_minute", 3),
+		IPPerHour:        configcache.GetInt(ctx, configNamespace, configEnv, "comment_rate_ip_per_hour", 20),
+		EmailPerHour:     configcache.GetInt(ctx, configNamespace, configEnv, "comment_rate_email_per_hour", 10),
+		MaxLinks:         configcache.GetInt(ctx, configNamespace, configEnv, "comment_max_links", 2),
+		KeywordBlocklist: configcache.GetStrings(ctx, configNamespace, configEnv, "comment_keyword_blocklist"),
+		FormTokenRequire: configcache.GetBool(ctx, configNamespace, configEnv, "comment_form_token_required", true),
+		FormMinSeconds:   configcache.GetInt(ctx, configNamespace, configEnv, "comment_form_min_seconds", 3),
+		FormTTLSeconds:   configcache.GetInt(ctx, configNamespace, configEnv, "comment_form_ttl_seconds", 86400),
+		PowDifficulty:    configcache.GetInt(ctx, configNamespace, configEnv, "comment_pow_difficulty", 0),
+		AkismetEnabled:   configcache.GetBool(ctx, configNamespace, configEnv, "akismet_enabled", false),
+		AkismetApiKey:    configcache.GetString(ctx, configNamespace, configEnv, "akismet_api_key", ""),
+		AkismetEndpoint:  configcache.GetString(ctx, configNamespace, configEnv, "akismet_endpoint", "https://rest.akismet.com"),
+		AkismetBlogUrl:   configcache.GetString(ctx, configNamespace, configEnv, "akismet_blog_url", ""),
+	}
+}
@@ -0,0 +1,60 @@
+package antispam
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// HoneypotChecker 蜜罐检查：表单中隐藏的字段被填写即视为机器人
+type HoneypotChecker struct{}
+
+func (HoneypotChecker) Name() string { return "honeypot" }
+
+func (HoneypotChecker) Check(ctx context.Context, c *Comment) (Verdict, string, error) {
+	if strings.TrimSpace(c.Honeypot) != "" {
+		return Reject, "hidden field filled", nil
+	}
+	return Pass, "", nil
+}
+
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.)[^\s<>"']+|<a\s[^>]*href`)
+
+// LinkChecker 链接数量检查：链接过多的评论进入待审核
+type LinkChecker struct {
+	MaxLinks int
+}
+
+func (l *LinkChecker) Name() string { return "links" }
+
+func (l *LinkChecker) Check(ctx context.Context, c *Comment) (Verdict, string, error) {
+	if l.MaxLinks < 0 {
+		return Pass, "", nil
+	}
+	n := len(linkPattern.FindAllStringIndex(c.Content, -1))
+	if n > l.MaxLinks {
+		return Suspect, fmt.Sprintf("%d links exceed limit %d", n, l.MaxLinks), nil
+	}
+	return Pass, "", nil
+}
+
+// KeywordChecker 关键词黑名单检查：命中任一关键词进入待审核
+type KeywordChecker struct {
+	Keywords []string
+}
+
+func (k *KeywordChecker) Name() string { return "keywords" }
+
+func (k *KeywordChecker) Check(ctx context.Context, c *Comment) (Verdict, string, error) {
+	if len(k.Keywords) == 0 {
+		return Pass, "", nil
+	}
+	text := strings.ToLower(strings.Join([]string{c.Name, c.Email, c.Website, c.Content}, "\n"))
+	for _, kw := range k.Keywords {
+		if kw = strings.ToLower(strings.TrimSpace(kw)); kw != "" && strings.Contains(text, kw) {
+			return Suspect, fmt.Sprintf("blocked keyword %q", kw), nil
+		}
+	}
+	return Pass, "", nil
+}
@@ -0,0 +1,179 @@
+package antispam
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+
+	"server/internal/service/auth"
+)
+
+// FormToken 签发给评论表单的令牌
+type FormToken struct {
+	Token      string // 签名令牌，提交评论时原样带回
+	IssuedAt   int64  // 签发时间（Unix秒）
+	ExpiresAt  int64  // 过期时间（Unix秒）
+	Difficulty int    // 工作量证明难度，0 表示无需计算
+}
+
+// IssueFormToken 为指定文章签发表单令牌
+//
+// 令牌格式：base64url(articleId:issuedAt:random).hex(hmac-sha256)。
+// 若配置了工作量证明难度，客户端需找到 nonce 使 sha256(token + ":" + nonce)
+// 的前导零比特数不少于 Difficulty，并随评论一起提交。
+func IssueFormToken(ctx context.Context, articleId int64) (*FormToken, error) {
+	key, err := auth.SigningKey(ctx)
+	if err != nil {
+		return nil, gerror.Wrap(err, "获取签名密钥失败")
+	}
+	cfg := LoadConfig(ctx)
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, gerror.Wrap(err, "生成随机数失败")
+	}
+	now := time.Now().Unix()
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d:%s", articleId, now, hex.EncodeToString(buf))))
+	return &FormToken{
+		Token:      payload + "." + sign(key, payload),
+		IssuedAt:   now,
+		ExpiresAt:  now + int64(cfg.FormTTLSeconds),
+		Difficulty: cfg.PowDifficulty,
+	}, nil
+}
+
+func sign(key []byte, payload string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("comment-form:" + payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// usedTokenDefaultTTL 令牌未配置有效期时已使用记录的保留时间
+const usedTokenDefaultTTL = 24 * time.Hour
+
+// UsedTokens 进程内已使用表单令牌记录，保留到令牌过期，防止同一令牌与工作量证明被重复提交
+type UsedTokens struct {
+	mu        sync.Mutex
+	expires   map[string]time.Time // sha256(令牌) → 过期时间
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewUsedTokens 创建已使用令牌记录
+func NewUsedTokens() *UsedTokens {
+	return &UsedTokens{expires: make(map[string]time.Time), now: time.Now}
+}
+
+// defaultUsedTokens 默认检查链共享的已使用令牌记录
+var defaultUsedTokens = NewUsedTokens()
+
+// Consume 记录令牌已使用直到 expiresAt；令牌此前已被使用且未过期时返回 false
+func (u *UsedTokens) Consume(token string, expiresAt time.Time) bool {
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	now := u.now()
+	// 每分钟最多清理一次过期记录，避免每次提交都遍历全部记录
+	if now.Sub(u.lastSweep) >= time.Minute {
+		for k, exp := range u.expires {
+			if !now.Before(exp) {
+				delete(u.expires, k)
+			}
+		}
+		u.lastSweep = now
+	}
+	if exp, ok := u.expires[key]; ok && now.Before(exp) {
+		return false
+	}
+	u.expires[key] = expiresAt
+	return true
+}
+
+// FormTokenChecker 表单令牌与工作量证明检查
+//
+// 校验通过的令牌记录到 Used 中，同一令牌在有效期内只能提交一次评论；Used 为 nil 时不检查重放。
+type FormTokenChecker struct {
+	Config *Config
+	Used   *UsedTokens
+}
+
+func (f *FormTokenChecker) Name() string { return "form_token" }
+
+func (f *FormTokenChecker) Check(ctx context.Context, c *Comment) (Verdict, string, error) {
+	if !f.Config.FormTokenRequire {
+		return Pass, "", nil
+	}
+	if c.FormToken == "" {
+		return Reject, "missing form token", nil
+	}
+	key, err := auth.SigningKey(ctx)
+	if err != nil {
+		return Pass, "", err
+	}
+	payload, signature, ok := strings.Cut(c.FormToken, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(sign(key, payload))) {
+		return Reject, "invalid signature", nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return Reject, "malformed token", nil
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 {
+		return Reject, "malformed token", nil
+	}
+	articleId, _ := strconv.ParseInt(parts[0], 10, 64)
+	issuedAt, _ := strconv.ParseInt(parts[1], 10, 64)
+	if articleId != c.ArticleId {
+		return Reject, "token issued for another article", nil
+	}
+	age := time.Now().Unix() - issuedAt
+	if age < int64(f.Config.FormMinSeconds) {
+		return Reject, "submitted too fast", nil
+	}
+	if f.Config.FormTTLSeconds > 0 && age > int64(f.Config.FormTTLSeconds) {
+		return Reject, "token expired", nil
+	}
+	if f.Config.PowDifficulty > 0 && !VerifyProofOfWork(c.FormToken, c.PowNonce, f.Config.PowDifficulty) {
+		return Reject, "invalid proof of work", nil
+	}
+	if f.Used != nil {
+		expiresAt := time.Unix(issuedAt, 0).Add(usedTokenDefaultTTL)
+		if f.Config.FormTTLSeconds > 0 {
+			expiresAt = time.Unix(issuedAt+int64(f.Config.FormTTLSeconds), 0)
+		}
+		if !f.Used.Consume(c.FormToken, expiresAt) {
+			return Reject, "token already used", nil
+		}
+	}
+	return Pass, "", nil
+}
+
+// VerifyProofOfWork 校验 sha256(token + ":" + nonce) 的前导零比特数是否达到难度要求
+func VerifyProofOfWork(token, nonce string, difficulty int) bool {
+	if nonce == "" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(token + ":" + nonce))
+	zeros := 0
+	for _, b := range sum {
+		if b == 0 {
+			zeros += 8
+			continue
+		}
+		zeros += bits.LeadingZeros8(b)
+		break
+	}
+	return zeros >= difficulty
+}
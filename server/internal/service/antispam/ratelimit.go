@@ -0,0 +1,94 @@
+package antispam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limiter 进程内滑动窗口计数器
+type Limiter struct {
+	mu     sync.Mutex
+	events map[string][]time.Time
+	now    func() time.Time
+}
+
+// NewLimiter 创建计数器
+func NewLimiter() *Limiter {
+	return &Limiter{events: make(map[string][]time.Time), now: time.Now}
+}
+
+// maxTrackedKeys 计数器跟踪的 key 超过该数量时清理过期记录，避免内存无限增长
+const maxTrackedKeys = 10000
+
+// defaultLimiter 默认检查链共享的计数器
+var defaultLimiter = NewLimiter()
+
+// Allow 检查 key 在 window 内的次数是否小于 limit；允许时记录一次
+func (l *Limiter) Allow(key string, limit int, window time.Duration) bool {
+	if limit <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if len(l.events) > maxTrackedKeys {
+		l.sweep(now)
+	}
+	events := l.prune(key, now, window)
+	if len(events) >= limit {
+		return false
+	}
+	l.events[key] = append(events, now)
+	return true
+}
+
+// prune 移除窗口外的事件，调用方需持有锁
+func (l *Limiter) prune(key string, now time.Time, window time.Duration) []time.Time {
+	events := l.events[key]
+	i := 0
+	for i < len(events) && now.Sub(events[i]) >= window {
+		i++
+	}
+	events = events[i:]
+	if len(events) == 0 {
+		delete(l.events, key)
+		return nil
+	}
+	l.events[key] = events
+	return events
+}
+
+// sweep 清理一小时前的所有记录，调用方需持有锁
+func (l *Limiter) sweep(now time.Time) {
+	for key := range l.events {
+		l.prune(key, now, time.Hour)
+	}
+}
+
+// RateLimitChecker 频率限制：按IP与邮箱分别限流，超出直接拒绝
+type RateLimitChecker struct {
+	Config  *Config
+	Limiter *Limiter
+}
+
+func (r *RateLimitChecker) Name() string { return "rate_limit" }
+
+func (r *RateLimitChecker) Check(ctx context.Context, c *Comment) (Verdict, string, error) {
+	if c.IP != "" {
+		if !r.Limiter.Allow("ip:m:"+c.IP, r.Config.IPPerMinute, time.Minute) {
+			return Reject, fmt.Sprintf("ip exceeds %d per minute", r.Config.IPPerMinute), nil
+		}
+		if !r.Limiter.Allow("ip:h:"+c.IP, r.Config.IPPerHour, time.Hour) {
+			return Reject, fmt.Sprintf("ip exceeds %d per hour", r.Config.IPPerHour), nil
+		}
+	}
+	if email := strings.ToLower(strings.TrimSpace(c.Email)); email != "" {
+		if !r.Limiter.Allow("email:h:"+email, r.Config.EmailPerHour, time.Hour) {
+			return Reject, fmt.Sprintf("email exceeds %d per hour", r.Config.EmailPerHour), nil
+		}
+	}
+	return Pass, "", nil
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gerror"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+	"server/internal/service/auth"
+)
+
+// archiveTimeZone 归档按此时区划分月份。必须与迁移 0022 中索引表达式使用的时区一致，
+// 否则查询无法命中按月归档索引
+const archiveTimeZone = "Asia/Shanghai"
+
+// blogArchiveDate 文章的归档日期：发布时间，未设置时取创建时间
+const blogArchiveDate = "COALESCE(publish_at, created_at)"
+
+// ArchiveMonth 归档中的一个月份
+type ArchiveMonth struct {
+	Month int
+	Count int
+}
+
+// ArchiveYear 归档中的一个年份，月份按时间倒序
+type ArchiveYear struct {
+	Year   int
+	Count  int
+	Months []*ArchiveMonth
+}
+
+// archiveMonthExpr 按月截断的日期表达式，与按月归档索引的表达式保持一致
+func archiveMonthExpr(dateColumn string) string {
+	return fmt.Sprintf("date_trunc('month', %s AT TIME ZONE '%s')", dateColumn, archiveTimeZone)
+}
+
+// archiveBuckets 统计查询结果中每年、每月的条目数，按时间倒序
+func archiveBuckets(m *gdb.Model, dateColumn string) ([]*ArchiveYear, error) {
+	rows, err := m.Fields(archiveMonthExpr(dateColumn) + " AS bucket, COUNT(*) AS count").
+		Group("bucket").
+		Order("bucket DESC").
+		All()
+	if err != nil {
+		return nil, err
+	}
+	years := make([]*ArchiveYear, 0)
+	for _, row := range rows {
+		t := row["bucket"].Time()
+		if t.IsZero() {
+			continue
+		}
+		count := row["count"].Int()
+		if n := len(years); n == 0 || years[n-1].Year != t.Year() {
+			years = append(years, &ArchiveYear{Year: t.Year()})
+		}
+		year := years[len(years)-1]
+		year.Count += count
+		year.Months = append(year.Months, &ArchiveMonth{Month: int(t.Month()), Count: count})
+	}
+	return years, nil
+}
+
+// whereArchiveMonth 将查询限定在指定年月内
+func whereArchiveMonth(m *gdb.Model, dateColumn string, year, month int) *gdb.Model {
+	return m.Where(archiveMonthExpr(dateColumn)+" = ?::timestamp", fmt.Sprintf("%04d-%02d-01 00:00:00", year, month))
+}
+
+// ArchiveTotal 归档条目总数
+func ArchiveTotal(years []*ArchiveYear) int {
+	total := 0
+	for _, y := range years {
+		total += y.Count
+	}
+	return total
+}
+
+// IBlogArchive 博客按月归档服务接口
+type IBlogArchive interface {
+	// Months 按年、月统计文章数。未登录只统计公开的已发布文章；
+	// 登录后可按 status 过滤（为空时为已发布），并包含私密文章
+	Months(ctx context.Context, status string) ([]*ArchiveYear, error)
+	// Articles 指定年月内的文章，可见性规则与 Months 相同，按归档日期倒序
+	Articles(ctx context.Context, year, month int, status string, page, size int) ([]*entity.BlogArticles, int, error)
+}
+
+type sBlogArchive struct{}
+
+// BlogArchive 博客按月归档服务实例
+func BlogArchive() IBlogArchive {
+	return &sBlogArchive{}
+}
+
+// archiveArticles 按调用方身份应用状态、可见性与软删除规则
+func (s *sBlogArchive) archiveArticles(ctx context.Context, status string) (*gdb.Model, bool) {
+	m := dao.BlogArticles.Ctx(ctx).WhereNull("deleted_at")
+	if !auth.IsAuthenticated(ctx) {
+		return m.Where("status", ArticleStatusPublished).Where("is_private", false), true
+	}
+	if status == "" {
+		status = ArticleStatusPublished
+	}
+	return m.Where("status", status), false
+}
+
+// Months 按月统计文章数
+func (s *sBlogArchive) Months(ctx context.Context, status string) ([]*ArchiveYear, error) {
+	m, public := s.archiveArticles(ctx, status)
+	// 只缓存匿名访客的结果，内容变更时随内容缓存一并失效
+	if public {
+		if v, ok := blogContentCache.get("archive:blog"); ok {
+			return v.([]*ArchiveYear), nil
+		}
+	}
+	years, err := archiveBuckets(m, blogArchiveDate)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询文章归档失败")
+	}
+	if public {
+		blogContentCache.set(ctx, "archive:blog", years)
+	}
+	return years, nil
+}
+
+// Articles 指定年月内的文章
+func (s *sBlogArchive) Articles(ctx context.Context, year, month int, status string, page, size int) ([]*entity.BlogArticles, int, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 10
+	}
+	m, _ := s.archiveArticles(ctx, status)
+	m = whereArchiveMonth(m, blogArchiveDate, year, month)
+
+	total, err := m.Count()
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询归档文章总数失败")
+	}
+	var articles []*entity.BlogArticles
+	err = m.FieldsEx(dao.BlogArticles.Columns().SearchVector).
+		Order(gdb.Raw(blogArchiveDate+" DESC, id DESC")).
+		Limit((page-1)*size, size).
+		Scan(&articles)
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询归档文章失败")
+	}
+	return articles, total, nil
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// commentUnsubscribeSubject 评论回复通知退订令牌的主题
+const commentUnsubscribeSubject = "comment-unsubscribe"
+
+// CommentUnsubscribeClaims 评论回复通知退订令牌声明；ThreadId 为 0 表示退订全部回复通知
+type CommentUnsubscribeClaims struct {
+	Email    string `json:"em"`
+	ThreadId int64  `json:"tid"`
+	jwt.RegisteredClaims
+}
+
+// GenerateCommentUnsubscribeToken 为邮箱签发评论串退订令牌。
+// 令牌随通知邮件长期有效，不设过期时间；更换 JWT 密钥后旧链接失效
+func GenerateCommentUnsubscribeToken(ctx context.Context, email string, threadId int64) (string, error) {
+	key, err := scopedKey(ctx, commentUnsubscribeSubject)
+	if err != nil {
+		return "", err
+	}
+	claims := &CommentUnsubscribeClaims{
+		Email:    strings.ToLower(email),
+		ThreadId: threadId,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:  commentUnsubscribeSubject,
+			IssuedAt: jwt.NewNumericDate(time.Now()),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+}
+
+// ValidateCommentUnsubscribeToken 校验评论串退订令牌的签名
+func ValidateCommentUnsubscribeToken(ctx context.Context, tokenString string) (*CommentUnsubscribeClaims, error) {
+	key, err := scopedKey(ctx, commentUnsubscribeSubject)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := jwt.ParseWithClaims(tokenString, &CommentUnsubscribeClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithSubject(commentUnsubscribeSubject))
+	if err != nil || !parsed.Valid {
+		return nil, ErrUnauthorized
+	}
+	claims, ok := parsed.Claims.(*CommentUnsubscribeClaims)
+	if !ok || claims.Email == "" || claims.ThreadId < 0 {
+		return nil, ErrUnauthorized
+	}
+	return claims, nil
+}
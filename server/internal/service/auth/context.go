@@ -0,0 +1,24 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/frame/g"
+)
+
+// CurrentSubject 读取当前请求中已认证的用户标识
+//
+// 由 MiddlewareJWT 注入的 auth.subject 决定；公开接口（noAuth）在未携带有效Token时返回空字符串，
+// 因此可用于在同一接口内区分匿名访客与已登录用户。
+func CurrentSubject(ctx context.Context) string {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return ""
+	}
+	return r.GetCtxVar("auth.subject").String()
+}
+
+// IsAuthenticated 当前请求是否已登录
+func IsAuthenticated(ctx context.Context) bool {
+	return CurrentSubject(ctx) != ""
+}
@@ -14,7 +14,7 @@ import (
 
 // Claims 自定义声明（可扩展）
 type Claims struct {
-	Subject string `json:"sub"`
+	Subject string `json:"sub"` // 用户ID；旧版令牌固定为 "admin"
 	jwt.RegisteredClaims
 }
 
@@ -48,6 +48,15 @@ func getJwtSecret(ctx context.Context) (string, error) {
 	return strings.Trim(g.NewVar(it.Value).String(), " \t\r\n\""), nil
 }
 
+// SigningKey 返回当前环境的签名密钥，供评论表单令牌等需要HMAC签名的模块复用
+func SigningKey(ctx context.Context) ([]byte, error) {
+	secret, err := getJwtSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(secret), nil
+}
+
 func getPasswordUpdatedAt(ctx context.Context) int64 {
 	env := currentEnv(ctx)
 	it, ok := configcache.Get(ctx, nsAuth, env, keyPasswordUpdatedAt)
@@ -66,8 +75,8 @@ func getPasswordUpdatedAt(ctx context.Context) int64 {
 	return ts
 }
 
-// GenerateToken 生成令牌
-func GenerateToken(ctx context.Context, ttlSeconds int64) (string, int64, error) {
+// GenerateToken 为指定用户签发令牌，subject 为用户ID
+func GenerateToken(ctx context.Context, subject string, ttlSeconds int64) (string, int64, error) {
 	secret, err := getJwtSecret(ctx)
 	if err != nil {
 		return "", 0, err
@@ -75,7 +84,7 @@ func GenerateToken(ctx context.Context, ttlSeconds int64) (string, int64, error)
 	now := time.Now()
 	exp := now.Add(time.Duration(ttlSeconds) * time.Second)
 	claims := &Claims{
-		Subject: "admin",
+		Subject: subject,
 		RegisteredClaims: jwt.RegisteredClaims{
 			IssuedAt:  jwt.NewNumericDate(now),
 			ExpiresAt: jwt.NewNumericDate(exp),
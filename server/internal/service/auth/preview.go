@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// previewSubject 预览令牌的主题，与登录令牌区分
+const previewSubject = "article-preview"
+
+// PreviewClaims 文章预览令牌声明：只授权只读访问单篇文章
+type PreviewClaims struct {
+	ArticleId int64 `json:"aid"`
+	jwt.RegisteredClaims
+}
+
+// scopedKey 由 JWT 密钥按用途派生的独立签名密钥，
+// 使各类用途受限的令牌（预览、解锁、订阅确认、评论退订）之间互不通用，也无法被当作登录令牌使用
+func scopedKey(ctx context.Context, purpose string) ([]byte, error) {
+	secret, err := getJwtSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil), nil
+}
+
+// GeneratePreviewToken 为指定文章签发预览令牌，返回令牌与过期时间戳（秒）
+func GeneratePreviewToken(ctx context.Context, articleId int64, ttlSeconds int64) (string, int64, error) {
+	key, err := scopedKey(ctx, previewSubject)
+	if err != nil {
+		return "", 0, err
+	}
+	now := time.Now()
+	exp := now.Add(time.Duration(ttlSeconds) * time.Second)
+	claims := &PreviewClaims{
+		ArticleId: articleId,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   previewSubject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(exp),
+		},
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+	if err != nil {
+		return "", 0, err
+	}
+	return signed, exp.Unix(), nil
+}
+
+// ValidatePreviewToken 校验预览令牌的签名与有效期；撤销状态由调用方另行检查
+func ValidatePreviewToken(ctx context.Context, tokenString string) (*PreviewClaims, error) {
+	key, err := scopedKey(ctx, previewSubject)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := jwt.ParseWithClaims(tokenString, &PreviewClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithSubject(previewSubject))
+	if err != nil || !parsed.Valid {
+		return nil, ErrUnauthorized
+	}
+	claims, ok := parsed.Claims.(*PreviewClaims)
+	if !ok || claims.ArticleId <= 0 {
+		return nil, ErrUnauthorized
+	}
+	return claims, nil
+}
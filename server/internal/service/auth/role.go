@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/gogf/gf/v2/frame/g"
+)
+
+// 用户角色，权限由低到高：作者只能管理自己的内容，编辑可以修改任何人的内容，管理员另外管理用户与站点配置
+const (
+	RoleAuthor = "author"
+	RoleEditor = "editor"
+	RoleAdmin  = "admin"
+)
+
+// legacySubject 引入多用户之前签发的令牌主体，视为ID为1的管理员
+const legacySubject = "admin"
+
+var roleRank = map[string]int{RoleAuthor: 1, RoleEditor: 2, RoleAdmin: 3}
+
+// ValidRole 是否为已知角色
+func ValidRole(role string) bool {
+	_, ok := roleRank[role]
+	return ok
+}
+
+// RoleAtLeast 角色 role 是否不低于 required；required 为空时总是满足
+func RoleAtLeast(role, required string) bool {
+	required = strings.ToLower(strings.TrimSpace(required))
+	if required == "" {
+		return true
+	}
+	need, ok := roleRank[required]
+	if !ok {
+		return false
+	}
+	return roleRank[role] >= need
+}
+
+// UserIDFromSubject 解析令牌主体中的用户ID，无法解析时返回 0
+func UserIDFromSubject(subject string) int64 {
+	if subject == legacySubject {
+		return 1
+	}
+	id, err := strconv.ParseInt(subject, 10, 64)
+	if err != nil || id <= 0 {
+		return 0
+	}
+	return id
+}
+
+// CurrentUserID 当前请求的用户ID，未登录时返回 0
+func CurrentUserID(ctx context.Context) int64 {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return 0
+	}
+	return r.GetCtxVar("auth.user_id").Int64()
+}
+
+// CurrentRole 当前请求的用户角色，未登录时返回空字符串
+func CurrentRole(ctx context.Context) string {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return ""
+	}
+	return r.GetCtxVar("auth.role").String()
+}
+
+// HasRole 当前用户的角色是否不低于 required
+func HasRole(ctx context.Context, required string) bool {
+	return IsAuthenticated(ctx) && RoleAtLeast(CurrentRole(ctx), required)
+}
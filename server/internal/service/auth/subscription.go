@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// subscriptionSubject 订阅确认令牌的主题
+const subscriptionSubject = "newsletter-confirm"
+
+// SubscriptionClaims 订阅确认令牌声明
+type SubscriptionClaims struct {
+	SubscriberId int64 `json:"sid"`
+	jwt.RegisteredClaims
+}
+
+// GenerateSubscriptionToken 为待确认的订阅者签发确认令牌，返回令牌与过期时间戳（秒）
+func GenerateSubscriptionToken(ctx context.Context, subscriberId int64, ttlSeconds int64) (string, int64, error) {
+	key, err := scopedKey(ctx, subscriptionSubject)
+	if err != nil {
+		return "", 0, err
+	}
+	now := time.Now()
+	exp := now.Add(time.Duration(ttlSeconds) * time.Second)
+	claims := &SubscriptionClaims{
+		SubscriberId: subscriberId,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subscriptionSubject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(exp),
+		},
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+	if err != nil {
+		return "", 0, err
+	}
+	return signed, exp.Unix(), nil
+}
+
+// ValidateSubscriptionToken 校验订阅确认令牌的签名与有效期
+func ValidateSubscriptionToken(ctx context.Context, tokenString string) (*SubscriptionClaims, error) {
+	key, err := scopedKey(ctx, subscriptionSubject)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := jwt.ParseWithClaims(tokenString, &SubscriptionClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithSubject(subscriptionSubject))
+	if err != nil || !parsed.Valid {
+		return nil, ErrUnauthorized
+	}
+	claims, ok := parsed.Claims.(*SubscriptionClaims)
+	if !ok || claims.SubscriberId <= 0 {
+		return nil, ErrUnauthorized
+	}
+	return claims, nil
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// unlockSubject 文章解锁令牌的主题
+const unlockSubject = "article-unlock"
+
+// UnlockClaims 密码保护文章的解锁令牌声明。
+// Fingerprint 绑定签发时的密码摘要，修改或清除密码后旧令牌随即失效
+type UnlockClaims struct {
+	ArticleId   int64  `json:"aid"`
+	Fingerprint string `json:"fp"`
+	jwt.RegisteredClaims
+}
+
+// GenerateUnlockToken 为已通过密码校验的文章签发解锁令牌，返回令牌与过期时间戳（秒）
+func GenerateUnlockToken(ctx context.Context, articleId int64, fingerprint string, ttlSeconds int64) (string, int64, error) {
+	key, err := scopedKey(ctx, unlockSubject)
+	if err != nil {
+		return "", 0, err
+	}
+	now := time.Now()
+	exp := now.Add(time.Duration(ttlSeconds) * time.Second)
+	claims := &UnlockClaims{
+		ArticleId:   articleId,
+		Fingerprint: fingerprint,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   unlockSubject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(exp),
+		},
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+	if err != nil {
+		return "", 0, err
+	}
+	return signed, exp.Unix(), nil
+}
+
+// ValidateUnlockToken 校验解锁令牌的签名与有效期；文章与密码指纹由调用方比对
+func ValidateUnlockToken(ctx context.Context, tokenString string) (*UnlockClaims, error) {
+	key, err := scopedKey(ctx, unlockSubject)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := jwt.ParseWithClaims(tokenString, &UnlockClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithSubject(unlockSubject))
+	if err != nil || !parsed.Valid {
+		return nil, ErrUnauthorized
+	}
+	claims, ok := parsed.Claims.(*UnlockClaims)
+	if !ok || claims.ArticleId <= 0 {
+		return nil, ErrUnauthorized
+	}
+	return claims, nil
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"server/internal/service/configcache"
+)
+
+func TestVisitorId(t *testing.T) {
+	ctx := context.Background()
+	configcache.Set(nsAuth, "default", keyJwtSecret, "test-secret")
+	id := strings.Repeat("ab", 16)
+	signed, err := SignVisitorId(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name  string
+		value string
+		ok    bool
+	}{
+		{"signed", signed, true},
+		{"unsigned", id, false},
+		{"empty signature", id + ".", false},
+		{"forged id", strings.Repeat("cd", 16) + signed[len(id):], false},
+		{"tampered signature", signed[:len(signed)-1] + "0", signed[len(signed)-1] == '0'},
+	}
+	for _, tc := range cases {
+		got, ok := VerifyVisitorId(ctx, tc.value)
+		if ok != tc.ok || (ok && got != id) {
+			t.Errorf("%s: got %q, %v", tc.name, got, ok)
+		}
+	}
+
+	// 更换密钥后旧签名失效
+	configcache.Set(nsAuth, "default", keyJwtSecret, "rotated")
+	if _, ok := VerifyVisitorId(ctx, signed); ok {
+		t.Error("signature accepted after key rotation")
+	}
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+	"server/internal/service/pinyin"
+)
+
+// articleSlugMaxLen 自动生成的文章 slug 最大长度（表字段为 255，保留余量给冲突后缀）
+const articleSlugMaxLen = 120
+
+// GenerateArticleSlug 根据标题生成唯一的文章 slug，汉字转换为拼音（无法转换的汉字忽略）；同时避开翻译使用的 slug
+func GenerateArticleSlug(ctx context.Context, title string, excludeId int64) (string, error) {
+	base := Slugify(pinyin.Romanize(title, "-"), articleSlugMaxLen)
+	return uniqueSlugFunc(base, "post", articleSlugMaxLen, func(slug string) (bool, error) {
+		q := dao.BlogArticles.Ctx(ctx).Where("slug", slug)
+		if excludeId > 0 {
+			q = q.WhereNot("id", excludeId)
+		}
+		if n, err := q.Count(); err != nil || n > 0 {
+			return n > 0, err
+		}
+		n, err := dao.BlogArticleTranslations.Ctx(ctx).Where("slug", slug).Count()
+		return n > 0, err
+	})
+}
+
+// GetArticleBySlug 按 slug 查询文章；slug 已被修改时返回文章当前 slug 作为 redirectTo，article 为 nil
+func (s *BlogSimpleService) GetArticleBySlug(ctx context.Context, slug string) (article *entity.BlogArticles, redirectTo string, err error) {
+	err = dao.BlogArticles.Ctx(ctx).
+		FieldsEx(dao.BlogArticles.Columns().SearchVector).
+		Where("slug", slug).
+		WhereNull("deleted_at").
+		Scan(&article)
+	if err != nil {
+		return nil, "", gerror.Wrap(err, "查询文章失败")
+	}
+	if article != nil {
+		return article, "", nil
+	}
+
+	current, err := dao.BlogArticles.Ctx(ctx).
+		InnerJoin("blog_article_slug_redirects r", "r.article_id = blog_articles.id").
+		Where("r.old_slug", slug).
+		WhereNull("blog_articles.deleted_at").
+		Value("blog_articles.slug")
+	if err != nil {
+		return nil, "", gerror.Wrap(err, "查询文章重定向失败")
+	}
+	if current.IsEmpty() {
+		return nil, "", gerror.NewCode(gcode.CodeNotFound, "文章不存在")
+	}
+	return nil, current.String(), nil
+}
+
+// recordSlugChange 记录文章的旧 slug 以便重定向；新 slug 若曾是重定向来源则删除该记录
+func recordSlugChange(ctx context.Context, articleId int64, oldSlug, newSlug string) error {
+	if _, err := dao.BlogArticleSlugRedirects.Ctx(ctx).Where("old_slug", newSlug).Delete(); err != nil {
+		return gerror.Wrap(err, "清理文章重定向失败")
+	}
+	if oldSlug == "" || oldSlug == newSlug {
+		return nil
+	}
+	_, err := g.DB().Exec(ctx, `INSERT INTO blog_article_slug_redirects (article_id, old_slug, created_at) VALUES (?, ?, NOW())
+		ON CONFLICT (old_slug) DO UPDATE SET article_id = EXCLUDED.article_id, created_at = EXCLUDED.created_at`, articleId, oldSlug)
+	if err != nil {
+		return gerror.Wrap(err, "记录文章重定向失败")
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+
+	"server/internal/dao"
+	"server/internal/service/configcache"
+)
+
+// contentCacheMaxEntries 内容缓存最多保留的条目数，超出时淘汰最久未使用的条目
+const contentCacheMaxEntries = 10000
+
+// contentCache 由文章、分类、标签内容派生的查询结果（相关文章、系列导航等）的进程内缓存，
+// 内容变更时整体失效；条目数超过上限时按最近最少使用淘汰
+type contentCache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // 最近使用的在前
+}
+
+type contentCacheEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+var blogContentCache = newContentCache()
+
+func newContentCache() *contentCache {
+	return &contentCache{entries: make(map[string]*list.Element), order: list.New()}
+}
+
+// InvalidateContentCaches 使所有由内容派生的缓存失效（站点地图、相关文章、系列），
+// 在文章、分类、标签、系列、微博发生变更后调用
+func InvalidateContentCaches() {
+	InvalidateSitemap()
+	blogContentCache.mu.Lock()
+	blogContentCache.entries = make(map[string]*list.Element)
+	blogContentCache.order.Init()
+	blogContentCache.mu.Unlock()
+}
+
+func (c *contentCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*contentCacheEntry)
+	if !time.Now().Before(e.expiresAt) {
+		c.order.Remove(el)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return e.value, true
+}
+
+func (c *contentCache) set(ctx context.Context, key string, value interface{}) {
+	ttl := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "related_cache_seconds", 600)
+	if ttl <= 0 {
+		return
+	}
+	expiresAt := time.Now().Add(time.Duration(ttl) * time.Second)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*contentCacheEntry)
+		e.value, e.expiresAt = value, expiresAt
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&contentCacheEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > contentCacheMaxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*contentCacheEntry).key)
+	}
+}
+
+// isPublicArticle 文章是否存在且公开可见（已发布、非私密、不在回收站），
+// 按文章ID缓存的派生结果只对公开文章缓存，避免枚举ID时缓存不存在的文章
+func isPublicArticle(ctx context.Context, articleId int64) (bool, error) {
+	n, err := dao.BlogArticles.Ctx(ctx).
+		Where("id", articleId).
+		Where("status", ArticleStatusPublished).
+		Where("COALESCE(is_private, false) = false").
+		Where("deleted_at IS NULL").
+		Count()
+	if err != nil {
+		return false, gerror.Wrap(err, "查询文章失败")
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestContentCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	c := newContentCache()
+	for i := 0; i < contentCacheMaxEntries; i++ {
+		c.set(ctx, fmt.Sprint(i), i)
+	}
+	// 访问 0 后它成为最近使用的条目，写入新条目时淘汰 1
+	if v, ok := c.get("0"); !ok || v.(int) != 0 {
+		t.Fatalf("get 0: %v %v", v, ok)
+	}
+	c.set(ctx, "new", -1)
+	if _, ok := c.get("1"); ok {
+		t.Fatal("least recently used entry was not evicted")
+	}
+	if _, ok := c.get("0"); !ok {
+		t.Fatal("recently used entry was evicted")
+	}
+	if n := c.order.Len(); n != contentCacheMaxEntries || len(c.entries) != n {
+		t.Fatalf("cache holds %d/%d entries", n, len(c.entries))
+	}
+}
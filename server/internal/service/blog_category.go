@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"server/internal/dao"
+	"server/internal/model/do"
+	"server/internal/model/entity"
+)
+
+const categorySlugMaxLen = 100
+
+// CategoryInput 创建或更新分类的参数
+type CategoryInput struct {
+	Name        string
+	Slug        string // 为空时根据名称生成（创建）或保持不变（更新）
+	Description *string
+	// ParentId 父分类：创建时 nil 或 0 表示顶级；更新时 nil 表示不变，0 表示移动到顶级
+	ParentId  *int64
+	SortOrder *int
+	IsActive  *bool
+}
+
+// CategoryNode 分类树节点
+type CategoryNode struct {
+	Category *entity.BlogCategories
+	// TotalCount 本分类及全部后代分类的文章数之和
+	TotalCount int
+	Children   []*CategoryNode
+}
+
+// IBlogCategory 博客分类服务接口
+type IBlogCategory interface {
+	// Create 创建分类
+	Create(ctx context.Context, in *CategoryInput) (*entity.BlogCategories, error)
+	// Update 更新分类，移动父分类时拒绝形成环
+	Update(ctx context.Context, id int64, in *CategoryInput) error
+	// Delete 删除分类；分类下有文章时必须指定 reassignTo 转移文章，子分类上移到被删分类的父级
+	Delete(ctx context.Context, id int64, reassignTo int64) error
+	// Reorder 按 ids 顺序重排 parentId 下的子分类（0 表示顶级分类）
+	Reorder(ctx context.Context, parentId int64, ids []int64) error
+	// Tree 返回分类树，节点携带汇总后的文章数
+	Tree(ctx context.Context, includeInactive bool) ([]*CategoryNode, error)
+}
+
+type sBlogCategory struct{}
+
+// BlogCategory 博客分类服务实例
+func BlogCategory() IBlogCategory {
+	return &sBlogCategory{}
+}
+
+// Create 创建分类
+func (s *sBlogCategory) Create(ctx context.Context, in *CategoryInput) (*entity.BlogCategories, error) {
+	var category *entity.BlogCategories
+	err := dao.BlogCategories.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		name := strings.TrimSpace(in.Name)
+		if name == "" {
+			return gerror.New("分类名称不能为空")
+		}
+		slug, err := resolveSlug(s.model(ctx, tx), in.Slug, name, "category", categorySlugMaxLen, 0)
+		if err != nil {
+			return err
+		}
+
+		data := do.BlogCategories{
+			Name:      name,
+			Slug:      slug,
+			IsActive:  true,
+			CreatedAt: gtime.Now(),
+			UpdatedAt: gtime.Now(),
+		}
+		if in.Description != nil {
+			data.Description = *in.Description
+		}
+		if in.IsActive != nil {
+			data.IsActive = *in.IsActive
+		}
+		var parentId int64
+		if in.ParentId != nil && *in.ParentId > 0 {
+			parentId = *in.ParentId
+			if err = s.mustExist(ctx, tx, parentId, "父分类不存在"); err != nil {
+				return err
+			}
+			data.ParentId = parentId
+		}
+		// 未指定排序时追加到同级末尾
+		if in.SortOrder != nil {
+			data.SortOrder = *in.SortOrder
+		} else {
+			next, err := s.nextSortOrder(ctx, tx, parentId)
+			if err != nil {
+				return err
+			}
+			data.SortOrder = next
+		}
+
+		id, err := dao.BlogCategories.Ctx(ctx).TX(tx).Data(data).InsertAndGetId()
+		if err != nil {
+			return gerror.Wrap(err, "创建分类失败")
+		}
+		if err = dao.BlogCategories.Ctx(ctx).TX(tx).Where("id", id).Scan(&category); err != nil {
+			return gerror.Wrap(err, "查询分类失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	InvalidateContentCaches()
+	return category, nil
+}
+
+// Update 更新分类
+func (s *sBlogCategory) Update(ctx context.Context, id int64, in *CategoryInput) error {
+	err := dao.BlogCategories.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var cur *entity.BlogCategories
+		if err := dao.BlogCategories.Ctx(ctx).TX(tx).Where("id", id).LockUpdate().Scan(&cur); err != nil {
+			return gerror.Wrap(err, "查询分类失败")
+		}
+		if cur == nil {
+			return gerror.NewCode(gcode.CodeNotFound, "分类不存在")
+		}
+
+		data := g.Map{"updated_at": gtime.Now()}
+		if name := strings.TrimSpace(in.Name); name != "" {
+			data["name"] = name
+		}
+		if in.Slug != "" && in.Slug != cur.Slug {
+			slug, err := resolveSlug(s.model(ctx, tx), in.Slug, "", "category", categorySlugMaxLen, id)
+			if err != nil {
+				return err
+			}
+			data["slug"] = slug
+		}
+		if in.Description != nil {
+			data["description"] = *in.Description
+		}
+		if in.SortOrder != nil {
+			data["sort_order"] = *in.SortOrder
+		}
+		if in.IsActive != nil {
+			data["is_active"] = *in.IsActive
+		}
+		if in.ParentId != nil && *in.ParentId != cur.ParentId {
+			parentId := *in.ParentId
+			if parentId == 0 {
+				data["parent_id"] = nil
+			} else {
+				if err := s.mustExist(ctx, tx, parentId, "父分类不存在"); err != nil {
+					return err
+				}
+				descendants, err := s.descendantIds(ctx, tx, id)
+				if err != nil {
+					return err
+				}
+				if parentId == id || descendants[parentId] {
+					return gerror.NewCode(gcode.CodeInvalidParameter, "不能将分类移动到自身或其子分类下")
+				}
+				data["parent_id"] = parentId
+			}
+			// 移动后未指定排序时追加到新父级末尾
+			if in.SortOrder == nil {
+				next, err := s.nextSortOrder(ctx, tx, parentId)
+				if err != nil {
+					return err
+				}
+				data["sort_order"] = next
+			}
+		}
+
+		if _, err := dao.BlogCategories.Ctx(ctx).TX(tx).Where("id", id).Data(data).Update(); err != nil {
+			return gerror.Wrap(err, "更新分类失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	InvalidateContentCaches()
+	return nil
+}
+
+// Delete 删除分类
+func (s *sBlogCategory) Delete(ctx context.Context, id int64, reassignTo int64) error {
+	err := dao.BlogCategories.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var cur *entity.BlogCategories
+		if err := dao.BlogCategories.Ctx(ctx).TX(tx).Where("id", id).LockUpdate().Scan(&cur); err != nil {
+			return gerror.Wrap(err, "查询分类失败")
+		}
+		if cur == nil {
+			return gerror.NewCode(gcode.CodeNotFound, "分类不存在")
+		}
+
+		// 文章（包括回收站中的）都引用分类，删除前必须转移
+		articles, err := dao.BlogArticles.Ctx(ctx).TX(tx).Where("category_id", id).Count()
+		if err != nil {
+			return gerror.Wrap(err, "统计分类文章失败")
+		}
+		if articles > 0 {
+			if reassignTo == 0 {
+				return gerror.NewCodef(gcode.CodeInvalidParameter, "分类下还有%d篇文章，请指定要转移到的分类", articles)
+			}
+			if reassignTo == id {
+				return gerror.NewCode(gcode.CodeInvalidParameter, "不能将文章转移到被删除的分类")
+			}
+			if err = s.mustExist(ctx, tx, reassignTo, "目标分类不存在"); err != nil {
+				return err
+			}
+			if _, err = dao.BlogArticles.Ctx(ctx).TX(tx).Where("category_id", id).Data(g.Map{
+				"category_id": reassignTo,
+				"updated_at":  gtime.Now(),
+			}).Update(); err != nil {
+				return gerror.Wrap(err, "转移分类文章失败")
+			}
+		}
+
+		// 子分类上移一级
+		var parent interface{}
+		if cur.ParentId > 0 {
+			parent = cur.ParentId
+		}
+		if _, err = dao.BlogCategories.Ctx(ctx).TX(tx).Where("parent_id", id).Data(g.Map{
+			"parent_id":  parent,
+			"updated_at": gtime.Now(),
+		}).Update(); err != nil {
+			return gerror.Wrap(err, "移动子分类失败")
+		}
+
+		if _, err = dao.BlogCategories.Ctx(ctx).TX(tx).Where("id", id).Delete(); err != nil {
+			return gerror.Wrap(err, "删除分类失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	InvalidateContentCaches()
+	return nil
+}
+
+// Reorder 重排同级分类
+func (s *sBlogCategory) Reorder(ctx context.Context, parentId int64, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return gerror.NewCodef(gcode.CodeInvalidParameter, "分类ID重复: %d", id)
+		}
+		seen[id] = true
+	}
+	return dao.BlogCategories.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		m := dao.BlogCategories.Ctx(ctx).TX(tx).WhereIn("id", ids)
+		if parentId > 0 {
+			m = m.Where("parent_id", parentId)
+		} else {
+			m = m.WhereNull("parent_id")
+		}
+		n, err := m.Count()
+		if err != nil {
+			return gerror.Wrap(err, "查询分类失败")
+		}
+		if n != len(ids) {
+			return gerror.NewCode(gcode.CodeInvalidParameter, "只能对同一父分类下的分类排序")
+		}
+		for i, id := range ids {
+			if _, err = dao.BlogCategories.Ctx(ctx).TX(tx).Where("id", id).Data(g.Map{
+				"sort_order": i,
+				"updated_at": gtime.Now(),
+			}).Update(); err != nil {
+				return gerror.Wrap(err, "更新分类排序失败")
+			}
+		}
+		return nil
+	})
+}
+
+// Tree 构建分类树；停用分类及其子树不出现在结果中
+func (s *sBlogCategory) Tree(ctx context.Context, includeInactive bool) ([]*CategoryNode, error) {
+	var categories []*entity.BlogCategories
+	m := dao.BlogCategories.Ctx(ctx)
+	if !includeInactive {
+		m = m.Where("is_active", true)
+	}
+	if err := m.Order("sort_order ASC, id ASC").Scan(&categories); err != nil {
+		return nil, gerror.Wrap(err, "查询分类列表失败")
+	}
+
+	nodes := make(map[int64]*CategoryNode, len(categories))
+	for _, c := range categories {
+		nodes[c.Id] = &CategoryNode{Category: c}
+	}
+	roots := make([]*CategoryNode, 0)
+	for _, c := range categories {
+		node := nodes[c.Id]
+		if c.ParentId == 0 {
+			roots = append(roots, node)
+		} else if parent, ok := nodes[c.ParentId]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+	for _, root := range roots {
+		rollUpCount(root)
+	}
+	return roots, nil
+}
+
+// rollUpCount 自底向上汇总文章数
+func rollUpCount(node *CategoryNode) int {
+	node.TotalCount = node.Category.ArticleCount
+	for _, child := range node.Children {
+		node.TotalCount += rollUpCount(child)
+	}
+	return node.TotalCount
+}
+
+// descendantIds 查询分类的全部后代ID
+func (s *sBlogCategory) descendantIds(ctx context.Context, tx gdb.TX, id int64) (map[int64]bool, error) {
+	rows, err := tx.GetAll(`WITH RECURSIVE subtree AS (
+			SELECT id FROM blog_categories WHERE parent_id = ?
+			UNION
+			SELECT c.id FROM blog_categories c INNER JOIN subtree s ON c.parent_id = s.id
+		)
+		SELECT id FROM subtree`, id)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询子分类失败")
+	}
+	ids := make(map[int64]bool, len(rows))
+	for _, row := range rows {
+		ids[row["id"].Int64()] = true
+	}
+	return ids, nil
+}
+
+func (s *sBlogCategory) mustExist(ctx context.Context, tx gdb.TX, id int64, msg string) error {
+	n, err := dao.BlogCategories.Ctx(ctx).TX(tx).Where("id", id).Count()
+	if err != nil {
+		return gerror.Wrap(err, "查询分类失败")
+	}
+	if n == 0 {
+		return gerror.NewCode(gcode.CodeInvalidParameter, msg)
+	}
+	return nil
+}
+
+func (s *sBlogCategory) nextSortOrder(ctx context.Context, tx gdb.TX, parentId int64) (int, error) {
+	m := dao.BlogCategories.Ctx(ctx).TX(tx)
+	if parentId > 0 {
+		m = m.Where("parent_id", parentId)
+	} else {
+		m = m.WhereNull("parent_id")
+	}
+	n, err := m.Count()
+	if err != nil {
+		return 0, gerror.Wrap(err, "查询分类排序失败")
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	max, err := m.Max("sort_order")
+	if err != nil {
+		return 0, gerror.Wrap(err, "查询分类排序失败")
+	}
+	return int(max) + 1, nil
+}
+
+func (s *sBlogCategory) model(ctx context.Context, tx gdb.TX) func() *gdb.Model {
+	return func() *gdb.Model { return dao.BlogCategories.Ctx(ctx).TX(tx) }
+}
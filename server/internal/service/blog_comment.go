@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"html"
+	"net/url"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	v1 "server/api/blog/v1"
+	"server/internal/dao"
+	"server/internal/model/do"
+	"server/internal/model/entity"
+	"server/internal/service/antispam"
+	"server/internal/service/auth"
+)
+
+// 评论状态
+const (
+	CommentStatusApproved = "approved"
+	CommentStatusPending  = "pending"
+	CommentStatusDeleted  = "deleted"
+)
+
+// IBlogComment 博客评论服务接口
+type IBlogComment interface {
+	// Create 创建评论（经过反垃圾检查链），返回新ID、最终状态与创建时间
+	Create(ctx context.Context, req *v1.CreateCommentReq) (id int64, status string, createdAt string, err error)
+	// List 分页查询顶级评论，并返回这些评论下的全部回复
+	List(ctx context.Context, req *v1.ListCommentsReq) (roots []*entity.BlogComments, replies []*entity.BlogComments, total int, err error)
+	// Moderate 修改评论审核状态
+	Moderate(ctx context.Context, id int64, status string) error
+	// Delete 软删除评论
+	Delete(ctx context.Context, id int64) error
+}
+
+type sBlogComment struct{}
+
+// BlogComment 博客评论服务实例
+func BlogComment() IBlogComment {
+	return &sBlogComment{}
+}
+
+// Create 创建评论
+func (s *sBlogComment) Create(ctx context.Context, req *v1.CreateCommentReq) (id int64, status string, createdAt string, err error) {
+	// 文章必须存在且已发布
+	article, err := dao.BlogArticles.Ctx(ctx).
+		Where(dao.BlogArticles.Columns().Id, req.ArticleId).
+		WhereNull(dao.BlogArticles.Columns().DeletedAt).
+		One()
+	if err != nil {
+		return 0, "", "", gerror.Wrap(err, "查询文章失败")
+	}
+	if article.IsEmpty() {
+		return 0, "", "", gerror.New("文章不存在")
+	}
+	if article[dao.BlogArticles.Columns().Status].String() != "published" {
+		return 0, "", "", gerror.New("文章未发布，暂不允许评论")
+	}
+
+	// 回复必须指向同一文章下的有效评论
+	var parentId int64
+	if req.ParentId != nil && *req.ParentId > 0 {
+		parentId = *req.ParentId
+		cnt, err := dao.BlogComments.Ctx(ctx).
+			Where(dao.BlogComments.Columns().Id, parentId).
+			Where(dao.BlogComments.Columns().ArticleId, req.ArticleId).
+			Where(dao.BlogComments.Columns().IsDeleted, false).
+			Count()
+		if err != nil {
+			return 0, "", "", gerror.Wrap(err, "查询父评论失败")
+		}
+		if cnt == 0 {
+			return 0, "", "", gerror.New("回复的评论不存在")
+		}
+	}
+
+	website := strings.TrimSpace(req.VisitorWebsite)
+	if website != "" {
+		if u, err := url.Parse(website); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return 0, "", "", gerror.New("访客网站地址无效")
+		}
+	}
+
+	comment := &antispam.Comment{
+		ArticleId: req.ArticleId,
+		ParentId:  parentId,
+		Name:      strings.TrimSpace(req.VisitorName),
+		Email:     strings.TrimSpace(req.VisitorEmail),
+		Website:   website,
+		Content:   strings.TrimSpace(req.Content),
+		Honeypot:  req.Honeypot,
+		FormToken: req.FormToken,
+		PowNonce:  req.PowNonce,
+	}
+	if r := g.RequestFromCtx(ctx); r != nil {
+		comment.IP = RequestIP(ctx)
+		comment.UserAgent = r.UserAgent()
+		comment.Referrer = r.Referer()
+	}
+
+	// 执行反垃圾检查链
+	result := antispam.Default(ctx).Run(ctx, comment)
+	if result.Rejected() {
+		g.Log().Infof(ctx, "评论被反垃圾检查拒绝: articleId=%d, ip=%s, reasons=%s", req.ArticleId, comment.IP, result.Reason())
+		return 0, "", "", gerror.NewCode(gcode.CodeInvalidRequest, "评论提交被拒绝，请稍后再试")
+	}
+	status = CommentStatusApproved
+	if result.Suspicious() {
+		status = CommentStatusPending
+	}
+
+	data := do.BlogComments{
+		ArticleId:         req.ArticleId,
+		VisitorName:       comment.Name,
+		VisitorEmail:      comment.Email,
+		VisitorWebsite:    comment.Website,
+		Content:           comment.Content,
+		HtmlContent:       renderCommentHtml(comment.Content),
+		DownloadUserAgent: comment.UserAgent,
+		Status:            status,
+		IsDeleted:         false,
+		SpamReasons:       result.Reason(),
+		CreatedAt:         gtime.Now(),
+		UpdatedAt:         gtime.Now(),
+	}
+	if parentId > 0 {
+		data.ParentId = parentId
+	}
+	if comment.IP != "" {
+		data.Ip = comment.IP
+	}
+	id, err = dao.BlogComments.Ctx(ctx).InsertAndGetId(data)
+	if err != nil {
+		return 0, "", "", gerror.Wrap(err, "保存评论失败")
+	}
+	if status == CommentStatusPending {
+		g.Log().Infof(ctx, "评论进入待审核队列: id=%d, reasons=%s", id, result.Reason())
+	}
+	created := &entity.BlogComments{
+		Id:           id,
+		ArticleId:    req.ArticleId,
+		ParentId:     parentId,
+		VisitorName:  comment.Name,
+		VisitorEmail: comment.Email,
+		Content:      comment.Content,
+		Status:       status,
+		CreatedAt:    data.CreatedAt,
+	}
+	if err := BlogCommentNotify().OnCreated(ctx, created); err != nil {
+		g.Log().Warningf(ctx, "写入评论通知失败: id=%d err=%v", id, err)
+	}
+	return id, status, data.CreatedAt.String(), nil
+}
+
+// List 评论列表
+func (s *sBlogComment) List(ctx context.Context, req *v1.ListCommentsReq) (roots []*entity.BlogComments, replies []*entity.BlogComments, total int, err error) {
+	status := req.Status
+	if status == "" {
+		status = CommentStatusApproved
+	}
+	// 匿名访客只能查看已发布的评论
+	if status != CommentStatusApproved && !auth.IsAuthenticated(ctx) {
+		return nil, nil, 0, gerror.NewCode(gcode.CodeNotAuthorized, "仅登录用户可查看待审核或已删除的评论")
+	}
+	page, size := req.Page, req.Size
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+
+	cols := dao.BlogComments.Columns()
+	base := dao.BlogComments.Ctx(ctx).
+		Where(cols.ArticleId, req.ArticleId).
+		Where(cols.Status, status)
+	if status != CommentStatusDeleted {
+		base = base.Where(cols.IsDeleted, false)
+	}
+
+	rootModel := base.Clone().WhereNull(cols.ParentId)
+	total, err = rootModel.Count()
+	if err != nil {
+		return nil, nil, 0, gerror.Wrap(err, "统计评论失败")
+	}
+	if err = rootModel.OrderDesc(cols.CreatedAt).Page(page, size).Scan(&roots); err != nil {
+		return nil, nil, 0, gerror.Wrap(err, "查询评论列表失败")
+	}
+	if len(roots) == 0 {
+		return roots, nil, total, nil
+	}
+	// 回复层级较浅，直接取出该文章下全部同状态回复，由调用方按 parent_id 组装
+	if err = base.Clone().WhereNotNull(cols.ParentId).OrderAsc(cols.CreatedAt).Scan(&replies); err != nil {
+		return roots, nil, total, gerror.Wrap(err, "查询评论回复失败")
+	}
+	return roots, replies, total, nil
+}
+
+// Moderate 审核评论
+func (s *sBlogComment) Moderate(ctx context.Context, id int64, status string) error {
+	cols := dao.BlogComments.Columns()
+	var comment *entity.BlogComments
+	if err := dao.BlogComments.Ctx(ctx).Where(cols.Id, id).Scan(&comment); err != nil {
+		return gerror.Wrap(err, "查询评论失败")
+	}
+	if comment == nil {
+		return gerror.New("评论不存在")
+	}
+	_, err := dao.BlogComments.Ctx(ctx).Where(cols.Id, id).Update(g.Map{
+		cols.Status:    status,
+		cols.IsDeleted: status == CommentStatusDeleted,
+		cols.UpdatedAt: gtime.Now(),
+	})
+	if err != nil {
+		return gerror.Wrap(err, "更新评论状态失败")
+	}
+	// 待审核的回复通过后才通知被回复的访客
+	if comment.Status != CommentStatusApproved && status == CommentStatusApproved {
+		comment.Status, comment.IsDeleted = status, false
+		if err := BlogCommentNotify().OnApproved(ctx, comment); err != nil {
+			g.Log().Warningf(ctx, "写入评论通知失败: id=%d err=%v", id, err)
+		}
+	}
+	return nil
+}
+
+// Delete 软删除评论
+func (s *sBlogComment) Delete(ctx context.Context, id int64) error {
+	return s.Moderate(ctx, id, CommentStatusDeleted)
+}
+
+// renderCommentHtml 评论内容按纯文本处理：转义HTML并保留换行
+func renderCommentHtml(content string) string {
+	escaped := html.EscapeString(content)
+	paragraphs := strings.Split(strings.ReplaceAll(escaped, "\r\n", "\n"), "\n\n")
+	for i, p := range paragraphs {
+		paragraphs[i] = "<p>" + strings.ReplaceAll(strings.TrimSpace(p), "\n", "<br>") + "</p>"
+	}
+	return strings.Join(paragraphs, "")
+}
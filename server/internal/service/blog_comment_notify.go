@@ -0,0 +1,360 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/encoding/gjson"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/text/gstr"
+
+	"server/internal/dao"
+	"server/internal/model/do"
+	"server/internal/model/entity"
+	"server/internal/service/auth"
+	"server/internal/service/configcache"
+	"server/internal/service/mailer"
+)
+
+// 评论通知邮件类型
+const (
+	mailKindCommentNew     = "comment_new"     // 管理员：新评论（已通过）
+	mailKindCommentPending = "comment_pending" // 管理员：评论待审核
+	mailKindCommentReply   = "comment_reply"   // 访客：评论收到回复
+)
+
+const (
+	// commentNotifyListLimit 管理员通知邮件中最多列出的评论数
+	commentNotifyListLimit = 20
+	// commentExcerptLen 通知邮件中评论内容摘录的最大字数
+	commentExcerptLen = 200
+)
+
+func init() {
+	registerMailRenderer(mailKindCommentNew, renderCommentAdmin)
+	registerMailRenderer(mailKindCommentPending, renderCommentAdmin)
+	registerMailRenderer(mailKindCommentReply, renderCommentReply)
+}
+
+// IBlogCommentNotify 评论通知服务接口
+type IBlogCommentNotify interface {
+	// OnCreated 评论创建后写入通知：待审核评论通知管理员，已通过的评论通知管理员并通知被回复的访客
+	OnCreated(ctx context.Context, comment *entity.BlogComments) error
+	// OnApproved 待审核评论通过后通知被回复的访客
+	OnApproved(ctx context.Context, comment *entity.BlogComments) error
+	// Unsubscribe 通过通知邮件中的令牌退订评论串（或全部）的回复通知，返回退订的评论串ID（0 为全部）
+	Unsubscribe(ctx context.Context, token string) (threadId int64, err error)
+}
+
+type sBlogCommentNotify struct{}
+
+// BlogCommentNotify 评论通知服务实例
+func BlogCommentNotify() IBlogCommentNotify {
+	return &sBlogCommentNotify{}
+}
+
+// OnCreated 新评论通知
+func (s *sBlogCommentNotify) OnCreated(ctx context.Context, comment *entity.BlogComments) error {
+	switch comment.Status {
+	case CommentStatusPending:
+		if configcache.GetBool(ctx, blogConfigNamespace, blogConfigEnv, "comment_notify_admin_pending", true) {
+			return s.notifyAdmin(ctx, mailKindCommentPending, comment)
+		}
+	case CommentStatusApproved:
+		if configcache.GetBool(ctx, blogConfigNamespace, blogConfigEnv, "comment_notify_admin_new", true) {
+			if err := s.notifyAdmin(ctx, mailKindCommentNew, comment); err != nil {
+				return err
+			}
+		}
+		return s.notifyReply(ctx, comment)
+	}
+	return nil
+}
+
+// OnApproved 审核通过通知
+func (s *sBlogCommentNotify) OnApproved(ctx context.Context, comment *entity.BlogComments) error {
+	return s.notifyReply(ctx, comment)
+}
+
+// notifyAdmin 通知管理员
+//
+// 节流：邮件延迟 comment_notify_batch_minutes 分钟发送，期间同类通知合并为一封，
+// 发送时列出自首条评论以来的全部同类评论；未配置 comment_notify_admin_email 时不通知
+func (s *sBlogCommentNotify) notifyAdmin(ctx context.Context, kind string, comment *entity.BlogComments) error {
+	to := strings.TrimSpace(configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "comment_notify_admin_email", ""))
+	if to == "" {
+		return nil
+	}
+	// 管理员本人的评论不通知
+	if strings.EqualFold(to, comment.VisitorEmail) {
+		return nil
+	}
+	count, err := dao.MailOutbox.Ctx(ctx).
+		Where("kind", kind).
+		Where("recipient", to).
+		Where("status", MailStatusPending).
+		Count()
+	if err != nil {
+		return gerror.Wrap(err, "查询待发送的评论通知失败")
+	}
+	if count > 0 {
+		return nil
+	}
+	batch := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "comment_notify_batch_minutes", 5)
+	return enqueueMailAt(ctx, kind, to, g.Map{"since": comment.CreatedAt.Timestamp()}, time.Now().Add(time.Duration(batch)*time.Minute))
+}
+
+// notifyReply 通知被回复的访客
+//
+// 以下情况不通知：不是回复、被回复的评论已删除或未通过、没有邮箱、回复自己、已退订该评论串或全部通知、
+// 同一条回复已通知过，或收件人一小时内收到的回复通知达到 comment_notify_reply_per_hour
+func (s *sBlogCommentNotify) notifyReply(ctx context.Context, comment *entity.BlogComments) error {
+	if comment.ParentId == 0 || comment.Status != CommentStatusApproved {
+		return nil
+	}
+	if !configcache.GetBool(ctx, blogConfigNamespace, blogConfigEnv, "comment_notify_replies", true) {
+		return nil
+	}
+	parent, err := approvedComment(ctx, comment.ParentId)
+	if err != nil || parent == nil {
+		return err
+	}
+	to := strings.ToLower(strings.TrimSpace(parent.VisitorEmail))
+	if !mailer.ValidAddress(to) || strings.EqualFold(to, strings.TrimSpace(comment.VisitorEmail)) {
+		return nil
+	}
+	threadId, err := commentThreadId(ctx, comment.Id)
+	if err != nil {
+		return err
+	}
+	if unsubscribed, err := commentRepliesUnsubscribed(ctx, to, threadId); err != nil || unsubscribed {
+		return err
+	}
+
+	notified, err := dao.MailOutbox.Ctx(ctx).
+		Where("kind", mailKindCommentReply).
+		Where("(payload->>'commentId')::BIGINT = ?", comment.Id).
+		Count()
+	if err != nil {
+		return gerror.Wrap(err, "查询评论通知记录失败")
+	}
+	if notified > 0 {
+		return nil
+	}
+	limit := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "comment_notify_reply_per_hour", 5)
+	if limit > 0 {
+		recent, err := dao.MailOutbox.Ctx(ctx).
+			Where("kind", mailKindCommentReply).
+			Where("recipient", to).
+			Where("created_at > NOW() - INTERVAL '1 hour'").
+			Count()
+		if err != nil {
+			return gerror.Wrap(err, "查询评论通知记录失败")
+		}
+		if recent >= limit {
+			g.Log().Infof(ctx, "回复通知超过频率限制，跳过: to=%s commentId=%d", to, comment.Id)
+			return nil
+		}
+	}
+	return MailOutbox().Enqueue(ctx, mailKindCommentReply, to, g.Map{"commentId": comment.Id, "threadId": threadId})
+}
+
+// Unsubscribe 退订回复通知，重复退订视为成功
+func (s *sBlogCommentNotify) Unsubscribe(ctx context.Context, token string) (int64, error) {
+	claims, err := auth.ValidateCommentUnsubscribeToken(ctx, token)
+	if err != nil {
+		return 0, gerror.NewCode(gcode.CodeInvalidParameter, "退订链接无效")
+	}
+	_, err = dao.BlogCommentUnsubscribes.Ctx(ctx).Data(do.BlogCommentUnsubscribes{
+		Email:     strings.ToLower(claims.Email),
+		ThreadId:  claims.ThreadId,
+		CreatedAt: gtime.Now(),
+	}).InsertIgnore()
+	if err != nil {
+		return 0, gerror.Wrap(err, "退订评论通知失败")
+	}
+	return claims.ThreadId, nil
+}
+
+// approvedComment 查询未删除且已通过的评论，不存在时返回 nil
+func approvedComment(ctx context.Context, id int64) (*entity.BlogComments, error) {
+	var comment *entity.BlogComments
+	err := dao.BlogComments.Ctx(ctx).
+		Where("id", id).
+		Where("status", CommentStatusApproved).
+		Where("is_deleted", false).
+		Scan(&comment)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询评论失败")
+	}
+	return comment, nil
+}
+
+// commentThreadId 评论所在评论串的顶级评论ID
+func commentThreadId(ctx context.Context, commentId int64) (int64, error) {
+	v, err := g.DB().Ctx(ctx).GetValue(ctx, `WITH RECURSIVE up AS (
+			SELECT id, parent_id FROM blog_comments WHERE id = ?
+			UNION
+			SELECT c.id, c.parent_id FROM blog_comments c INNER JOIN up ON c.id = up.parent_id
+		)
+		SELECT id FROM up WHERE parent_id IS NULL LIMIT 1`, commentId)
+	if err != nil {
+		return 0, gerror.Wrap(err, "查询评论串失败")
+	}
+	return v.Int64(), nil
+}
+
+// commentRepliesUnsubscribed 邮箱是否已退订该评论串或全部回复通知
+func commentRepliesUnsubscribed(ctx context.Context, email string, threadId int64) (bool, error) {
+	count, err := dao.BlogCommentUnsubscribes.Ctx(ctx).
+		Where("email", strings.ToLower(email)).
+		WhereIn("thread_id", g.Slice{0, threadId}).
+		Count()
+	if err != nil {
+		return false, gerror.Wrap(err, "查询评论通知退订记录失败")
+	}
+	return count > 0, nil
+}
+
+// ---------- 邮件渲染 ----------
+
+// commentMailItem 邮件模板中的评论
+type commentMailItem struct {
+	Author       string
+	Excerpt      string
+	ArticleTitle string
+	URL          string
+	CreatedAt    string
+}
+
+// commentExcerpt 评论内容摘录（纯文本）
+func commentExcerpt(content string) string {
+	content = strings.Join(strings.Fields(content), " ")
+	if len([]rune(content)) > commentExcerptLen {
+		return gstr.SubStrRune(content, 0, commentExcerptLen) + "…"
+	}
+	return content
+}
+
+// commentURL 评论在前台的地址
+func commentURL(site *SiteInfo, slug string, commentId int64) string {
+	return site.ArticleURL(slug) + "#comment-" + strconv.FormatInt(commentId, 10)
+}
+
+// renderCommentAdmin 渲染管理员通知：列出自 since 以来仍处于对应状态的评论，没有时不发送
+func renderCommentAdmin(ctx context.Context, item *entity.MailOutbox, payload *gjson.Json) (*mailer.Message, error) {
+	status := CommentStatusApproved
+	if item.Kind == mailKindCommentPending {
+		status = CommentStatusPending
+	}
+	var rows []struct {
+		entity.BlogComments
+		Title string
+		Slug  string
+	}
+	m := g.DB().Model("blog_comments c").Ctx(ctx).
+		InnerJoin("blog_articles a", "a.id = c.article_id").
+		Where("c.status", status).
+		Where("c.is_deleted", false).
+		Where("c.created_at >= ?", gtime.NewFromTimeStamp(payload.Get("since").Int64()))
+	total, err := m.Count()
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询评论失败")
+	}
+	if total == 0 {
+		return nil, errMailSkipped
+	}
+	if err = m.Fields("c.*, a.title, a.slug").OrderDesc("c.created_at").Limit(commentNotifyListLimit).Scan(&rows); err != nil {
+		return nil, gerror.Wrap(err, "查询评论失败")
+	}
+
+	site := LoadSiteInfo(ctx)
+	comments := make([]commentMailItem, 0, len(rows))
+	for _, r := range rows {
+		comments = append(comments, commentMailItem{
+			Author:       r.VisitorName,
+			Excerpt:      commentExcerpt(r.Content),
+			ArticleTitle: r.Title,
+			URL:          commentURL(site, r.Slug, r.Id),
+			CreatedAt:    r.CreatedAt.Layout("2006-01-02 15:04"),
+		})
+	}
+	title := site.Title + " 有 " + strconv.Itoa(total) + " 条新评论"
+	if status == CommentStatusPending {
+		title = site.Title + " 有 " + strconv.Itoa(total) + " 条评论待审核"
+	}
+	return renderMail(ctx, item.Recipient, "comment_admin", g.Map{
+		"title":    title,
+		"pending":  status == CommentStatusPending,
+		"total":    total,
+		"comments": comments,
+		"more":     total - len(comments),
+		"adminURL": site.URL + "/admin",
+	})
+}
+
+// renderCommentReply 渲染回复通知；回复或被回复的评论已删除、文章已不公开或收件人已退订时不发送
+func renderCommentReply(ctx context.Context, item *entity.MailOutbox, payload *gjson.Json) (*mailer.Message, error) {
+	reply, err := approvedComment(ctx, payload.Get("commentId").Int64())
+	if err != nil {
+		return nil, err
+	}
+	if reply == nil {
+		return nil, errMailSkipped
+	}
+	parent, err := approvedComment(ctx, reply.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		return nil, errMailSkipped
+	}
+	threadId := payload.Get("threadId").Int64()
+	if unsubscribed, err := commentRepliesUnsubscribed(ctx, item.Recipient, threadId); err != nil {
+		return nil, err
+	} else if unsubscribed {
+		return nil, errMailSkipped
+	}
+	var article *entity.BlogArticles
+	if err = publishedArticles(ctx).Where("id", reply.ArticleId).Scan(&article); err != nil {
+		return nil, gerror.Wrap(err, "查询文章失败")
+	}
+	if article == nil {
+		return nil, errMailSkipped
+	}
+
+	threadToken, err := auth.GenerateCommentUnsubscribeToken(ctx, item.Recipient, threadId)
+	if err != nil {
+		return nil, gerror.Wrap(err, "生成退订令牌失败")
+	}
+	allToken, err := auth.GenerateCommentUnsubscribeToken(ctx, item.Recipient, 0)
+	if err != nil {
+		return nil, gerror.Wrap(err, "生成退订令牌失败")
+	}
+	site := LoadSiteInfo(ctx)
+	msg, err := renderMail(ctx, item.Recipient, "comment_reply", g.Map{
+		"title":             reply.VisitorName + " 回复了你在《" + article.Title + "》的评论",
+		"name":              parent.VisitorName,
+		"articleTitle":      article.Title,
+		"parentExcerpt":     commentExcerpt(parent.Content),
+		"replyAuthor":       reply.VisitorName,
+		"replyExcerpt":      commentExcerpt(reply.Content),
+		"replyURL":          commentURL(site, article.Slug, reply.Id),
+		"threadUnsubscribe": site.URL + "/comments/unsubscribe?token=" + url.QueryEscape(threadToken),
+		"allUnsubscribe":    site.URL + "/comments/unsubscribe?token=" + url.QueryEscape(allToken),
+	})
+	if err != nil {
+		return nil, err
+	}
+	msg.Headers = map[string]string{
+		"List-Unsubscribe":      "<" + site.APIURL + "/blog/comments/unsubscribe?token=" + url.QueryEscape(threadToken) + ">",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+	return msg, nil
+}
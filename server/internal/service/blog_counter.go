@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+
+	"server/internal/dao"
+	"server/internal/service/auth"
+	"server/internal/service/configcache"
+	"server/internal/service/counter"
+)
+
+// 文章计数字段
+const (
+	CounterViews  = "view_count"
+	CounterLikes  = "like_count"
+	CounterShares = "share_count"
+)
+
+// visitorCookie 匿名访客标识 Cookie，首次访问时以 IP+UA 摘要签发并附加签名，之后优先使用
+const (
+	visitorCookie       = "blog_vid"
+	visitorCookieMaxAge = 365 * 24 * time.Hour
+)
+
+var (
+	visitorIdPattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+	// botUserAgentPattern 爬虫、预览抓取与命令行工具，不计入浏览与分享
+	botUserAgentPattern = regexp.MustCompile(`(?i)bot|crawl|spider|slurp|archiver|scrapy|curl|wget|python-requests|go-http-client|okhttp|httpclient|headless|phantomjs|lighthouse|facebookexternalhit|embedly|preview`)
+
+	articleCounters = counter.NewBuffer()
+	viewWindow      = counter.NewWindow()
+	shareWindow     = counter.NewWindow()
+)
+
+// IBlogCounter 文章浏览、点赞、分享计数服务接口
+type IBlogCounter interface {
+	// RecordView 记录一次浏览：同一访客在去重窗口内只计一次，爬虫不计；返回是否计入
+	RecordView(ctx context.Context, articleId int64) bool
+	// Like 当前访客点赞文章，重复点赞无副作用；返回最新点赞数
+	Like(ctx context.Context, articleId int64) (likeCount int, err error)
+	// Unlike 当前访客取消点赞；返回最新点赞数
+	Unlike(ctx context.Context, articleId int64) (likeCount int, err error)
+	// IsLiked 当前访客是否已点赞
+	IsLiked(ctx context.Context, articleId int64) bool
+	// RecordShare 记录一次分享，同一访客同一渠道在去重窗口内只计一次；返回最新分享数
+	RecordShare(ctx context.Context, articleId int64, channel string) (shareCount int, err error)
+	// Pending 返回文章尚未写入数据库的计数增量
+	Pending(articleId int64, field string) int
+	// Flush 将缓冲的计数增量批量写入数据库
+	Flush(ctx context.Context) error
+}
+
+type sBlogCounter struct{}
+
+// BlogCounter 文章计数服务实例
+func BlogCounter() IBlogCounter {
+	return &sBlogCounter{}
+}
+
+// RecordView 记录浏览
+func (s *sBlogCounter) RecordView(ctx context.Context, articleId int64) bool {
+	r := g.RequestFromCtx(ctx)
+	if r == nil || IsBotUserAgent(r.UserAgent()) {
+		return false
+	}
+	window := time.Duration(configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "view_dedup_window_minutes", 30)) * time.Minute
+	if !viewWindow.First(fmt.Sprintf("%d:%s", articleId, visitorId(ctx, r)), window) {
+		return false
+	}
+	articleCounters.Add(articleId, CounterViews, 1)
+	return true
+}
+
+// Like 点赞
+func (s *sBlogCounter) Like(ctx context.Context, articleId int64) (int, error) {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return 0, gerror.New("无法识别访客")
+	}
+	if err := mustBeVisibleArticle(ctx, articleId); err != nil {
+		return 0, err
+	}
+	var ip interface{}
+	if addr := RequestIP(ctx); addr != "" {
+		ip = addr
+	}
+	res, err := g.DB().Exec(ctx, `INSERT INTO blog_article_likes (article_id, visitor_id, ip, created_at) VALUES (?, ?, ?, NOW())
+		ON CONFLICT (article_id, visitor_id) DO NOTHING`, articleId, visitorId(ctx, r), ip)
+	if err != nil {
+		return 0, gerror.Wrap(err, "点赞失败")
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		articleCounters.Add(articleId, CounterLikes, 1)
+	}
+	return s.current(ctx, articleId, CounterLikes)
+}
+
+// Unlike 取消点赞
+func (s *sBlogCounter) Unlike(ctx context.Context, articleId int64) (int, error) {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return 0, gerror.New("无法识别访客")
+	}
+	res, err := dao.BlogArticleLikes.Ctx(ctx).
+		Where("article_id", articleId).
+		Where("visitor_id", visitorId(ctx, r)).
+		Delete()
+	if err != nil {
+		return 0, gerror.Wrap(err, "取消点赞失败")
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		articleCounters.Add(articleId, CounterLikes, -1)
+	}
+	return s.current(ctx, articleId, CounterLikes)
+}
+
+// IsLiked 是否已点赞
+func (s *sBlogCounter) IsLiked(ctx context.Context, articleId int64) bool {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return false
+	}
+	n, err := dao.BlogArticleLikes.Ctx(ctx).Where("article_id", articleId).Where("visitor_id", visitorId(ctx, r)).Count()
+	return err == nil && n > 0
+}
+
+// RecordShare 记录分享
+func (s *sBlogCounter) RecordShare(ctx context.Context, articleId int64, channel string) (int, error) {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return 0, gerror.New("无法识别访客")
+	}
+	if err := mustBeVisibleArticle(ctx, articleId); err != nil {
+		return 0, err
+	}
+	window := time.Duration(configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "view_dedup_window_minutes", 30)) * time.Minute
+	key := fmt.Sprintf("%d:%s:%s", articleId, strings.ToLower(channel), visitorId(ctx, r))
+	if !IsBotUserAgent(r.UserAgent()) && shareWindow.First(key, window) {
+		articleCounters.Add(articleId, CounterShares, 1)
+		g.Log().Debugf(ctx, "文章分享: articleId=%d channel=%s", articleId, channel)
+	}
+	return s.current(ctx, articleId, CounterShares)
+}
+
+// Pending 未写入的增量
+func (s *sBlogCounter) Pending(articleId int64, field string) int {
+	return articleCounters.Pending(articleId, field)
+}
+
+// Flush 批量写入计数
+//
+// 所有文章的增量合并为一条 UPDATE ... FROM (VALUES ...) 语句，按 ID 排序以固定加锁顺序；
+// 写入失败时增量放回缓冲，下次重试。
+func (s *sBlogCounter) Flush(ctx context.Context) error {
+	deltas := articleCounters.Drain()
+	if len(deltas) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(deltas))
+	for id := range deltas {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var (
+		values []string
+		args   []interface{}
+	)
+	for _, id := range ids {
+		d := deltas[id]
+		values = append(values, "(?::BIGINT, ?::INT, ?::INT, ?::INT)")
+		args = append(args, id, d[CounterViews], d[CounterLikes], d[CounterShares])
+	}
+	_, err := g.DB().Exec(ctx, `UPDATE blog_articles a SET
+			view_count = GREATEST(COALESCE(a.view_count, 0) + v.views, 0),
+			like_count = GREATEST(COALESCE(a.like_count, 0) + v.likes, 0),
+			share_count = GREATEST(COALESCE(a.share_count, 0) + v.shares, 0)
+		FROM (VALUES `+strings.Join(values, ", ")+`) AS v(id, views, likes, shares)
+		WHERE a.id = v.id`, args...)
+	if err != nil {
+		articleCounters.Restore(deltas)
+		return gerror.Wrap(err, "写入文章计数失败")
+	}
+	return nil
+}
+
+// current 当前计数：数据库中的值加上未写入的增量
+func (s *sBlogCounter) current(ctx context.Context, articleId int64, field string) (int, error) {
+	v, err := dao.BlogArticles.Ctx(ctx).Where("id", articleId).Value(field)
+	if err != nil {
+		return 0, gerror.Wrap(err, "查询文章计数失败")
+	}
+	n := v.Int() + articleCounters.Pending(articleId, field)
+	if n < 0 {
+		n = 0
+	}
+	return n, nil
+}
+
+// mustBeVisibleArticle 只有公开可见的已发布文章可以点赞、分享
+func mustBeVisibleArticle(ctx context.Context, articleId int64) error {
+	n, err := publishedArticles(ctx).Where("id", articleId).Count()
+	if err != nil {
+		return gerror.Wrap(err, "查询文章失败")
+	}
+	if n == 0 {
+		return gerror.NewCode(gcode.CodeNotFound, "文章不存在")
+	}
+	return nil
+}
+
+// visitorId 读取访客标识；Cookie 缺失或签名无效时以 IP+UA 摘要重新签发，
+// 使不保存 Cookie 的客户端也能稳定去重，且无法通过伪造 Cookie 重复计数
+func visitorId(ctx context.Context, r *ghttp.Request) string {
+	if id, ok := auth.VerifyVisitorId(ctx, r.Cookie.Get(visitorCookie).String()); ok && visitorIdPattern.MatchString(id) {
+		return id
+	}
+	sum := sha256.Sum256([]byte(RequestIP(ctx) + "|" + r.UserAgent()))
+	id := hex.EncodeToString(sum[:16])
+	if signed, err := auth.SignVisitorId(ctx, id); err == nil {
+		r.Cookie.SetCookie(visitorCookie, signed, "", "/", visitorCookieMaxAge, ghttp.CookieOptions{HttpOnly: true, SameSite: http.SameSiteLaxMode})
+	}
+	return id
+}
+
+// IsBotUserAgent 判断 UA 是否为爬虫或自动化工具，空 UA 视为爬虫
+func IsBotUserAgent(ua string) bool {
+	ua = strings.TrimSpace(ua)
+	return ua == "" || botUserAgentPattern.MatchString(ua)
+}
+
+// StartCounterFlusher 启动计数写入任务，间隔由 blog/default counter_flush_interval_seconds 配置；
+// ctx 结束时写入剩余增量
+func StartCounterFlusher(ctx context.Context) {
+	go func() {
+		for {
+			interval := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "counter_flush_interval_seconds", 10)
+			if interval < 1 {
+				interval = 1
+			}
+			select {
+			case <-ctx.Done():
+				if err := BlogCounter().Flush(context.Background()); err != nil {
+					g.Log().Errorf(ctx, "写入剩余文章计数失败: %v", err)
+				}
+				g.Log().Info(ctx, "文章计数写入任务已停止")
+				return
+			case <-time.After(time.Duration(interval) * time.Second):
+				if err := BlogCounter().Flush(ctx); err != nil {
+					g.Log().Errorf(ctx, "写入文章计数失败: %v", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,459 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	htmltpl "html/template"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gview"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+)
+
+// 导出格式
+const (
+	ExportFormatMarkdown = "markdown" // 每篇文章一个带 Front Matter 的 Markdown 文件
+	ExportFormatHTML     = "html"     // 自包含的静态 HTML 站点
+)
+
+// exportTemplateDir 静态站点模板目录（位于 resource/template 下）
+const exportTemplateDir = "export"
+
+// ExportInput 导出参数
+type ExportInput struct {
+	Format string
+	// IncludeDrafts Markdown 导出是否包含草稿、定时与私密文章；静态站点始终只包含公开的已发布文章
+	IncludeDrafts bool
+}
+
+// ExportResult 导出结果统计
+type ExportResult struct {
+	Size     int64 // 压缩包字节数
+	Articles int
+	Assets   int
+}
+
+// IBlogExport 博客内容导出服务接口
+type IBlogExport interface {
+	// Export 将文章及其引用的图片导出为 zip 压缩包，边生成边写入 w，不在内存中保留整个压缩包
+	Export(ctx context.Context, in *ExportInput, w io.Writer) (*ExportResult, error)
+}
+
+// ExportFileName 导出压缩包的默认文件名
+func ExportFileName(format string) string {
+	return fmt.Sprintf("blog-%s-%s.zip", format, time.Now().Format("20060102-150405"))
+}
+
+type sBlogExport struct{}
+
+// BlogExport 博客内容导出服务实例
+func BlogExport() IBlogExport {
+	return &sBlogExport{}
+}
+
+// exportTag 文章标签（名称与 slug）
+type exportTag struct {
+	Name string
+	Slug string
+}
+
+// exportRun 单次导出的状态
+type exportRun struct {
+	ctx        context.Context
+	site       *SiteInfo
+	zw         *zip.Writer
+	categories map[int64]*entity.BlogCategories
+	tags       map[int64][]exportTag
+	seo        map[int64]*entity.BlogSeoData
+	assets     map[string]string // 文件UUID -> 压缩包内路径，空串表示读取失败
+	urlPattern *regexp.Regexp
+}
+
+// countingWriter 统计写入的字节数
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Export 导出博客内容
+func (s *sBlogExport) Export(ctx context.Context, in *ExportInput, w io.Writer) (*ExportResult, error) {
+	if in.Format != ExportFormatMarkdown && in.Format != ExportFormatHTML {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "不支持的导出格式: %s", in.Format)
+	}
+
+	m := dao.BlogArticles.Ctx(ctx).
+		FieldsEx(dao.BlogArticles.Columns().SearchVector).
+		WhereNull("deleted_at")
+	if in.Format == ExportFormatHTML || !in.IncludeDrafts {
+		m = m.Where("status", ArticleStatusPublished).Where("is_private", false)
+	}
+	var articles []*entity.BlogArticles
+	if err := m.Order("publish_at DESC NULLS LAST, id DESC").Scan(&articles); err != nil {
+		return nil, gerror.Wrap(err, "查询导出文章失败")
+	}
+
+	site := LoadSiteInfo(ctx)
+	out := &countingWriter{w: w}
+	run := &exportRun{
+		ctx:    ctx,
+		site:   site,
+		zw:     zip.NewWriter(out),
+		assets: make(map[string]string),
+		// 本站文件地址：相对路径或带站点/API 根地址的绝对路径
+		urlPattern: regexp.MustCompile(`(?:` + regexp.QuoteMeta(site.APIURL) + `|` + regexp.QuoteMeta(site.URL) + `)?` +
+			fileURLPattern.String() + `(?:\?[^\s"'<>()]*)?`),
+	}
+	if err := run.load(articles); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if in.Format == ExportFormatMarkdown {
+		err = run.markdown(articles)
+	} else {
+		err = run.html(articles)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = run.zw.Close(); err != nil {
+		return nil, gerror.Wrap(err, "生成压缩包失败")
+	}
+
+	assets := 0
+	for _, p := range run.assets {
+		if p != "" {
+			assets++
+		}
+	}
+	g.Log().Infof(ctx, "博客导出完成: format=%s articles=%d assets=%d size=%d", in.Format, len(articles), assets, out.n)
+	return &ExportResult{
+		Size:     out.n,
+		Articles: len(articles),
+		Assets:   assets,
+	}, nil
+}
+
+// load 批量加载分类、标签与 SEO 数据
+func (r *exportRun) load(articles []*entity.BlogArticles) error {
+	var categories []*entity.BlogCategories
+	if err := dao.BlogCategories.Ctx(r.ctx).Scan(&categories); err != nil {
+		return gerror.Wrap(err, "查询分类失败")
+	}
+	r.categories = make(map[int64]*entity.BlogCategories, len(categories))
+	for _, c := range categories {
+		r.categories[c.Id] = c
+	}
+
+	r.tags = make(map[int64][]exportTag)
+	r.seo = make(map[int64]*entity.BlogSeoData)
+	if len(articles) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(articles))
+	for _, a := range articles {
+		ids = append(ids, a.Id)
+	}
+	rows, err := g.DB().Ctx(r.ctx).GetAll(r.ctx, `SELECT bat.article_id, bt.name, bt.slug FROM blog_article_tags bat
+		INNER JOIN blog_tags bt ON bt.id = bat.tag_id
+		WHERE bat.article_id IN(?) ORDER BY bt.name`, ids)
+	if err != nil {
+		return gerror.Wrap(err, "查询文章标签失败")
+	}
+	for _, row := range rows {
+		id := row["article_id"].Int64()
+		r.tags[id] = append(r.tags[id], exportTag{Name: row["name"].String(), Slug: row["slug"].String()})
+	}
+	var seo []*entity.BlogSeoData
+	if err = dao.BlogSeoData.Ctx(r.ctx).WhereIn("article_id", ids).Scan(&seo); err != nil {
+		return gerror.Wrap(err, "查询文章SEO数据失败")
+	}
+	for _, item := range seo {
+		r.seo[item.ArticleId] = item
+	}
+	return nil
+}
+
+// categoryPath 分类由顶级到末级的路径
+func (r *exportRun) categoryPath(id int64) []*entity.BlogCategories {
+	var chain []*entity.BlogCategories
+	seen := make(map[int64]bool)
+	for c := r.categories[id]; c != nil && !seen[c.Id]; c = r.categories[c.ParentId] {
+		seen[c.Id] = true
+		chain = append([]*entity.BlogCategories{c}, chain...)
+	}
+	return chain
+}
+
+// asset 将本站文件写入压缩包 assets/ 目录并返回其路径，同一文件只写入一次
+func (r *exportRun) asset(uuid string) string {
+	if p, ok := r.assets[uuid]; ok {
+		return p
+	}
+	content, fileName, _, err := File().GetFileContent(r.ctx, uuid)
+	if err != nil {
+		g.Log().Warningf(r.ctx, "导出图片失败: uuid=%s err=%v", uuid, err)
+		r.assets[uuid] = ""
+		return ""
+	}
+	p := "assets/" + uuid + strings.ToLower(path.Ext(fileName))
+	if err = r.write(p, content); err != nil {
+		g.Log().Warningf(r.ctx, "写入导出图片失败: uuid=%s err=%v", uuid, err)
+		p = ""
+	}
+	r.assets[uuid] = p
+	return p
+}
+
+// rewriteAssets 将文本中的本站文件地址替换为 prefix + 压缩包内路径，读取失败的保留原地址
+func (r *exportRun) rewriteAssets(text, prefix string) string {
+	return r.urlPattern.ReplaceAllStringFunc(text, func(s string) string {
+		uuid := fileUUIDFromURL(s)
+		if p := r.asset(uuid); p != "" {
+			return prefix + p
+		}
+		return s
+	})
+}
+
+func (r *exportRun) write(name string, content []byte) error {
+	w, err := r.zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
+
+// markdown 每篇文章导出为 posts/<slug>.md（slug 为空时为 posts/<id>.md），Front Matter 与导入格式兼容；
+// 备份需完整保留正文，受密码保护的文章以 password: true 标记，密码本身不导出
+func (r *exportRun) markdown(articles []*entity.BlogArticles) error {
+	for _, a := range articles {
+		var b strings.Builder
+		b.WriteString("---\n")
+		yamlField(&b, "", "title", a.Title)
+		yamlField(&b, "", "slug", a.Slug)
+		if t := articleDate(a); !t.IsZero() {
+			b.WriteString("date: " + t.Format(time.RFC3339) + "\n")
+		}
+		if a.CreatedAt != nil {
+			b.WriteString("created: " + a.CreatedAt.Time.Format(time.RFC3339) + "\n")
+		}
+		if a.UpdatedAt != nil {
+			b.WriteString("updated: " + a.UpdatedAt.Time.Format(time.RFC3339) + "\n")
+		}
+		b.WriteString("status: " + a.Status + "\n")
+		b.WriteString("draft: " + strconv.FormatBool(a.Status != ArticleStatusPublished) + "\n")
+		if a.IsPrivate {
+			b.WriteString("private: true\n")
+		}
+		if a.IsTop {
+			b.WriteString("top: true\n")
+		}
+		if IsArticleProtected(a) {
+			b.WriteString("password: true\n")
+		}
+		if chain := r.categoryPath(a.CategoryId); len(chain) > 0 {
+			names := make([]string, 0, len(chain))
+			for _, c := range chain {
+				names = append(names, c.Name)
+			}
+			yamlList(&b, "categories", names)
+		}
+		if tags := r.tags[a.Id]; len(tags) > 0 {
+			names := make([]string, 0, len(tags))
+			for _, t := range tags {
+				names = append(names, t.Name)
+			}
+			yamlList(&b, "tags", names)
+		}
+		yamlField(&b, "", "summary", a.Summary)
+		yamlField(&b, "", "cover", r.rewriteAssets(a.FeaturedImage, "../"))
+		if seo := r.seo[a.Id]; seo != nil {
+			fields := [][2]string{
+				{"metaTitle", seo.MetaTitle},
+				{"metaDescription", seo.MetaDescription},
+				{"metaKeywords", seo.MetaKeywords},
+				{"ogTitle", seo.OgTitle},
+				{"ogDescription", seo.OgDescription},
+				{"ogImage", r.rewriteAssets(seo.OgImage, "../")},
+				{"twitterTitle", seo.TwitterTitle},
+				{"twitterDescription", seo.TwitterDescription},
+				{"twitterImage", r.rewriteAssets(seo.TwitterImage, "../")},
+				{"canonicalUrl", seo.CanonicalUrl},
+			}
+			var sb strings.Builder
+			for _, f := range fields {
+				yamlField(&sb, "  ", f[0], f[1])
+			}
+			if sb.Len() > 0 {
+				b.WriteString("seo:\n" + sb.String())
+			}
+		}
+		b.WriteString("---\n")
+		b.WriteString("\n" + r.rewriteAssets(a.Content, "../") + "\n")
+		if err := r.write("posts/"+exportName(a)+".md", []byte(b.String())); err != nil {
+			return gerror.Wrap(err, "写入导出文件失败")
+		}
+	}
+	return nil
+}
+
+// yamlField 输出非空的 YAML 字符串字段；双引号字符串的转义与 Go 字面量兼容
+func yamlField(b *strings.Builder, indent, key, value string) {
+	if value == "" {
+		return
+	}
+	b.WriteString(indent + key + ": " + strconv.Quote(value) + "\n")
+}
+
+func yamlList(b *strings.Builder, key string, values []string) {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, strconv.Quote(v))
+	}
+	b.WriteString(key + ": [" + strings.Join(quoted, ", ") + "]\n")
+}
+
+// exportName 文章在压缩包中的文件名（不含扩展名），slug 为空时使用文章ID
+func exportName(a *entity.BlogArticles) string {
+	if a.Slug != "" {
+		return a.Slug
+	}
+	return strconv.FormatInt(a.Id, 10)
+}
+
+// articleDate 文章的发布时间，未发布时使用创建时间
+func articleDate(a *entity.BlogArticles) time.Time {
+	if a.PublishAt != nil {
+		return a.PublishAt.Time
+	}
+	if a.CreatedAt != nil {
+		return a.CreatedAt.Time
+	}
+	return time.Time{}
+}
+
+// exportLink 静态站点中的链接
+type exportLink struct {
+	Title string
+	URL   string
+	Date  string
+	Count int
+}
+
+// html 使用 resource/template/export 下的模板生成静态站点：
+// index.html、posts/<slug>.html、categories/<slug>.html、tags/<slug>.html 与 assets/
+func (r *exportRun) html(articles []*entity.BlogArticles) error {
+	view := gview.New()
+	view.SetAutoEncode(true)
+	render := func(name, tpl string, params g.Map) error {
+		params["site"] = r.site
+		params["generatedAt"] = time.Now().Format("2006-01-02 15:04")
+		content, err := view.Parse(r.ctx, exportTemplateDir+"/"+tpl, params)
+		if err != nil {
+			return gerror.Wrapf(err, "渲染模板 %s 失败", tpl)
+		}
+		if err = r.write(name, []byte(content)); err != nil {
+			return gerror.Wrap(err, "写入导出文件失败")
+		}
+		return nil
+	}
+
+	link := func(a *entity.BlogArticles, root string) exportLink {
+		return exportLink{Title: a.Title, URL: root + "posts/" + exportName(a) + ".html", Date: articleDate(a).Format("2006-01-02")}
+	}
+	byCategory := make(map[int64][]exportLink)
+	byTag := make(map[string][]exportLink)
+	tagNames := make(map[string]string)
+	index := make([]exportLink, 0, len(articles))
+
+	for i, a := range articles {
+		index = append(index, link(a, ""))
+		for _, c := range r.categoryPath(a.CategoryId) {
+			byCategory[c.Id] = append(byCategory[c.Id], link(a, "../"))
+		}
+		var tags []exportLink
+		for _, t := range r.tags[a.Id] {
+			byTag[t.Slug] = append(byTag[t.Slug], link(a, "../"))
+			tagNames[t.Slug] = t.Name
+			tags = append(tags, exportLink{Title: t.Name, URL: "../tags/" + t.Slug + ".html"})
+		}
+		var categories []exportLink
+		for _, c := range r.categoryPath(a.CategoryId) {
+			categories = append(categories, exportLink{Title: c.Name, URL: "../categories/" + c.Slug + ".html"})
+		}
+		params := g.Map{
+			"root":       "../",
+			"title":      a.Title,
+			"article":    a,
+			"date":       articleDate(a).Format("2006-01-02"),
+			"cover":      r.rewriteAssets(a.FeaturedImage, "../"),
+			"categories": categories,
+			"tags":       tags,
+		}
+		// 静态站点会被公开发布，受密码保护的文章只输出标题与摘要，正文及其引用的图片都不导出
+		if IsArticleProtected(a) {
+			params["protected"] = true
+		} else {
+			params["content"] = htmltpl.HTML(r.rewriteAssets(a.HtmlContent, "../"))
+		}
+		// 列表按发布时间倒序，较新的一篇为“下一篇”
+		if i > 0 {
+			params["next"] = link(articles[i-1], "../")
+		}
+		if i+1 < len(articles) {
+			params["prev"] = link(articles[i+1], "../")
+		}
+		if err := render("posts/"+exportName(a)+".html", "article.html", params); err != nil {
+			return err
+		}
+	}
+
+	var categoryLinks, tagLinks []exportLink
+	for id, links := range byCategory {
+		c := r.categories[id]
+		categoryLinks = append(categoryLinks, exportLink{Title: c.Name, URL: "categories/" + c.Slug + ".html", Count: len(links)})
+		if err := render("categories/"+c.Slug+".html", "list.html", g.Map{
+			"root": "../", "title": c.Name, "description": c.Description, "articles": links,
+		}); err != nil {
+			return err
+		}
+	}
+	for slug, links := range byTag {
+		tagLinks = append(tagLinks, exportLink{Title: tagNames[slug], URL: "tags/" + slug + ".html", Count: len(links)})
+		if err := render("tags/"+slug+".html", "list.html", g.Map{
+			"root": "../", "title": "#" + tagNames[slug], "articles": links,
+		}); err != nil {
+			return err
+		}
+	}
+	sort.Slice(categoryLinks, func(i, j int) bool { return categoryLinks[i].Title < categoryLinks[j].Title })
+	sort.Slice(tagLinks, func(i, j int) bool { return tagLinks[i].Title < tagLinks[j].Title })
+
+	return render("index.html", "index.html", g.Map{
+		"root":       "",
+		"title":      r.site.Title,
+		"articles":   index,
+		"categories": categoryLinks,
+		"tags":       tagLinks,
+	})
+}
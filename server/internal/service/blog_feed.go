@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+	"server/internal/service/configcache"
+	"server/internal/service/feed"
+)
+
+// FeedInput 订阅源查询参数
+type FeedInput struct {
+	Format   string // rss/atom/json
+	Category string // 分类 slug，可选
+	Tag      string // 标签 slug，可选
+	Lang     string // 语言，可选：只包含有该语言版本的文章，有翻译时输出译文
+}
+
+// IBlogFeed 博客订阅源服务接口
+type IBlogFeed interface {
+	// Render 生成指定格式的订阅源
+	Render(ctx context.Context, in *FeedInput) (*RawDocument, error)
+}
+
+type sBlogFeed struct{}
+
+// BlogFeed 博客订阅源服务实例
+func BlogFeed() IBlogFeed {
+	return &sBlogFeed{}
+}
+
+// Render 生成订阅源
+func (s *sBlogFeed) Render(ctx context.Context, in *FeedInput) (*RawDocument, error) {
+	format := in.Format
+	if _, ok := feed.ContentTypes[format]; !ok {
+		return nil, gerror.New("不支持的订阅格式")
+	}
+	site := LoadSiteInfo(ctx)
+
+	f := &feed.Feed{
+		Title:       site.Title,
+		Description: site.Description,
+		Link:        site.URL + "/blog",
+		Language:    site.Language,
+		Author:      site.Author,
+	}
+	query := url.Values{}
+
+	m := publishedArticles(ctx)
+	if in.Category != "" {
+		var category *entity.BlogCategories
+		if err := dao.BlogCategories.Ctx(ctx).Where("slug", in.Category).Where("is_active", true).Scan(&category); err != nil {
+			return nil, gerror.Wrap(err, "查询分类失败")
+		}
+		if category == nil {
+			return nil, gerror.New("分类不存在")
+		}
+		m = ApplyArticleFilters(m, category.Id, true, "")
+		f.Title = site.Title + " - " + category.Name
+		if category.Description != "" {
+			f.Description = category.Description
+		}
+		f.Link = site.CategoryURL(category.Slug)
+		query.Set("category", category.Slug)
+	}
+	if in.Tag != "" {
+		var tag *entity.BlogTags
+		if err := dao.BlogTags.Ctx(ctx).Where("slug", in.Tag).Scan(&tag); err != nil {
+			return nil, gerror.Wrap(err, "查询标签失败")
+		}
+		if tag == nil {
+			return nil, gerror.New("标签不存在")
+		}
+		m = ApplyArticleFilters(m, 0, false, tag.Slug)
+		f.Title = f.Title + " - #" + tag.Name
+		f.Link = site.TagURL(tag.Slug)
+		query.Set("tag", tag.Slug)
+	}
+	lang := ""
+	if in.Lang != "" {
+		if lang = NormalizeLanguage(in.Lang); lang == "" {
+			return nil, gerror.NewCode(gcode.CodeInvalidParameter, "语言标签无效")
+		}
+		m = BlogTranslation().WhereLanguage(ctx, m, lang)
+		f.Language = lang
+		query.Set("lang", lang)
+	}
+	f.FeedURL = site.APIURL + "/blog/feed/" + format
+	if len(query) > 0 {
+		f.FeedURL += "?" + query.Encode()
+	}
+
+	limit := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "feed_item_count", 20)
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	var articles []*entity.BlogArticles
+	err := m.Fields("id, article_id, title, slug, summary, html_content, password_hash, category_id, language, featured_image, publish_at, updated_at").
+		Order("publish_at DESC, id DESC").
+		Limit(limit).
+		Scan(&articles)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询订阅文章失败")
+	}
+	if articles, err = BlogTranslation().Localize(ctx, articles, lang); err != nil {
+		return nil, err
+	}
+
+	tagNames, err := s.articleTagNames(ctx, articles)
+	if err != nil {
+		return nil, err
+	}
+	categoryNames, err := s.categoryNames(ctx, articles)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastModified time.Time
+	for _, a := range articles {
+		item := feed.Item{
+			ID:          "urn:uuid:" + a.ArticleId,
+			Title:       a.Title,
+			Link:        site.ArticleURL(a.Slug),
+			Summary:     a.Summary,
+			ContentHTML: a.HtmlContent,
+			Image:       site.AbsURL(a.FeaturedImage),
+		}
+		// 受密码保护的文章只输出摘要
+		if IsArticleProtected(a) {
+			item.ContentHTML = ""
+		}
+		if name := categoryNames[a.CategoryId]; name != "" {
+			item.Categories = append(item.Categories, name)
+		}
+		item.Categories = append(item.Categories, tagNames[a.Id]...)
+		if a.PublishAt != nil {
+			item.Published = a.PublishAt.Time
+		}
+		item.Updated = item.Published
+		if a.UpdatedAt != nil && a.UpdatedAt.Time.After(item.Updated) {
+			item.Updated = a.UpdatedAt.Time
+		}
+		if item.Updated.After(lastModified) {
+			lastModified = item.Updated
+		}
+		f.Items = append(f.Items, item)
+	}
+	f.Updated = lastModified
+	if f.Updated.IsZero() {
+		f.Updated = time.Now()
+	}
+
+	body, err := feed.Encode(f, format)
+	if err != nil {
+		return nil, gerror.Wrap(err, "生成订阅源失败")
+	}
+	return &RawDocument{
+		Body:         body,
+		ContentType:  feed.ContentTypes[format],
+		ETag:         BodyETag(body),
+		LastModified: lastModified,
+	}, nil
+}
+
+// articleTagNames 批量查询文章标签名
+func (s *sBlogFeed) articleTagNames(ctx context.Context, articles []*entity.BlogArticles) (map[int64][]string, error) {
+	out := make(map[int64][]string)
+	if len(articles) == 0 {
+		return out, nil
+	}
+	ids := make([]int64, 0, len(articles))
+	for _, a := range articles {
+		ids = append(ids, a.Id)
+	}
+	rows, err := g.DB().Ctx(ctx).GetAll(ctx, `SELECT bat.article_id, bt.name FROM blog_article_tags bat
+		INNER JOIN blog_tags bt ON bt.id = bat.tag_id
+		WHERE bat.article_id IN(?) ORDER BY bt.name`, ids)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询文章标签失败")
+	}
+	for _, r := range rows {
+		id := r["article_id"].Int64()
+		out[id] = append(out[id], r["name"].String())
+	}
+	return out, nil
+}
+
+// categoryNames 批量查询分类名
+func (s *sBlogFeed) categoryNames(ctx context.Context, articles []*entity.BlogArticles) (map[int64]string, error) {
+	out := make(map[int64]string)
+	ids := make([]int64, 0, len(articles))
+	for _, a := range articles {
+		if a.CategoryId > 0 {
+			ids = append(ids, a.CategoryId)
+		}
+	}
+	if len(ids) == 0 {
+		return out, nil
+	}
+	var categories []*entity.BlogCategories
+	if err := dao.BlogCategories.Ctx(ctx).WhereIn("id", ids).Scan(&categories); err != nil {
+		return nil, gerror.Wrap(err, "查询分类失败")
+	}
+	for _, c := range categories {
+		out[c.Id] = c.Name
+	}
+	return out, nil
+}
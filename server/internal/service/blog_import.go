@@ -0,0 +1,505 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	v1 "server/api/blog/v1"
+	"server/internal/dao"
+	"server/internal/service/auth"
+	"server/internal/service/configcache"
+	"server/internal/service/importer"
+	"server/internal/service/webmention"
+	"server/utility"
+)
+
+const (
+	// importDefaultCategory 来源文章没有分类时归入的分类
+	importDefaultCategory = "未分类"
+	// importImageMaxSize 单张远程图片的大小上限
+	importImageMaxSize = 20 << 20
+	importFetchTimeout = 30 * time.Second
+)
+
+// 导入条目的处理结果
+const (
+	ImportActionCreate = "create"
+	ImportActionSkip   = "skip"
+	ImportActionFail   = "fail"
+)
+
+// ImportInput 导入参数
+type ImportInput struct {
+	Data             []byte // zip 压缩包或 WXR XML 文件内容
+	DryRun           bool   // 仅生成报告，不写入数据库、不保存图片
+	DefaultCategory  string // 来源文章没有分类时使用，默认“未分类”
+	SkipRemoteImages bool   // 不抓取远程图片，保留原地址
+	AuthorId         int64  // 文章作者；为 0 时使用当前登录用户，命令行等无登录态的调用方需显式指定
+	UploaderIP       string
+	UserAgent        string
+}
+
+// ImportItem 单篇文章的导入结果
+type ImportItem struct {
+	Source        string
+	Title         string
+	Slug          string
+	Status        string
+	Category      string // 分类路径，以“ / ”分隔
+	Tags          []string
+	PublishAt     string
+	Images        int      // 已导入（或将导入）的图片数
+	MissingImages []string // 无法找到或抓取失败的图片
+	Action        string   // create/skip/fail
+	Reason        string
+	ArticleId     int64
+}
+
+// ImportReport 导入报告；DryRun 为 true 时各项表示“将会”发生的变更
+type ImportReport struct {
+	Format        string // markdown/wxr
+	DryRun        bool
+	Total         int
+	Created       int
+	Skipped       int
+	Failed        int
+	NewCategories []string
+	NewTags       []string
+	Warnings      []string
+	Items         []*ImportItem
+}
+
+// IBlogImport 博客内容导入服务接口
+type IBlogImport interface {
+	// Import 导入 Hexo/Hugo Markdown 压缩包或 WordPress WXR 导出文件。
+	// 已存在相同 slug 的文章会被跳过，因此可以重复执行
+	Import(ctx context.Context, in *ImportInput) (*ImportReport, error)
+}
+
+type sBlogImport struct{}
+
+// BlogImport 博客内容导入服务实例
+func BlogImport() IBlogImport {
+	return &sBlogImport{}
+}
+
+// importRun 单次导入的状态：分类、标签、图片与 slug 的去重缓存
+type importRun struct {
+	ctx        context.Context
+	in         *ImportInput
+	src        *importer.Source
+	report     *ImportReport
+	categories map[string]int64  // 小写分类路径 -> 分类ID（演练中新分类为负数）
+	tags       map[string]bool   // 已检查过的小写标签名
+	images     map[string]string // 图片来源（zip:路径 或 远程地址）-> 本站地址
+	failed     map[string]string // 抓取失败的图片 -> 原因
+	slugs      map[string]bool   // 本次导入已占用的 slug
+}
+
+// Import 导入博客内容
+func (s *sBlogImport) Import(ctx context.Context, in *ImportInput) (*ImportReport, error) {
+	if len(in.Data) == 0 {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "导入文件为空")
+	}
+	if in.AuthorId == 0 {
+		in.AuthorId = auth.CurrentUserID(ctx)
+	}
+	if !in.DryRun {
+		if in.AuthorId <= 0 {
+			return nil, gerror.NewCode(gcode.CodeInvalidParameter, "未指定文章作者")
+		}
+		if _, err := User().Get(ctx, in.AuthorId); err != nil {
+			return nil, err
+		}
+	}
+	src, err := importer.Parse(in.Data)
+	if err != nil {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, err.Error())
+	}
+	if strings.TrimSpace(in.DefaultCategory) == "" {
+		in.DefaultCategory = importDefaultCategory
+	}
+
+	run := &importRun{
+		ctx: ctx,
+		in:  in,
+		src: src,
+		report: &ImportReport{
+			Format:        src.Format,
+			DryRun:        in.DryRun,
+			Total:         len(src.Posts),
+			NewCategories: []string{},
+			NewTags:       []string{},
+			Warnings:      src.Warnings,
+			Items:         make([]*ImportItem, 0, len(src.Posts)),
+		},
+		categories: make(map[string]int64),
+		tags:       make(map[string]bool),
+		images:     make(map[string]string),
+		failed:     make(map[string]string),
+		slugs:      make(map[string]bool),
+	}
+	if run.report.Warnings == nil {
+		run.report.Warnings = []string{}
+	}
+	for _, post := range src.Posts {
+		item := run.importPost(post)
+		switch item.Action {
+		case ImportActionCreate:
+			run.report.Created++
+		case ImportActionSkip:
+			run.report.Skipped++
+		default:
+			run.report.Failed++
+		}
+		run.report.Items = append(run.report.Items, item)
+	}
+
+	g.Log().Infof(ctx, "博客导入完成: format=%s dryRun=%v total=%d created=%d skipped=%d failed=%d",
+		src.Format, in.DryRun, run.report.Total, run.report.Created, run.report.Skipped, run.report.Failed)
+	return run.report, nil
+}
+
+// importPost 导入单篇文章，错误记录在结果中而不中断整个导入
+func (r *importRun) importPost(post *importer.Post) *ImportItem {
+	item := &ImportItem{
+		Source:        post.Source,
+		Title:         post.Title,
+		Tags:          post.Tags,
+		MissingImages: []string{},
+		Action:        ImportActionCreate,
+	}
+	if item.Tags == nil {
+		item.Tags = []string{}
+	}
+	fail := func(action string, err error) *ImportItem {
+		item.Action, item.Reason = action, err.Error()
+		return item
+	}
+	if post.Title == "" {
+		return fail(ImportActionFail, gerror.New("文章标题为空"))
+	}
+	if strings.TrimSpace(post.Content) == "" {
+		return fail(ImportActionFail, gerror.New("文章内容为空"))
+	}
+
+	// slug：沿用来源中的 slug，已存在时跳过，便于重复导入
+	slug := Slugify(post.Slug, articleSlugMaxLen)
+	if slug == "" {
+		generated, err := GenerateArticleSlug(r.ctx, post.Title, 0)
+		if err != nil {
+			return fail(ImportActionFail, err)
+		}
+		// 自动生成的 slug 写入时才占用，演练中同名标题会得到相同的 slug，不做重复检查
+		slug = generated
+		item.Slug = slug
+	} else {
+		n, err := dao.BlogArticles.Ctx(r.ctx).Where("slug", slug).Count()
+		if err != nil {
+			return fail(ImportActionFail, gerror.Wrap(err, "检查URL标识失败"))
+		}
+		if n > 0 {
+			item.Slug = slug
+			return fail(ImportActionSkip, gerror.New("URL标识已存在"))
+		}
+		item.Slug = slug
+		if r.slugs[slug] {
+			return fail(ImportActionSkip, gerror.New("与本次导入中的其他文章URL标识重复"))
+		}
+		r.slugs[slug] = true
+	}
+
+	// 状态与发布时间
+	item.Status = ArticleStatusPublished
+	switch {
+	case post.Draft:
+		item.Status = ArticleStatusDraft
+	case post.Private:
+		item.Status = ArticleStatusPrivate
+	}
+	var publishAt *gtime.Time
+	if !post.Date.IsZero() && item.Status != ArticleStatusDraft {
+		publishAt = gtime.NewFromTime(post.Date)
+		item.PublishAt = publishAt.String()
+	}
+
+	// 分类
+	categoryPath, categorySlugs := post.Category, post.CategorySlugs
+	if len(categoryPath) == 0 {
+		categoryPath, categorySlugs = []string{r.in.DefaultCategory}, nil
+	}
+	item.Category = strings.Join(categoryPath, " / ")
+	categoryId, err := r.categoryId(categoryPath, categorySlugs)
+	if err != nil {
+		return fail(ImportActionFail, err)
+	}
+
+	// 标签
+	if err = r.checkTags(post.Tags); err != nil {
+		return fail(ImportActionFail, err)
+	}
+
+	// 图片
+	content, cover := r.importImages(post, item)
+
+	if r.in.DryRun {
+		return item
+	}
+
+	tags := make([]v1.TagInput, 0, len(post.Tags))
+	for _, name := range post.Tags {
+		tags = append(tags, v1.TagInput{Name: name})
+	}
+	article, err := BlogSimple().CreateArticle(r.ctx, map[string]interface{}{
+		"title":         post.Title,
+		"content":       content,
+		"slug":          slug,
+		"summary":       post.Summary,
+		"categoryId":    categoryId,
+		"status":        item.Status,
+		"publishAt":     publishAt,
+		"featuredImage": cover,
+		"tags":          tags,
+		"authorId":      r.in.AuthorId,
+	})
+	if err != nil {
+		return fail(ImportActionFail, err)
+	}
+	item.ArticleId = article.Id
+
+	// 保留来源中的创建与修改时间
+	if !post.Date.IsZero() {
+		updated := post.Updated
+		if updated.IsZero() || updated.Before(post.Date) {
+			updated = post.Date
+		}
+		_, err = dao.BlogArticles.Ctx(r.ctx).Where("id", article.Id).Data(g.Map{
+			"created_at": gtime.NewFromTime(post.Date),
+			"updated_at": gtime.NewFromTime(updated),
+		}).Update()
+		if err != nil {
+			g.Log().Warningf(r.ctx, "更新导入文章时间失败: id=%d err=%v", article.Id, err)
+		}
+	}
+	return item
+}
+
+// categoryId 按名称逐级查找分类路径，不存在的分类自动创建（演练时仅记录）
+func (r *importRun) categoryId(names, slugs []string) (int64, error) {
+	var parentId int64
+	for i, name := range names {
+		key := strings.ToLower(strings.Join(names[:i+1], "\x00"))
+		if id, ok := r.categories[key]; ok {
+			parentId = id
+			continue
+		}
+
+		var id int64
+		if parentId >= 0 {
+			v, err := dao.BlogCategories.Ctx(r.ctx).
+				Where("LOWER(name) = LOWER(?)", name).
+				Where("COALESCE(parent_id, 0) = ?", parentId).
+				Value("id")
+			if err != nil {
+				return 0, gerror.Wrap(err, "查询分类失败")
+			}
+			id = v.Int64()
+		}
+		if id == 0 {
+			r.report.NewCategories = append(r.report.NewCategories, strings.Join(names[:i+1], " / "))
+			if r.in.DryRun {
+				id = -int64(len(r.categories) + 1)
+			} else {
+				in := &CategoryInput{Name: name, ParentId: &parentId}
+				if i < len(slugs) && slugs[i] != "" && Slugify(slugs[i], categorySlugMaxLen) == slugs[i] {
+					n, err := dao.BlogCategories.Ctx(r.ctx).Where("slug", slugs[i]).Count()
+					if err != nil {
+						return 0, gerror.Wrap(err, "检查分类URL标识失败")
+					}
+					if n == 0 {
+						in.Slug = slugs[i]
+					}
+				}
+				category, err := BlogCategory().Create(r.ctx, in)
+				if err != nil {
+					return 0, err
+				}
+				id = category.Id
+			}
+		}
+		r.categories[key] = id
+		parentId = id
+	}
+	return parentId, nil
+}
+
+// checkTags 记录将要新建的标签，标签本身在创建文章时由 SetArticleTags 创建
+func (r *importRun) checkTags(names []string) error {
+	for _, name := range names {
+		key := strings.ToLower(name)
+		if r.tags[key] {
+			continue
+		}
+		r.tags[key] = true
+		n, err := dao.BlogTags.Ctx(r.ctx).Where("LOWER(name) = ?", key).Count()
+		if err != nil {
+			return gerror.Wrap(err, "查询标签失败")
+		}
+		if n == 0 {
+			r.report.NewTags = append(r.report.NewTags, name)
+		}
+	}
+	return nil
+}
+
+// importImages 将正文与封面引用的图片保存到文件服务并改写地址，返回改写后的正文与封面
+func (r *importRun) importImages(post *importer.Post, item *ImportItem) (string, string) {
+	refs := importer.ImageRefs(post.Content)
+	if post.Cover != "" {
+		refs = append(refs, post.Cover)
+	}
+	mapping := make(map[string]string, len(refs))
+	counted := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		if _, done := mapping[ref]; done || counted[ref] {
+			continue
+		}
+		var key string
+		if local, ok := r.src.ResolveLocal(post, ref); ok {
+			key = "zip:" + local
+		} else if importer.IsRemote(ref) {
+			if r.in.SkipRemoteImages {
+				continue
+			}
+			key = ref
+			if strings.HasPrefix(key, "//") {
+				key = "https:" + key
+			}
+		} else {
+			item.MissingImages = append(item.MissingImages, ref)
+			continue
+		}
+
+		counted[ref] = true
+		if r.in.DryRun {
+			item.Images++
+			continue
+		}
+		to, err := r.storeImage(key)
+		if err != nil {
+			item.MissingImages = append(item.MissingImages, ref+"（"+err.Error()+"）")
+			continue
+		}
+		mapping[ref] = to
+		item.Images++
+	}
+
+	cover := post.Cover
+	if to, ok := mapping[cover]; ok {
+		cover = to
+	}
+	return importer.RewriteImages(post.Content, mapping), cover
+}
+
+// storeImage 读取压缩包内或远程的图片并保存到文件服务，同一来源只保存一次
+func (r *importRun) storeImage(key string) (string, error) {
+	if to, ok := r.images[key]; ok {
+		return to, nil
+	}
+	if reason, ok := r.failed[key]; ok {
+		return "", gerror.New(reason)
+	}
+
+	var (
+		name, contentType string
+		content           []byte
+	)
+	if local, ok := strings.CutPrefix(key, "zip:"); ok {
+		data, found := r.src.ReadFile(local)
+		if !found {
+			r.failed[key] = "读取压缩包文件失败"
+			return "", gerror.New(r.failed[key])
+		}
+		name, content = path.Base(local), data
+	} else {
+		var err error
+		if name, content, contentType, err = r.fetchRemote(key); err != nil {
+			r.failed[key] = err.Error()
+			return "", err
+		}
+	}
+
+	ext := strings.TrimPrefix(path.Ext(name), ".")
+	mimeType := utility.GetMimeTypeFromExtension(ext)
+	if !utility.IsImageFile(mimeType) && !utility.IsImageFile(contentType) {
+		r.failed[key] = "不是图片文件"
+		return "", gerror.New(r.failed[key])
+	}
+	file, err := File().SaveContent(r.ctx, name, content, contentType,
+		utility.DetectFileCategory(mimeType, ext), r.in.AuthorId, r.in.UploaderIP, r.in.UserAgent, "blog")
+	if err != nil {
+		r.failed[key] = err.Error()
+		return "", err
+	}
+	to := fmt.Sprintf("/file/download/%s", file.FileUuid)
+	r.images[key] = to
+	return to, nil
+}
+
+// fetchRemote 下载远程图片，返回文件名、内容与 Content-Type
+func (r *importRun) fetchRemote(rawURL string) (string, []byte, string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", nil, "", gerror.New("无效的图片地址")
+	}
+	req, err := http.NewRequestWithContext(r.ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", nil, "", gerror.Wrap(err, "无效的图片地址")
+	}
+	// 与 Webmention 抓取共用拒绝内网地址的客户端，避免导入内容让服务器访问内部服务
+	allowPrivate := configcache.GetBool(r.ctx, blogConfigNamespace, blogConfigEnv, "import_allow_private", false)
+	resp, err := webmention.NewHTTPClient(importFetchTimeout, allowPrivate).Do(req)
+	if err != nil {
+		return "", nil, "", gerror.Wrap(err, "下载失败")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, "", gerror.Newf("下载失败: HTTP %d", resp.StatusCode)
+	}
+	if resp.ContentLength > importImageMaxSize {
+		return "", nil, "", gerror.New("图片过大")
+	}
+	// 没有 Content-Length 时边读边限制大小，多读一个字节用于判断是否超限
+	content, err := io.ReadAll(io.LimitReader(resp.Body, importImageMaxSize+1))
+	if err != nil {
+		return "", nil, "", gerror.Wrap(err, "下载失败")
+	}
+	if len(content) > importImageMaxSize {
+		return "", nil, "", gerror.New("图片过大")
+	}
+	contentType := strings.TrimSpace(strings.SplitN(resp.Header.Get("Content-Type"), ";", 2)[0])
+
+	name := path.Base(u.Path)
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		name = unescaped
+	}
+	if name == "" || name == "/" || name == "." {
+		name = "image"
+	}
+	// 地址没有扩展名时根据 Content-Type 补全，如 image/png -> .png
+	if path.Ext(name) == "" && strings.HasPrefix(contentType, "image/") {
+		name += "." + strings.SplitN(strings.TrimPrefix(contentType, "image/"), "+", 2)[0]
+	}
+	return name, content, contentType, nil
+}
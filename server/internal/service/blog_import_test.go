@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// 没有登录态且未指定作者时，正式导入在访问数据库之前即被拒绝
+func TestImportRequiresAuthor(t *testing.T) {
+	_, err := BlogImport().Import(context.Background(), &ImportInput{Data: []byte("x")})
+	if gerror.Code(err) != gcode.CodeInvalidParameter {
+		t.Fatalf("got %v", err)
+	}
+}
@@ -0,0 +1,752 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/encoding/gjson"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+	"server/internal/service/antispam"
+	"server/internal/service/auth"
+	"server/internal/service/configcache"
+	"server/internal/service/mailer"
+)
+
+// 订阅者状态
+const (
+	SubscriberStatusPending      = "pending"
+	SubscriberStatusActive       = "active"
+	SubscriberStatusUnsubscribed = "unsubscribed"
+	SubscriberStatusBounced      = "bounced"
+)
+
+// 订阅频率
+const (
+	SubscribeInstant = "instant"
+	SubscribeDaily   = "daily"
+	SubscribeWeekly  = "weekly"
+)
+
+// 订阅相关邮件类型
+const (
+	mailKindNewsletterConfirm = "newsletter_confirm"
+	mailKindNewsletterPost    = "newsletter_post"
+	mailKindNewsletterDigest  = "newsletter_digest"
+)
+
+const (
+	// confirmResendInterval 同一地址重复提交订阅时，确认邮件的最短发送间隔
+	confirmResendInterval = 10 * time.Minute
+	// digestMaxArticles 单封摘要邮件最多包含的文章数
+	digestMaxArticles = 30
+)
+
+// subscribeLimiter 按 IP 限制订阅请求
+var subscribeLimiter = antispam.NewLimiter()
+
+func init() {
+	registerMailRenderer(mailKindNewsletterConfirm, renderNewsletterConfirm)
+	registerMailRenderer(mailKindNewsletterPost, renderNewsletterPost)
+	registerMailRenderer(mailKindNewsletterDigest, renderNewsletterDigest)
+}
+
+// NewsletterPreferences 订阅者的订阅设置
+type NewsletterPreferences struct {
+	Subscriber  *entity.BlogSubscribers
+	CategoryIds []int64
+}
+
+// IBlogNewsletter 博客邮件订阅服务接口
+type IBlogNewsletter interface {
+	// Subscribe 提交订阅并发送确认邮件；地址已订阅时直接返回成功，不暴露订阅状态
+	Subscribe(ctx context.Context, email, name, frequency string, categoryIds []int64) error
+	// Confirm 通过确认邮件中的令牌激活订阅
+	Confirm(ctx context.Context, token string) (*entity.BlogSubscribers, error)
+	// Unsubscribe 通过退订令牌取消订阅
+	Unsubscribe(ctx context.Context, token string) error
+	// Preferences 通过退订令牌查询订阅设置
+	Preferences(ctx context.Context, token string) (*NewsletterPreferences, error)
+	// UpdatePreferences 通过退订令牌修改订阅设置，categoryIds 为空表示订阅全部分类
+	UpdatePreferences(ctx context.Context, token, name, frequency string, categoryIds []int64) (*NewsletterPreferences, error)
+	// List 管理端订阅者列表
+	List(ctx context.Context, status, keyword string, page, size int) ([]*entity.BlogSubscribers, int, error)
+	// Delete 管理端删除订阅者
+	Delete(ctx context.Context, id int64) error
+	// AnnounceDue 为最近发布且尚未通知的文章向即时订阅者写入通知邮件，返回写入的邮件数
+	AnnounceDue(ctx context.Context) (int, error)
+	// QueueDigests 为到期的每日、每周订阅者写入摘要邮件，返回写入的邮件数
+	QueueDigests(ctx context.Context) (int, error)
+}
+
+type sBlogNewsletter struct{}
+
+// BlogNewsletter 博客邮件订阅服务实例
+func BlogNewsletter() IBlogNewsletter {
+	return &sBlogNewsletter{}
+}
+
+func newsletterEnabled(ctx context.Context) bool {
+	return configcache.GetBool(ctx, blogConfigNamespace, blogConfigEnv, "newsletter_enabled", true)
+}
+
+func validFrequency(frequency string) bool {
+	switch frequency {
+	case SubscribeInstant, SubscribeDaily, SubscribeWeekly:
+		return true
+	}
+	return false
+}
+
+// newSubscriberToken 生成退订令牌（32 字节随机数的十六进制）
+func newSubscriberToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", gerror.Wrap(err, "生成退订令牌失败")
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// checkCategoryIds 去重并校验分类存在
+func checkCategoryIds(ctx context.Context, categoryIds []int64) ([]int64, error) {
+	seen := make(map[int64]bool, len(categoryIds))
+	ids := make([]int64, 0, len(categoryIds))
+	for _, id := range categoryIds {
+		if id > 0 && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	count, err := dao.BlogCategories.Ctx(ctx).WhereIn("id", ids).Count()
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询分类失败")
+	}
+	if count != len(ids) {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "分类不存在")
+	}
+	return ids, nil
+}
+
+// setSubscriberCategories 替换订阅者的分类
+func setSubscriberCategories(ctx context.Context, tx gdb.TX, subscriberId int64, categoryIds []int64) error {
+	if _, err := tx.Model(dao.BlogSubscriberCategories.Table()).Ctx(ctx).Where("subscriber_id", subscriberId).Delete(); err != nil {
+		return gerror.Wrap(err, "更新订阅分类失败")
+	}
+	if len(categoryIds) == 0 {
+		return nil
+	}
+	rows := make(g.List, 0, len(categoryIds))
+	for _, id := range categoryIds {
+		rows = append(rows, g.Map{"subscriber_id": subscriberId, "category_id": id})
+	}
+	if _, err := tx.Model(dao.BlogSubscriberCategories.Table()).Ctx(ctx).Data(rows).Insert(); err != nil {
+		return gerror.Wrap(err, "更新订阅分类失败")
+	}
+	return nil
+}
+
+// Subscribe 提交订阅
+func (s *sBlogNewsletter) Subscribe(ctx context.Context, email, name, frequency string, categoryIds []int64) error {
+	if !newsletterEnabled(ctx) {
+		return gerror.NewCode(gcode.CodeNotSupported, "邮件订阅未开启")
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	if !mailer.ValidAddress(email) {
+		return gerror.NewCode(gcode.CodeInvalidParameter, "邮箱地址无效")
+	}
+	if frequency == "" {
+		frequency = SubscribeInstant
+	}
+	if !validFrequency(frequency) {
+		return gerror.NewCode(gcode.CodeInvalidParameter, "订阅频率无效")
+	}
+	categoryIds, err := checkCategoryIds(ctx, categoryIds)
+	if err != nil {
+		return err
+	}
+
+	ip := RequestIP(ctx)
+	limit := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "newsletter_subscribe_per_hour", 5)
+	if !subscribeLimiter.Allow("ip:"+ip, limit, time.Hour) {
+		return gerror.NewCode(gcode.CodeOperationFailed, "订阅请求过于频繁，请稍后再试")
+	}
+
+	var existing *entity.BlogSubscribers
+	if err = dao.BlogSubscribers.Ctx(ctx).Where("lower(email) = ?", email).Scan(&existing); err != nil {
+		return gerror.Wrap(err, "查询订阅者失败")
+	}
+	if existing != nil {
+		if existing.Status == SubscriberStatusActive {
+			return nil
+		}
+		if existing.Status == SubscriberStatusPending && existing.ConfirmSentAt != nil &&
+			time.Since(existing.ConfirmSentAt.Time) < confirmResendInterval {
+			return nil
+		}
+	}
+
+	var subscriberId int64
+	err = dao.BlogSubscribers.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		data := g.Map{
+			"name":            strings.TrimSpace(name),
+			"status":          SubscriberStatusPending,
+			"frequency":       frequency,
+			"all_categories":  len(categoryIds) == 0,
+			"confirm_sent_at": gtime.Now(),
+			"ip":              ip,
+			"updated_at":      gtime.Now(),
+		}
+		if existing != nil {
+			subscriberId = existing.Id
+			if _, err := tx.Model(dao.BlogSubscribers.Table()).Ctx(ctx).Where("id", subscriberId).Data(data).Update(); err != nil {
+				return gerror.Wrap(err, "更新订阅者失败")
+			}
+		} else {
+			token, err := newSubscriberToken()
+			if err != nil {
+				return err
+			}
+			data["email"] = email
+			data["unsubscribe_token"] = token
+			if subscriberId, err = tx.Model(dao.BlogSubscribers.Table()).Ctx(ctx).Data(data).InsertAndGetId(); err != nil {
+				return gerror.Wrap(err, "创建订阅者失败")
+			}
+		}
+		return setSubscriberCategories(ctx, tx, subscriberId, categoryIds)
+	})
+	if err != nil {
+		return err
+	}
+	return MailOutbox().Enqueue(ctx, mailKindNewsletterConfirm, email, g.Map{"subscriberId": subscriberId})
+}
+
+// Confirm 确认订阅
+func (s *sBlogNewsletter) Confirm(ctx context.Context, token string) (*entity.BlogSubscribers, error) {
+	claims, err := auth.ValidateSubscriptionToken(ctx, token)
+	if err != nil {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "确认链接无效或已过期")
+	}
+	// 只激活待确认的订阅：退订后旧的确认链接不能重新订阅
+	_, err = dao.BlogSubscribers.Ctx(ctx).
+		Where("id", claims.SubscriberId).
+		Where("status", SubscriberStatusPending).
+		Data(g.Map{
+			"status":       SubscriberStatusActive,
+			"confirmed_at": gtime.Now(),
+			"bounce_count": 0,
+			"updated_at":   gtime.Now(),
+		}).Update()
+	if err != nil {
+		return nil, gerror.Wrap(err, "确认订阅失败")
+	}
+	var sub *entity.BlogSubscribers
+	if err = dao.BlogSubscribers.Ctx(ctx).Where("id", claims.SubscriberId).Scan(&sub); err != nil {
+		return nil, gerror.Wrap(err, "查询订阅者失败")
+	}
+	if sub == nil || sub.Status != SubscriberStatusActive {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "确认链接无效或已过期")
+	}
+	return sub, nil
+}
+
+// subscriberByToken 按退订令牌查询订阅者
+func subscriberByToken(ctx context.Context, token string) (*entity.BlogSubscribers, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "订阅不存在")
+	}
+	var sub *entity.BlogSubscribers
+	if err := dao.BlogSubscribers.Ctx(ctx).Where("unsubscribe_token", token).Scan(&sub); err != nil {
+		return nil, gerror.Wrap(err, "查询订阅者失败")
+	}
+	if sub == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "订阅不存在")
+	}
+	return sub, nil
+}
+
+// Unsubscribe 退订，重复退订视为成功
+func (s *sBlogNewsletter) Unsubscribe(ctx context.Context, token string) error {
+	sub, err := subscriberByToken(ctx, token)
+	if err != nil {
+		return err
+	}
+	if sub.Status == SubscriberStatusUnsubscribed {
+		return nil
+	}
+	_, err = dao.BlogSubscribers.Ctx(ctx).Where("id", sub.Id).Data(g.Map{
+		"status":          SubscriberStatusUnsubscribed,
+		"unsubscribed_at": gtime.Now(),
+		"updated_at":      gtime.Now(),
+	}).Update()
+	if err != nil {
+		return gerror.Wrap(err, "退订失败")
+	}
+	return nil
+}
+
+// Preferences 订阅设置
+func (s *sBlogNewsletter) Preferences(ctx context.Context, token string) (*NewsletterPreferences, error) {
+	sub, err := subscriberByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return s.preferences(ctx, sub)
+}
+
+func (s *sBlogNewsletter) preferences(ctx context.Context, sub *entity.BlogSubscribers) (*NewsletterPreferences, error) {
+	prefs := &NewsletterPreferences{Subscriber: sub, CategoryIds: []int64{}}
+	if sub.AllCategories {
+		return prefs, nil
+	}
+	values, err := dao.BlogSubscriberCategories.Ctx(ctx).Where("subscriber_id", sub.Id).OrderAsc("category_id").Array("category_id")
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询订阅分类失败")
+	}
+	for _, v := range values {
+		prefs.CategoryIds = append(prefs.CategoryIds, v.Int64())
+	}
+	return prefs, nil
+}
+
+// UpdatePreferences 修改订阅设置
+func (s *sBlogNewsletter) UpdatePreferences(ctx context.Context, token, name, frequency string, categoryIds []int64) (*NewsletterPreferences, error) {
+	sub, err := subscriberByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if frequency == "" {
+		frequency = sub.Frequency
+	}
+	if !validFrequency(frequency) {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "订阅频率无效")
+	}
+	if categoryIds, err = checkCategoryIds(ctx, categoryIds); err != nil {
+		return nil, err
+	}
+	err = dao.BlogSubscribers.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := tx.Model(dao.BlogSubscribers.Table()).Ctx(ctx).Where("id", sub.Id).Data(g.Map{
+			"name":           strings.TrimSpace(name),
+			"frequency":      frequency,
+			"all_categories": len(categoryIds) == 0,
+			"updated_at":     gtime.Now(),
+		}).Update()
+		if err != nil {
+			return gerror.Wrap(err, "更新订阅设置失败")
+		}
+		return setSubscriberCategories(ctx, tx, sub.Id, categoryIds)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sub, err = subscriberByToken(ctx, token); err != nil {
+		return nil, err
+	}
+	return s.preferences(ctx, sub)
+}
+
+// List 订阅者列表
+func (s *sBlogNewsletter) List(ctx context.Context, status, keyword string, page, size int) ([]*entity.BlogSubscribers, int, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+	m := dao.BlogSubscribers.Ctx(ctx)
+	if status != "" {
+		m = m.Where("status", status)
+	}
+	if keyword = strings.TrimSpace(keyword); keyword != "" {
+		like := "%" + keyword + "%"
+		m = m.Where("(email ILIKE ? OR name ILIKE ?)", like, like)
+	}
+	total, err := m.Count()
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询订阅者总数失败")
+	}
+	var items []*entity.BlogSubscribers
+	if err = m.Order("created_at DESC, id DESC").Limit((page-1)*size, size).Scan(&items); err != nil {
+		return nil, 0, gerror.Wrap(err, "查询订阅者失败")
+	}
+	return items, total, nil
+}
+
+// Delete 删除订阅者
+func (s *sBlogNewsletter) Delete(ctx context.Context, id int64) error {
+	result, err := dao.BlogSubscribers.Ctx(ctx).Where("id", id).Delete()
+	if err != nil {
+		return gerror.Wrap(err, "删除订阅者失败")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return gerror.NewCode(gcode.CodeNotFound, "订阅者不存在")
+	}
+	return nil
+}
+
+// AnnounceDue 通知新文章
+//
+// 由后台任务轮询而非在发布接口中触发，新建、编辑、定时发布、从回收站恢复等途径发布的文章都会被通知；
+// 只通知 newsletter_max_age_hours 内发布的文章，避免导入旧文章或开启订阅时群发历史文章。
+// 写入 blog_newsletter_posts 与写入发件箱在同一事务中，每篇文章只通知一次。
+func (s *sBlogNewsletter) AnnounceDue(ctx context.Context) (int, error) {
+	if !newsletterEnabled(ctx) {
+		return 0, nil
+	}
+	maxAge := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "newsletter_max_age_hours", 48)
+	values, err := publishedArticles(ctx).
+		Where("COALESCE(publish_at, created_at) > NOW() - make_interval(hours => ?)", maxAge).
+		Where("COALESCE(publish_at, created_at) <= NOW()").
+		Where("id NOT IN (SELECT article_id FROM blog_newsletter_posts)").
+		OrderAsc("id").
+		Array("id")
+	if err != nil {
+		return 0, gerror.Wrap(err, "查询待通知文章失败")
+	}
+
+	queued := 0
+	for _, v := range values {
+		n, err := s.announce(ctx, v.Int64())
+		if err != nil {
+			return queued, err
+		}
+		queued += n
+	}
+	return queued, nil
+}
+
+// announce 认领文章并为匹配的即时订阅者写入通知邮件；文章属于订阅分类或其子分类时匹配
+func (s *sBlogNewsletter) announce(ctx context.Context, articleId int64) (queued int, err error) {
+	err = dao.BlogNewsletterPosts.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		claimed, err := tx.GetAll(`INSERT INTO blog_newsletter_posts (article_id) VALUES (?)
+			ON CONFLICT (article_id) DO NOTHING RETURNING article_id`, articleId)
+		if err != nil {
+			return gerror.Wrap(err, "认领待通知文章失败")
+		}
+		if len(claimed) == 0 {
+			return nil
+		}
+		result, err := tx.Exec(`INSERT INTO mail_outbox (kind, recipient, payload)
+			SELECT ?, s.email, jsonb_build_object('subscriberId', s.id, 'articleId', a.id)
+			FROM blog_subscribers s, blog_articles a
+			WHERE a.id = ? AND s.status = ? AND s.frequency = ?
+			  AND (s.all_categories OR EXISTS (
+				WITH RECURSIVE ancestors AS (
+					SELECT id, parent_id FROM blog_categories WHERE id = a.category_id
+					UNION
+					SELECT c.id, c.parent_id FROM blog_categories c INNER JOIN ancestors an ON c.id = an.parent_id
+				)
+				SELECT 1 FROM blog_subscriber_categories sc
+				WHERE sc.subscriber_id = s.id AND sc.category_id IN (SELECT id FROM ancestors)
+			  ))`, mailKindNewsletterPost, articleId, SubscriberStatusActive, SubscribeInstant)
+		if err != nil {
+			return gerror.Wrap(err, "写入文章通知邮件失败")
+		}
+		n, _ := result.RowsAffected()
+		queued = int(n)
+		if _, err = tx.Exec(`UPDATE blog_newsletter_posts SET recipients = ? WHERE article_id = ?`, queued, articleId); err != nil {
+			return gerror.Wrap(err, "记录通知人数失败")
+		}
+		return nil
+	})
+	if err == nil && queued > 0 {
+		g.Log().Infof(ctx, "文章 %d 已通知 %d 位订阅者", articleId, queued)
+	}
+	return queued, err
+}
+
+// QueueDigests 写入摘要邮件
+//
+// 每日摘要在 newsletter_digest_hour 点后发送，每周摘要另外要求当天为 newsletter_digest_weekday（0 为周日）；
+// 时间按归档时区计算。订阅者先被认领（更新 last_digest_at），没有新文章时不写入邮件。
+func (s *sBlogNewsletter) QueueDigests(ctx context.Context) (int, error) {
+	if !newsletterEnabled(ctx) {
+		return 0, nil
+	}
+	loc, err := time.LoadLocation(archiveTimeZone)
+	if err != nil {
+		loc = time.Local
+	}
+	now := time.Now().In(loc)
+	if now.Hour() < configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "newsletter_digest_hour", 8) {
+		return 0, nil
+	}
+
+	queued, err := s.queueDigests(ctx, SubscribeDaily, 23)
+	if err != nil {
+		return queued, err
+	}
+	if int(now.Weekday()) == configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "newsletter_digest_weekday", 1) {
+		n, err := s.queueDigests(ctx, SubscribeWeekly, 7*24-1)
+		queued += n
+		if err != nil {
+			return queued, err
+		}
+	}
+	return queued, nil
+}
+
+// queueDigests 认领距上次摘要超过 gapHours 小时的订阅者，为有新文章的订阅者写入摘要邮件
+func (s *sBlogNewsletter) queueDigests(ctx context.Context, frequency string, gapHours int) (int, error) {
+	var due []struct {
+		Id            int64
+		Email         string
+		AllCategories bool
+		Since         *gtime.Time
+	}
+	err := g.DB().Ctx(ctx).GetScan(ctx, &due, `UPDATE blog_subscribers s SET last_digest_at = NOW()
+		FROM (
+			SELECT id, COALESCE(last_digest_at, confirmed_at, created_at) AS since FROM blog_subscribers
+			WHERE status = ? AND frequency = ?
+			  AND (last_digest_at IS NULL OR last_digest_at < NOW() - make_interval(hours => ?))
+			FOR UPDATE SKIP LOCKED
+		) d
+		WHERE s.id = d.id
+		RETURNING s.id, s.email, s.all_categories, d.since`, SubscriberStatusActive, frequency, gapHours)
+	if err != nil {
+		return 0, gerror.Wrap(err, "认领摘要订阅者失败")
+	}
+
+	queued := 0
+	for _, sub := range due {
+		m := publishedArticles(ctx).
+			Where("COALESCE(publish_at, created_at) > ?", sub.Since).
+			Where("COALESCE(publish_at, created_at) <= NOW()")
+		if !sub.AllCategories {
+			m = m.Where(`category_id IN (
+				WITH RECURSIVE subtree AS (
+					SELECT category_id AS id FROM blog_subscriber_categories WHERE subscriber_id = ?
+					UNION
+					SELECT c.id FROM blog_categories c INNER JOIN subtree st ON c.parent_id = st.id
+				)
+				SELECT id FROM subtree)`, sub.Id)
+		}
+		values, err := m.Order("COALESCE(publish_at, created_at) DESC").Limit(digestMaxArticles).Array("id")
+		if err != nil {
+			return queued, gerror.Wrap(err, "查询摘要文章失败")
+		}
+		if len(values) == 0 {
+			continue
+		}
+		ids := make([]int64, 0, len(values))
+		for _, v := range values {
+			ids = append(ids, v.Int64())
+		}
+		payload := g.Map{"subscriberId": sub.Id, "articleIds": ids, "frequency": frequency}
+		if err = MailOutbox().Enqueue(ctx, mailKindNewsletterDigest, sub.Email, payload); err != nil {
+			return queued, err
+		}
+		queued++
+	}
+	return queued, nil
+}
+
+// trackSubscriberDelivery 记录订阅邮件送达，并清零连续退信次数
+func trackSubscriberDelivery(ctx context.Context, item *entity.MailOutbox) {
+	if !strings.HasPrefix(item.Kind, "newsletter_") {
+		return
+	}
+	_, err := g.DB().Ctx(ctx).Exec(ctx, `UPDATE blog_subscribers
+		SET last_sent_at = NOW(), send_count = send_count + 1, bounce_count = 0
+		WHERE lower(email) = lower(?)`, item.Recipient)
+	if err != nil {
+		g.Log().Warningf(ctx, "记录订阅邮件送达失败: %v", err)
+	}
+}
+
+// trackSubscriberBounce 记录退信；连续退信达到 newsletter_bounce_limit 次的订阅者停止发送
+func trackSubscriberBounce(ctx context.Context, item *entity.MailOutbox) {
+	if !strings.HasPrefix(item.Kind, "newsletter_") {
+		return
+	}
+	limit := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "newsletter_bounce_limit", 3)
+	_, err := g.DB().Ctx(ctx).Exec(ctx, `UPDATE blog_subscribers
+		SET bounce_count = bounce_count + 1, updated_at = NOW(),
+		    status = CASE WHEN status = ? AND bounce_count + 1 >= ? THEN ? ELSE status END
+		WHERE lower(email) = lower(?)`, SubscriberStatusActive, limit, SubscriberStatusBounced, item.Recipient)
+	if err != nil {
+		g.Log().Warningf(ctx, "记录订阅邮件退信失败: %v", err)
+	}
+}
+
+// StartNewsletterWorker 启动订阅通知任务：每分钟检查新发布的文章与到期的摘要
+func StartNewsletterWorker(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				g.Log().Info(ctx, "订阅通知任务已停止")
+				return
+			case <-ticker.C:
+				if _, err := BlogNewsletter().AnnounceDue(ctx); err != nil {
+					g.Log().Errorf(ctx, "写入文章通知邮件失败: %v", err)
+				}
+				if _, err := BlogNewsletter().QueueDigests(ctx); err != nil {
+					g.Log().Errorf(ctx, "写入摘要邮件失败: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// ---------- 邮件渲染 ----------
+
+// newsletterArticle 邮件模板中的文章
+type newsletterArticle struct {
+	Title       string
+	Summary     string
+	URL         string
+	Image       string
+	PublishedAt string
+}
+
+// loadSubscriber 渲染时重新读取订阅者，状态不符合时跳过发送
+func loadSubscriber(ctx context.Context, payload *gjson.Json, status string) (*entity.BlogSubscribers, error) {
+	var sub *entity.BlogSubscribers
+	err := dao.BlogSubscribers.Ctx(ctx).Where("id", payload.Get("subscriberId").Int64()).Scan(&sub)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询订阅者失败")
+	}
+	if sub == nil || sub.Status != status {
+		return nil, errMailSkipped
+	}
+	return sub, nil
+}
+
+// loadNewsletterArticles 按 ids 顺序读取仍公开可见的文章
+func loadNewsletterArticles(ctx context.Context, site *SiteInfo, ids []int64) ([]newsletterArticle, error) {
+	var articles []*entity.BlogArticles
+	if err := publishedArticles(ctx).WhereIn("id", ids).Scan(&articles); err != nil {
+		return nil, gerror.Wrap(err, "查询文章失败")
+	}
+	byId := make(map[int64]*entity.BlogArticles, len(articles))
+	for _, a := range articles {
+		byId[a.Id] = a
+	}
+	items := make([]newsletterArticle, 0, len(articles))
+	for _, id := range ids {
+		a, ok := byId[id]
+		if !ok {
+			continue
+		}
+		published := a.PublishAt
+		if published == nil {
+			published = a.CreatedAt
+		}
+		item := newsletterArticle{
+			Title:   a.Title,
+			Summary: a.Summary,
+			URL:     site.ArticleURL(a.Slug),
+		}
+		if a.FeaturedImage != "" {
+			item.Image = site.AbsURL(a.FeaturedImage)
+		}
+		if published != nil {
+			item.PublishedAt = published.Layout("2006-01-02")
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// subscriberLinks 向模板参数写入退订与管理订阅链接，返回 List-Unsubscribe 邮件头（RFC 8058 一键退订）
+func subscriberLinks(site *SiteInfo, sub *entity.BlogSubscribers, params g.Map) map[string]string {
+	token := url.QueryEscape(sub.UnsubscribeToken)
+	params["unsubscribeURL"] = site.URL + "/newsletter/unsubscribe?token=" + token
+	params["preferencesURL"] = site.URL + "/newsletter/preferences?token=" + token
+	return map[string]string{
+		"List-Unsubscribe":      "<" + site.APIURL + "/blog/newsletter/unsubscribe?token=" + token + ">",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+}
+
+func renderNewsletterConfirm(ctx context.Context, item *entity.MailOutbox, payload *gjson.Json) (*mailer.Message, error) {
+	sub, err := loadSubscriber(ctx, payload, SubscriberStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	ttlHours := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "newsletter_confirm_ttl_hours", 48)
+	token, _, err := auth.GenerateSubscriptionToken(ctx, sub.Id, int64(ttlHours)*3600)
+	if err != nil {
+		return nil, gerror.Wrap(err, "生成确认令牌失败")
+	}
+	site := LoadSiteInfo(ctx)
+	return renderMail(ctx, item.Recipient, "confirm", g.Map{
+		"title":      "请确认订阅 " + site.Title,
+		"name":       sub.Name,
+		"confirmURL": site.URL + "/newsletter/confirm?token=" + url.QueryEscape(token),
+		"ttlHours":   ttlHours,
+	})
+}
+
+func renderNewsletterPost(ctx context.Context, item *entity.MailOutbox, payload *gjson.Json) (*mailer.Message, error) {
+	sub, err := loadSubscriber(ctx, payload, SubscriberStatusActive)
+	if err != nil {
+		return nil, err
+	}
+	site := LoadSiteInfo(ctx)
+	articles, err := loadNewsletterArticles(ctx, site, []int64{payload.Get("articleId").Int64()})
+	if err != nil {
+		return nil, err
+	}
+	if len(articles) == 0 {
+		return nil, errMailSkipped
+	}
+	params := g.Map{"title": articles[0].Title, "name": sub.Name, "article": articles[0]}
+	headers := subscriberLinks(site, sub, params)
+	msg, err := renderMail(ctx, item.Recipient, "post", params)
+	if err != nil {
+		return nil, err
+	}
+	msg.Headers = headers
+	return msg, nil
+}
+
+func renderNewsletterDigest(ctx context.Context, item *entity.MailOutbox, payload *gjson.Json) (*mailer.Message, error) {
+	sub, err := loadSubscriber(ctx, payload, SubscriberStatusActive)
+	if err != nil {
+		return nil, err
+	}
+	site := LoadSiteInfo(ctx)
+	articles, err := loadNewsletterArticles(ctx, site, payload.Get("articleIds").Int64s())
+	if err != nil {
+		return nil, err
+	}
+	if len(articles) == 0 {
+		return nil, errMailSkipped
+	}
+	weekly := payload.Get("frequency").String() == SubscribeWeekly
+	title := site.Title + " 每日文章摘要"
+	if weekly {
+		title = site.Title + " 每周文章摘要"
+	}
+	params := g.Map{
+		"title":    title,
+		"name":     sub.Name,
+		"articles": articles,
+		"weekly":   weekly,
+	}
+	headers := subscriberLinks(site, sub, params)
+	msg, err := renderMail(ctx, item.Recipient, "digest", params)
+	if err != nil {
+		return nil, err
+	}
+	msg.Headers = headers
+	return msg, nil
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"server/internal/dao"
+	"server/internal/model/do"
+	"server/internal/model/entity"
+	"server/internal/service/auth"
+	"server/internal/service/configcache"
+)
+
+const (
+	// 预览令牌默认有效期（小时），可通过配置 preview_token_ttl_hours 调整
+	defaultPreviewTTLHours = 72
+	// 预览令牌最长有效期：30 天
+	maxPreviewTTLSeconds = 30 * 24 * 3600
+)
+
+// IBlogPreview 草稿预览链接服务接口
+type IBlogPreview interface {
+	// Create 为文章签发预览令牌，ttlSeconds <= 0 时使用配置的默认有效期；令牌明文只在此处返回一次
+	Create(ctx context.Context, articleId int64, ttlSeconds int64, note string) (*entity.BlogPreviewTokens, string, error)
+	// List 文章的全部预览令牌，按创建时间倒序
+	List(ctx context.Context, articleId int64) ([]*entity.BlogPreviewTokens, error)
+	// Revoke 撤销预览令牌，已撤销的令牌保持原撤销时间
+	Revoke(ctx context.Context, id int64) error
+	// Verify 校验令牌是否可用于预览指定文章，并记录最近使用时间
+	Verify(ctx context.Context, token string, articleId int64) error
+}
+
+type sBlogPreview struct{}
+
+// BlogPreview 草稿预览链接服务实例
+func BlogPreview() IBlogPreview {
+	return &sBlogPreview{}
+}
+
+// Create 签发预览令牌
+func (s *sBlogPreview) Create(ctx context.Context, articleId int64, ttlSeconds int64, note string) (*entity.BlogPreviewTokens, string, error) {
+	count, err := dao.BlogArticles.Ctx(ctx).Where("id", articleId).Where("deleted_at IS NULL").Count()
+	if err != nil {
+		return nil, "", gerror.Wrap(err, "查询文章失败")
+	}
+	if count == 0 {
+		return nil, "", gerror.NewCode(gcode.CodeNotFound, "文章不存在")
+	}
+	if err = checkArticleOwnership(ctx, articleId); err != nil {
+		return nil, "", err
+	}
+
+	if ttlSeconds <= 0 {
+		ttlSeconds = int64(configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "preview_token_ttl_hours", defaultPreviewTTLHours)) * 3600
+	}
+	if ttlSeconds <= 0 || ttlSeconds > maxPreviewTTLSeconds {
+		ttlSeconds = maxPreviewTTLSeconds
+	}
+
+	token, exp, err := auth.GeneratePreviewToken(ctx, articleId, ttlSeconds)
+	if err != nil {
+		return nil, "", gerror.Wrap(err, "签发预览令牌失败")
+	}
+	expiresAt := gtime.NewFromTimeStamp(exp)
+	id, err := dao.BlogPreviewTokens.Ctx(ctx).Data(do.BlogPreviewTokens{
+		ArticleId: articleId,
+		TokenHash: previewTokenHash(token),
+		Note:      strings.TrimSpace(note),
+		ExpiresAt: expiresAt,
+	}).InsertAndGetId()
+	if err != nil {
+		return nil, "", gerror.Wrap(err, "保存预览令牌失败")
+	}
+
+	var created *entity.BlogPreviewTokens
+	if err = dao.BlogPreviewTokens.Ctx(ctx).Where("id", id).Scan(&created); err != nil {
+		return nil, "", gerror.Wrap(err, "查询预览令牌失败")
+	}
+	return created, token, nil
+}
+
+// List 预览令牌列表
+func (s *sBlogPreview) List(ctx context.Context, articleId int64) ([]*entity.BlogPreviewTokens, error) {
+	if err := checkArticleOwnership(ctx, articleId); err != nil {
+		return nil, err
+	}
+	var tokens []*entity.BlogPreviewTokens
+	err := dao.BlogPreviewTokens.Ctx(ctx).
+		Where("article_id", articleId).
+		Order("created_at DESC, id DESC").
+		Scan(&tokens)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询预览令牌失败")
+	}
+	return tokens, nil
+}
+
+// Revoke 撤销预览令牌
+func (s *sBlogPreview) Revoke(ctx context.Context, id int64) error {
+	articleId, err := dao.BlogPreviewTokens.Ctx(ctx).Fields("article_id").Where("id", id).Value()
+	if err != nil {
+		return gerror.Wrap(err, "查询预览令牌失败")
+	}
+	if articleId.IsNil() {
+		return gerror.NewCode(gcode.CodeNotFound, "预览令牌不存在")
+	}
+	if err = checkArticleOwnership(ctx, articleId.Int64()); err != nil {
+		return err
+	}
+	_, err = dao.BlogPreviewTokens.Ctx(ctx).
+		Where("id", id).
+		Where("revoked_at IS NULL").
+		Data(g.Map{"revoked_at": gtime.Now()}).
+		Update()
+	if err != nil {
+		return gerror.Wrap(err, "撤销预览令牌失败")
+	}
+	return nil
+}
+
+// Verify 校验预览令牌：签名与有效期、所属文章、是否已撤销
+func (s *sBlogPreview) Verify(ctx context.Context, token string, articleId int64) error {
+	// 对外统一返回“不存在”，不暴露令牌失效的具体原因
+	denied := gerror.NewCode(gcode.CodeNotFound, "文章不存在")
+
+	claims, err := auth.ValidatePreviewToken(ctx, token)
+	if err != nil || claims.ArticleId != articleId {
+		return denied
+	}
+	// 条件更新同时完成撤销/过期检查与使用记录
+	result, err := dao.BlogPreviewTokens.Ctx(ctx).
+		Where("token_hash", previewTokenHash(token)).
+		Where("article_id", articleId).
+		Where("revoked_at IS NULL").
+		Where("expires_at > NOW()").
+		Data(g.Map{
+			"last_used_at": gtime.Now(),
+			"use_count":    gdb.Raw("use_count + 1"),
+		}).
+		Update()
+	if err != nil {
+		return gerror.Wrap(err, "校验预览令牌失败")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return denied
+	}
+	return nil
+}
+
+// previewTokenHash 数据库只保存令牌的 SHA-256 摘要
+func previewTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
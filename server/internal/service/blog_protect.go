@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+	"server/internal/service/antispam"
+	"server/internal/service/auth"
+	"server/internal/service/configcache"
+)
+
+const (
+	// 密码摘要格式：pbkdf2-sha256$迭代次数$盐$摘要（盐与摘要为 base64 无填充编码）
+	passwordHashScheme     = "pbkdf2-sha256"
+	passwordHashIterations = 120000
+	passwordHashKeyLen     = 32
+	// 解锁 Cookie 名前缀，完整名称为 blog_unlock_<文章ID>
+	unlockCookiePrefix = "blog_unlock_"
+)
+
+// unlockLimiter 按 IP 与文章、以及按文章整体限制密码尝试次数
+var unlockLimiter = antispam.NewLimiter()
+
+// IBlogProtect 文章访问控制（私密与密码保护）服务接口
+type IBlogProtect interface {
+	// SetPassword 设置文章访问密码，password 为空时取消密码保护
+	SetPassword(ctx context.Context, articleId int64, password string) error
+	// Unlock 校验访问密码，成功时签发短期解锁令牌并写入 Cookie
+	Unlock(ctx context.Context, articleId int64, password string) (token string, expiresAt int64, err error)
+	// Unlocked 当前请求是否可以阅读受密码保护文章的正文：
+	// 已登录、未设置密码，或持有有效的解锁令牌（参数 token 或 Cookie）
+	Unlocked(ctx context.Context, article *entity.BlogArticles, token string) bool
+}
+
+type sBlogProtect struct{}
+
+// BlogProtect 文章访问控制服务实例
+func BlogProtect() IBlogProtect {
+	return &sBlogProtect{}
+}
+
+// IsArticlePrivate 文章是否仅登录用户可见（私密标记或 private 状态）
+func IsArticlePrivate(article *entity.BlogArticles) bool {
+	return article.IsPrivate || article.Status == ArticleStatusPrivate
+}
+
+// IsArticleProtected 文章是否设置了访问密码
+func IsArticleProtected(article *entity.BlogArticles) bool {
+	return article.PasswordHash != ""
+}
+
+// SetPassword 设置或取消访问密码
+func (s *sBlogProtect) SetPassword(ctx context.Context, articleId int64, password string) error {
+	count, err := dao.BlogArticles.Ctx(ctx).Where("id", articleId).WhereNull("deleted_at").Count()
+	if err != nil {
+		return gerror.Wrap(err, "查询文章失败")
+	}
+	if count == 0 {
+		return gerror.NewCode(gcode.CodeNotFound, "文章不存在")
+	}
+	if err = checkArticleOwnership(ctx, articleId); err != nil {
+		return err
+	}
+
+	hash := ""
+	if password != "" {
+		if hash, err = hashPassword(password); err != nil {
+			return gerror.Wrap(err, "生成密码摘要失败")
+		}
+	}
+	// 更新 password_hash 会触发搜索向量重建，受保护文章的正文随之移出索引
+	if _, err = dao.BlogArticles.Ctx(ctx).Where("id", articleId).Data(g.Map{"password_hash": hash}).Update(); err != nil {
+		return gerror.Wrap(err, "保存文章密码失败")
+	}
+	InvalidateContentCaches()
+	return nil
+}
+
+// Unlock 校验访问密码
+func (s *sBlogProtect) Unlock(ctx context.Context, articleId int64, password string) (string, int64, error) {
+	var article *entity.BlogArticles
+	err := dao.BlogArticles.Ctx(ctx).
+		Fields("id, status, is_private, password_hash").
+		Where("id", articleId).
+		WhereNull("deleted_at").
+		Scan(&article)
+	if err != nil {
+		return "", 0, gerror.Wrap(err, "查询文章失败")
+	}
+	if article == nil || article.Status != ArticleStatusPublished || IsArticlePrivate(article) {
+		return "", 0, gerror.NewCode(gcode.CodeNotFound, "文章不存在")
+	}
+	if !IsArticleProtected(article) {
+		return "", 0, gerror.NewCode(gcode.CodeInvalidOperation, "文章未设置访问密码")
+	}
+
+	// 先按 IP 限制，再按文章整体限制，使更换 IP 的分布式猜测同样受限
+	r := g.RequestFromCtx(ctx)
+	aid := strconv.FormatInt(articleId, 10)
+	attempts := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "article_unlock_attempts_per_hour", 10)
+	articleAttempts := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "article_unlock_attempts_per_article_per_hour", 100)
+	if !unlockLimiter.Allow(RequestIP(ctx)+":"+aid, attempts, time.Hour) ||
+		!unlockLimiter.Allow("article:"+aid, articleAttempts, time.Hour) {
+		return "", 0, gerror.NewCode(gcode.CodeInvalidRequest, "尝试次数过多，请稍后再试")
+	}
+	if !verifyPassword(article.PasswordHash, password) {
+		return "", 0, gerror.NewCode(gcode.CodeInvalidParameter, "密码错误")
+	}
+
+	ttl := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "article_unlock_ttl_minutes", 120)
+	if ttl <= 0 {
+		ttl = 120
+	}
+	token, exp, err := auth.GenerateUnlockToken(ctx, articleId, passwordFingerprint(article.PasswordHash), int64(ttl)*60)
+	if err != nil {
+		return "", 0, gerror.Wrap(err, "签发解锁令牌失败")
+	}
+	if r != nil {
+		http.SetCookie(r.Response.Writer, &http.Cookie{
+			Name:     unlockCookiePrefix + strconv.FormatInt(articleId, 10),
+			Value:    token,
+			Path:     "/",
+			MaxAge:   ttl * 60,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+	return token, exp, nil
+}
+
+// Unlocked 当前请求是否已解锁文章
+func (s *sBlogProtect) Unlocked(ctx context.Context, article *entity.BlogArticles, token string) bool {
+	if !IsArticleProtected(article) || auth.IsAuthenticated(ctx) {
+		return true
+	}
+	if token == "" {
+		if r := g.RequestFromCtx(ctx); r != nil {
+			token = r.Cookie.Get(unlockCookiePrefix + strconv.FormatInt(article.Id, 10)).String()
+		}
+	}
+	if token == "" {
+		return false
+	}
+	claims, err := auth.ValidateUnlockToken(ctx, token)
+	if err != nil {
+		return false
+	}
+	return claims.ArticleId == article.Id &&
+		subtle.ConstantTimeCompare([]byte(claims.Fingerprint), []byte(passwordFingerprint(article.PasswordHash))) == 1
+}
+
+// hashPassword 以随机盐计算 PBKDF2-SHA256 摘要
+func hashPassword(password string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := pbkdf2SHA256(password, salt, passwordHashIterations, passwordHashKeyLen)
+	enc := base64.RawStdEncoding
+	return fmt.Sprintf("%s$%d$%s$%s", passwordHashScheme, passwordHashIterations, enc.EncodeToString(salt), enc.EncodeToString(key)), nil
+}
+
+// verifyPassword 校验密码与摘要是否匹配
+func verifyPassword(hash, password string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != passwordHashScheme {
+		return false
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations <= 0 {
+		return false
+	}
+	enc := base64.RawStdEncoding
+	salt, err := enc.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	want, err := enc.DecodeString(parts[3])
+	if err != nil {
+		return false
+	}
+	got := pbkdf2SHA256(password, salt, iterations, len(want))
+	return subtle.ConstantTimeCompare(got, want) == 1
+}
+
+// pbkdf2SHA256 PBKDF2（RFC 8018）以 HMAC-SHA256 为伪随机函数派生密钥
+func pbkdf2SHA256(password string, salt []byte, iterations, keyLen int) []byte {
+	prf := hmac.New(sha256.New, []byte(password))
+	var key []byte
+	for block := uint32(1); len(key) < keyLen; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		prf.Write([]byte{byte(block >> 24), byte(block >> 16), byte(block >> 8), byte(block)})
+		u := prf.Sum(nil)
+		t := append([]byte(nil), u...)
+		for i := 1; i < iterations; i++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		key = append(key, t...)
+	}
+	return key[:keyLen]
+}
+
+// passwordFingerprint 密码摘要的短指纹，写入解锁令牌
+func passwordFingerprint(hash string) string {
+	sum := sha256.Sum256([]byte(hash))
+	return hex.EncodeToString(sum[:8])
+}
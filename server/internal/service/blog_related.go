@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+)
+
+// 相关文章评分权重：每个共同标签 3 分，同分类 2 分，文本相似度（ts_rank，0~1）乘以 10
+const (
+	relatedTagWeight      = 3.0
+	relatedCategoryWeight = 2.0
+	relatedTextWeight     = 10.0
+	// relatedQueryLexemes 取源文章标题与摘要中的前若干个词构造相似度查询
+	relatedQueryLexemes = 32
+)
+
+// RelatedArticle 相关文章及其得分
+type RelatedArticle struct {
+	Article *entity.BlogArticles
+	Score   float64
+}
+
+// IBlogRelated 相关文章服务接口
+type IBlogRelated interface {
+	// List 返回与指定文章最相关的已发布文章，按得分降序
+	List(ctx context.Context, articleId int64, limit int) ([]*RelatedArticle, error)
+}
+
+type sBlogRelated struct{}
+
+// BlogRelated 相关文章服务实例
+func BlogRelated() IBlogRelated {
+	return &sBlogRelated{}
+}
+
+// List 相关文章
+//
+// 得分由共同标签数、是否同分类以及文本相似度组成。文本相似度复用 search_vector：
+// 以源文章标题（A）与摘要（B）权重的词构造 OR 查询，用 ts_rank 衡量候选文章的匹配程度。
+// 公开文章的结果缓存到内容变更为止。
+func (s *sBlogRelated) List(ctx context.Context, articleId int64, limit int) ([]*RelatedArticle, error) {
+	if limit <= 0 || limit > 20 {
+		limit = 5
+	}
+	key := fmt.Sprintf("related:%d:%d", articleId, limit)
+	if v, ok := blogContentCache.get(key); ok {
+		return v.([]*RelatedArticle), nil
+	}
+
+	rows, err := g.DB().Ctx(ctx).GetAll(ctx, `WITH src AS (
+			SELECT id, category_id, search_vector FROM blog_articles WHERE id = ?
+		),
+		q AS (
+			SELECT to_tsquery('simple', string_agg(quote_literal(u.lexeme), ' | ')) AS query
+			FROM src, LATERAL (
+				SELECT lexeme FROM unnest(src.search_vector) u
+				WHERE u.weights && ARRAY['A', 'B']::"char"[]
+				LIMIT ?
+			) u
+		),
+		scored AS (
+			SELECT a.id,
+				(SELECT COUNT(1) FROM blog_article_tags t
+				 WHERE t.article_id = a.id
+				   AND t.tag_id IN (SELECT tag_id FROM blog_article_tags WHERE article_id = src.id)) AS shared_tags,
+				(a.category_id IS NOT DISTINCT FROM src.category_id AND a.category_id IS NOT NULL) AS same_category,
+				COALESCE(ts_rank(a.search_vector, q.query), 0) AS text_rank
+			FROM blog_articles a, src, q
+			WHERE a.id <> src.id
+			  AND a.status = 'published'
+			  AND COALESCE(a.is_private, false) = false
+			  AND a.deleted_at IS NULL
+		)
+		SELECT id, shared_tags * ? + (CASE WHEN same_category THEN ? ELSE 0 END) + text_rank * ? AS score
+		FROM scored
+		WHERE shared_tags > 0 OR same_category OR text_rank > 0
+		ORDER BY score DESC, id DESC
+		LIMIT ?`,
+		articleId, relatedQueryLexemes, relatedTagWeight, relatedCategoryWeight, relatedTextWeight, limit)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询相关文章失败")
+	}
+
+	related := make([]*RelatedArticle, 0, len(rows))
+	if len(rows) > 0 {
+		ids := make([]int64, 0, len(rows))
+		for _, r := range rows {
+			ids = append(ids, r["id"].Int64())
+		}
+		byId, err := articlesByIds(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rows {
+			if a := byId[r["id"].Int64()]; a != nil {
+				related = append(related, &RelatedArticle{Article: a, Score: r["score"].Float64()})
+			}
+		}
+	}
+	public, err := isPublicArticle(ctx, articleId)
+	if err != nil {
+		return nil, err
+	}
+	if public {
+		blogContentCache.set(ctx, key, related)
+	}
+	return related, nil
+}
+
+// articlesByIds 按ID批量查询文章列表字段（不含正文）
+func articlesByIds(ctx context.Context, ids []int64) (map[int64]*entity.BlogArticles, error) {
+	var articles []*entity.BlogArticles
+	err := dao.BlogArticles.Ctx(ctx).
+		FieldsEx(dao.BlogArticles.Columns().SearchVector, dao.BlogArticles.Columns().Content, dao.BlogArticles.Columns().HtmlContent).
+		WhereIn("id", ids).
+		Scan(&articles)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询文章失败")
+	}
+	byId := make(map[int64]*entity.BlogArticles, len(articles))
+	for _, a := range articles {
+		byId[a.Id] = a
+	}
+	return byId, nil
+}
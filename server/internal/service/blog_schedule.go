@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+	"server/internal/service/configcache"
+)
+
+// IBlogSchedule 定时发布服务接口
+type IBlogSchedule interface {
+	// PublishDue 发布所有已到发布时间的定时文章，返回本次发布的文章ID
+	PublishDue(ctx context.Context) (ids []int64, err error)
+	// ListScheduled 按发布时间升序分页查询待发布文章
+	ListScheduled(ctx context.Context, page, size int) (articles []*entity.BlogArticles, total int, err error)
+}
+
+type sBlogSchedule struct{}
+
+// BlogSchedule 定时发布服务实例
+func BlogSchedule() IBlogSchedule {
+	return &sBlogSchedule{}
+}
+
+// publishDueBatchSize 单次发布的最大文章数，剩余的在下一轮处理
+const publishDueBatchSize = 100
+
+// PublishDue 发布到期文章
+//
+// 通过 FOR UPDATE SKIP LOCKED 认领到期行并在同一语句内更新状态，
+// 多实例同时运行时每篇文章只会被一个实例发布。
+func (s *sBlogSchedule) PublishDue(ctx context.Context) (ids []int64, err error) {
+	rows, err := g.DB().Ctx(ctx).GetAll(ctx, `UPDATE blog_articles SET status = ?, is_draft = false, updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM blog_articles
+			WHERE status = ? AND publish_at <= NOW() AND deleted_at IS NULL
+			ORDER BY publish_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id`, ArticleStatusPublished, ArticleStatusScheduled, publishDueBatchSize)
+	if err != nil {
+		return nil, gerror.Wrap(err, "发布定时文章失败")
+	}
+	for _, r := range rows {
+		ids = append(ids, r["id"].Int64())
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	for _, id := range ids {
+		if _, err := BlogVersion().Record(ctx, id, VersionChangeUpdate, "定时发布"); err != nil {
+			g.Log().Warningf(ctx, "记录定时发布版本失败: id=%d err=%v", id, err)
+		}
+	}
+	InvalidateContentCaches()
+	g.Log().Infof(ctx, "定时发布文章 %d 篇: %v", len(ids), ids)
+	return ids, nil
+}
+
+// ListScheduled 待发布文章列表
+func (s *sBlogSchedule) ListScheduled(ctx context.Context, page, size int) (articles []*entity.BlogArticles, total int, err error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 10
+	}
+	m := dao.BlogArticles.Ctx(ctx).
+		Where("status", ArticleStatusScheduled).
+		WhereNull("deleted_at")
+	total, err = m.Count()
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询定时文章总数失败")
+	}
+	err = m.FieldsEx(dao.BlogArticles.Columns().SearchVector, dao.BlogArticles.Columns().Content, dao.BlogArticles.Columns().HtmlContent).
+		Order("publish_at ASC, id ASC").
+		Limit((page-1)*size, size).
+		Scan(&articles)
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询定时文章失败")
+	}
+	return articles, total, nil
+}
+
+// StartScheduledPublisher 启动定时发布调度器，检查间隔由 blog/default scheduled_publish_interval_seconds 配置
+func StartScheduledPublisher(ctx context.Context) {
+	go func() {
+		for {
+			interval := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "scheduled_publish_interval_seconds", 60)
+			if interval < 5 {
+				interval = 5
+			}
+			select {
+			case <-ctx.Done():
+				g.Log().Info(ctx, "定时发布调度器已停止")
+				return
+			case <-time.After(time.Duration(interval) * time.Second):
+				if _, err := BlogSchedule().PublishDue(ctx); err != nil {
+					g.Log().Errorf(ctx, "定时发布失败: %v", err)
+				}
+			}
+		}
+	}()
+}
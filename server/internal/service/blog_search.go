@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// 高亮标记：ts_headline 使用控制字符作为起止标记，转义HTML后再替换为 <mark>
+const (
+	headlineStart = "\x02"
+	headlineStop  = "\x03"
+)
+
+// SearchInput 文章搜索参数
+type SearchInput struct {
+	Query      string
+	Page       int
+	Size       int
+	CategoryId int64
+	Tag        string
+	// IncludeDescendants 分类过滤是否包含子分类
+	IncludeDescendants bool
+}
+
+// SearchHit 搜索命中结果
+type SearchHit struct {
+	Id             int64       `orm:"id"`
+	Title          string      `orm:"title"`
+	Slug           string      `orm:"slug"`
+	Summary        string      `orm:"summary"`
+	CategoryId     int64       `orm:"category_id"`
+	FeaturedImage  string      `orm:"featured_image"`
+	PublishAt      *gtime.Time `orm:"publish_at"`
+	Rank           float64     `orm:"rank"`
+	TitleHighlight string      `orm:"title_highlight"`
+	Headline       string      `orm:"headline"`
+}
+
+// IBlogSearch 文章全文搜索服务接口
+type IBlogSearch interface {
+	// Search 按相关度排序搜索已发布文章，返回带高亮的命中列表与总数
+	Search(ctx context.Context, in *SearchInput) (hits []*SearchHit, total int, err error)
+}
+
+type sBlogSearch struct{}
+
+// BlogSearch 文章全文搜索服务实例
+func BlogSearch() IBlogSearch {
+	return &sBlogSearch{}
+}
+
+// Search 全文搜索
+//
+// 索引侧由数据库函数 blog_cjk_bigram 将中日韩文本切分为重叠二元组，
+// 查询侧使用 BuildSearchQuery 以相同规则生成 tsquery；高亮摘要则基于逐字切分的文本与短语查询生成。
+func (s *sBlogSearch) Search(ctx context.Context, in *SearchInput) (hits []*SearchHit, total int, err error) {
+	query := BuildSearchQuery(in.Query)
+	if query == "" {
+		return nil, 0, gerror.New("搜索关键词无效")
+	}
+	page, size := in.Page, in.Size
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 10
+	}
+	if size > 50 {
+		size = 50
+	}
+
+	m := publishedArticles(ctx).
+		Where("search_vector @@ to_tsquery('simple', ?)", query)
+	m = ApplyArticleFilters(m, in.CategoryId, in.IncludeDescendants, in.Tag)
+
+	total, err = m.Count()
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "统计搜索结果失败")
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	// 先按相关度分页取出ID，再只为当页结果生成高亮，避免对全部命中计算 ts_headline
+	rankExpr := "ts_rank(search_vector, to_tsquery('simple', ?))"
+	idRows, err := m.Fields("id").
+		Order(gdb.Raw(fmt.Sprintf("%s DESC, publish_at DESC", strings.Replace(rankExpr, "?", quoteLiteral(query), 1)))).
+		Page(page, size).
+		All()
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询搜索结果失败")
+	}
+	ids := make([]int64, 0, len(idRows))
+	for _, r := range idRows {
+		ids = append(ids, r["id"].Int64())
+	}
+	if len(ids) == 0 {
+		return nil, total, nil
+	}
+
+	phrase := buildHeadlineQuery(in.Query)
+	opts := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=2, MaxWords=30, MinWords=10, FragmentDelimiter=\" … \"", headlineStart, headlineStop)
+	titleOpts := fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true", headlineStart, headlineStop)
+	sql := `
+		SELECT id, title, slug, summary, category_id, featured_image, publish_at,
+			ts_rank(search_vector, to_tsquery('simple', ?)) AS rank,
+			ts_headline('simple', blog_cjk_split(title), to_tsquery('simple', ?), ?) AS title_highlight,
+			ts_headline('simple', blog_cjk_split(regexp_replace(CASE WHEN password_hash = '' THEN content ELSE coalesce(summary, '') END, '[#*` + "`" + `>_~|\[\]()]+', ' ', 'g')), to_tsquery('simple', ?), ?) AS headline
+		FROM blog_articles
+		WHERE id IN(?)
+		ORDER BY rank DESC, publish_at DESC`
+	if err = g.DB().Ctx(ctx).GetScan(ctx, &hits, sql, query, phrase, titleOpts, phrase, opts, ids); err != nil {
+		return nil, total, gerror.Wrap(err, "生成搜索高亮失败")
+	}
+	for _, h := range hits {
+		h.TitleHighlight = renderHeadline(h.TitleHighlight)
+		h.Headline = renderHeadline(h.Headline)
+	}
+	return hits, total, nil
+}
+
+// publishedArticles 公开可见文章的基础查询
+func publishedArticles(ctx context.Context) *gdb.Model {
+	return g.DB().Model("blog_articles").Ctx(ctx).
+		Where("status", "published").
+		Where("is_private", false).
+		WhereNull("deleted_at")
+}
+
+// ApplyArticleFilters 在文章查询上叠加分类与标签过滤（标签按 slug 或名称匹配），
+// withDescendants 为 true 时分类过滤包含其全部后代分类
+func ApplyArticleFilters(m *gdb.Model, categoryId int64, withDescendants bool, tag string) *gdb.Model {
+	if categoryId > 0 {
+		if withDescendants {
+			m = m.Where(`blog_articles.category_id IN (
+				WITH RECURSIVE subtree AS (
+					SELECT id FROM blog_categories WHERE id = ?
+					UNION
+					SELECT c.id FROM blog_categories c INNER JOIN subtree s ON c.parent_id = s.id
+				)
+				SELECT id FROM subtree)`, categoryId)
+		} else {
+			m = m.Where("blog_articles.category_id", categoryId)
+		}
+	}
+	if tag = strings.TrimSpace(tag); tag != "" {
+		m = m.Where(`blog_articles.id IN (
+			SELECT bat.article_id FROM blog_article_tags bat
+			INNER JOIN blog_tags bt ON bt.id = bat.tag_id
+			WHERE bt.slug = ? OR bt.name = ?)`, tag, tag)
+	}
+	return m
+}
+
+// isCJK 判断是否为需要二元切分的中日韩字符，范围需与迁移 0012 中的正则保持一致
+func isCJK(r rune) bool {
+	return (r >= 0x3400 && r <= 0x4DBF) ||
+		(r >= 0x4E00 && r <= 0x9FFF) ||
+		(r >= 0xF900 && r <= 0xFAFF) ||
+		(r >= 0x3040 && r <= 0x30FF) ||
+		(r >= 0xAC00 && r <= 0xD7AF)
+}
+
+// searchTerm 查询中的一个词：CJK连续片段或普通单词
+type searchTerm struct {
+	text string
+	cjk  bool
+}
+
+// splitSearchTerms 将用户输入切分为词，丢弃标点及 tsquery 运算符等特殊字符
+func splitSearchTerms(q string) []searchTerm {
+	var terms []searchTerm
+	var buf []rune
+	bufCJK := false
+	flush := func() {
+		if len(buf) > 0 {
+			terms = append(terms, searchTerm{text: strings.ToLower(string(buf)), cjk: bufCJK})
+			buf = buf[:0]
+		}
+	}
+	for _, r := range q {
+		switch {
+		case isCJK(r):
+			if !bufCJK {
+				flush()
+			}
+			bufCJK = true
+			buf = append(buf, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if bufCJK {
+				flush()
+			}
+			bufCJK = false
+			buf = append(buf, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return terms
+}
+
+// BuildSearchQuery 生成与 search_vector 匹配的 tsquery 文本
+//
+// 普通单词按前缀匹配；CJK片段展开为相邻的二元组短语（中文搜索 => 中文 <-> 文搜 <-> 搜索），
+// 单个CJK字符按前缀匹配以命中所有以该字开头的二元组。各词之间为 AND 关系。
+func BuildSearchQuery(q string) string {
+	parts := make([]string, 0)
+	for _, t := range splitSearchTerms(q) {
+		if !t.cjk {
+			parts = append(parts, t.text+":*")
+			continue
+		}
+		runes := []rune(t.text)
+		if len(runes) == 1 {
+			parts = append(parts, t.text+":*")
+			continue
+		}
+		grams := make([]string, 0, len(runes)-1)
+		for i := 0; i+1 < len(runes); i++ {
+			grams = append(grams, string(runes[i:i+2]))
+		}
+		parts = append(parts, "("+strings.Join(grams, " <-> ")+")")
+	}
+	return strings.Join(parts, " & ")
+}
+
+// buildHeadlineQuery 生成用于 ts_headline 的逐字短语查询（与 blog_cjk_split 配合）
+func buildHeadlineQuery(q string) string {
+	parts := make([]string, 0)
+	for _, t := range splitSearchTerms(q) {
+		if !t.cjk {
+			parts = append(parts, t.text+":*")
+			continue
+		}
+		chars := make([]string, 0)
+		for _, r := range t.text {
+			chars = append(chars, string(r))
+		}
+		parts = append(parts, "("+strings.Join(chars, " <-> ")+")")
+	}
+	return strings.Join(parts, " | ")
+}
+
+// renderHeadline 转义HTML，替换高亮标记，并移除 blog_cjk_split 在CJK字符间插入的空白
+func renderHeadline(s string) string {
+	runes := []rune(html.EscapeString(s))
+	var b strings.Builder
+	// prevCJK 记录上一个可见字符是否为CJK，用于判断空白是否由切分插入
+	prevCJK := false
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if unicode.IsSpace(r) {
+			j := i
+			for j < len(runes) && (unicode.IsSpace(runes[j]) || string(runes[j]) == headlineStart || string(runes[j]) == headlineStop) {
+				j++
+			}
+			if prevCJK && j < len(runes) && isCJK(runes[j]) {
+				// 跳过空白但保留其中的高亮标记
+				for k := i; k < j; k++ {
+					if !unicode.IsSpace(runes[k]) {
+						b.WriteRune(runes[k])
+					}
+				}
+				i = j - 1
+				continue
+			}
+			if prevCJK || (j < len(runes) && isCJK(runes[j])) {
+				// CJK与其他文字之间的多余空白收敛为一个
+				for k := i; k < j; k++ {
+					if !unicode.IsSpace(runes[k]) {
+						b.WriteRune(runes[k])
+					}
+				}
+				b.WriteRune(' ')
+				i = j - 1
+				continue
+			}
+			b.WriteRune(r)
+			continue
+		}
+		if string(r) != headlineStart && string(r) != headlineStop {
+			prevCJK = isCJK(r)
+		}
+		b.WriteRune(r)
+	}
+	out := strings.TrimSpace(b.String())
+	// 逐字高亮的相邻片段合并为一个
+	out = strings.ReplaceAll(out, headlineStop+headlineStart, "")
+	out = strings.ReplaceAll(out, headlineStart, "<mark>")
+	out = strings.ReplaceAll(out, headlineStop, "</mark>")
+	return out
+}
+
+// quoteLiteral 生成安全的 SQL 字符串字面量
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	v1 "server/api/blog/v1"
+	"server/internal/dao"
+	"server/internal/model/do"
+	"server/internal/model/entity"
+)
+
+// IBlogSEO 文章SEO数据服务接口
+type IBlogSEO interface {
+	// Save 保存文章SEO数据（整体覆盖）并重新生成 JSON-LD
+	Save(ctx context.Context, article *entity.BlogArticles, in *v1.SEOInput) error
+	// Resolve 读取文章SEO数据，缺失字段按标题、摘要、特色图片等回退；tr 不为 nil 时使用翻译的SEO数据与译文回退，
+	// 文章存在已发布翻译时附带 hreflang 备用语言链接
+	Resolve(ctx context.Context, article *entity.BlogArticles, tr *entity.BlogArticleTranslations) (*v1.SEOData, error)
+}
+
+type sBlogSEO struct{}
+
+// BlogSEO 文章SEO数据服务实例
+func BlogSEO() IBlogSEO {
+	return &sBlogSEO{}
+}
+
+// Save 保存SEO数据
+//
+// 表中仅保存用户显式填写的值，回退在读取时计算，这样后续修改标题或摘要时SEO数据会随之变化。
+func (s *sBlogSEO) Save(ctx context.Context, article *entity.BlogArticles, in *v1.SEOInput) error {
+	if in == nil {
+		in = &v1.SEOInput{}
+	}
+	stored := &entity.BlogSeoData{
+		ArticleId:          article.Id,
+		MetaTitle:          strings.TrimSpace(in.MetaTitle),
+		MetaDescription:    strings.TrimSpace(in.MetaDescription),
+		MetaKeywords:       strings.TrimSpace(in.MetaKeywords),
+		OgTitle:            strings.TrimSpace(in.OGTitle),
+		OgDescription:      strings.TrimSpace(in.OGDescription),
+		OgImage:            strings.TrimSpace(in.OGImage),
+		TwitterTitle:       strings.TrimSpace(in.TwitterTitle),
+		TwitterDescription: strings.TrimSpace(in.TwitterDesc),
+		TwitterImage:       strings.TrimSpace(in.TwitterImage),
+		CanonicalUrl:       strings.TrimSpace(in.CanonicalURL),
+	}
+	resolved, err := s.resolve(ctx, article, stored)
+	if err != nil {
+		return err
+	}
+
+	_, err = dao.BlogSeoData.Ctx(ctx).Data(do.BlogSeoData{
+		ArticleId:          stored.ArticleId,
+		MetaTitle:          stored.MetaTitle,
+		MetaDescription:    stored.MetaDescription,
+		MetaKeywords:       stored.MetaKeywords,
+		OgTitle:            stored.OgTitle,
+		OgDescription:      stored.OgDescription,
+		OgImage:            stored.OgImage,
+		TwitterTitle:       stored.TwitterTitle,
+		TwitterDescription: stored.TwitterDescription,
+		TwitterImage:       stored.TwitterImage,
+		CanonicalUrl:       stored.CanonicalUrl,
+		JsonLd:             string(resolved.JsonLd),
+		UpdatedAt:          gtime.Now(),
+	}).OnConflict(dao.BlogSeoData.Columns().ArticleId).Save()
+	if err != nil {
+		return gerror.Wrap(err, "保存SEO数据失败")
+	}
+	return nil
+}
+
+// Resolve 读取SEO数据
+func (s *sBlogSEO) Resolve(ctx context.Context, article *entity.BlogArticles, tr *entity.BlogArticleTranslations) (*v1.SEOData, error) {
+	var stored *entity.BlogSeoData
+	if tr != nil {
+		// 翻译的SEO数据保存在翻译表中，回退到译文的标题与摘要
+		stored = &entity.BlogSeoData{
+			ArticleId:          article.Id,
+			MetaTitle:          tr.MetaTitle,
+			MetaDescription:    tr.MetaDescription,
+			MetaKeywords:       tr.MetaKeywords,
+			OgTitle:            tr.OgTitle,
+			OgDescription:      tr.OgDescription,
+			OgImage:            tr.OgImage,
+			TwitterTitle:       tr.TwitterTitle,
+			TwitterDescription: tr.TwitterDescription,
+			TwitterImage:       tr.TwitterImage,
+			CanonicalUrl:       tr.CanonicalUrl,
+		}
+	} else {
+		err := dao.BlogSeoData.Ctx(ctx).Where(dao.BlogSeoData.Columns().ArticleId, article.Id).Scan(&stored)
+		if err != nil {
+			return nil, gerror.Wrap(err, "查询SEO数据失败")
+		}
+		if stored == nil {
+			stored = &entity.BlogSeoData{ArticleId: article.Id}
+		}
+	}
+	data, err := s.resolve(ctx, ApplyTranslation(article, tr), stored)
+	if err != nil {
+		return nil, err
+	}
+
+	// 各语言版本互相指向，x-default 指向原文
+	alternates, err := BlogTranslation().Alternates(ctx, []int64{article.Id}, false)
+	if err != nil {
+		return nil, err
+	}
+	if versions := alternates[article.Id]; len(versions) > 0 {
+		site := LoadSiteInfo(ctx)
+		for _, v := range versions {
+			data.Alternates = append(data.Alternates, v1.HreflangLink{Hreflang: v.Language, URL: site.ArticleURL(v.Slug)})
+		}
+		data.Alternates = append(data.Alternates, v1.HreflangLink{Hreflang: "x-default", URL: site.ArticleURL(versions[0].Slug)})
+	}
+	return data, nil
+}
+
+// resolve 计算回退后的SEO数据并生成 JSON-LD
+func (s *sBlogSEO) resolve(ctx context.Context, article *entity.BlogArticles, stored *entity.BlogSeoData) (*v1.SEOData, error) {
+	site := LoadSiteInfo(ctx)
+
+	var tagNames []string
+	rows, err := dao.BlogTags.Ctx(ctx).
+		Fields("blog_tags.name").
+		InnerJoin("blog_article_tags", "blog_tags.id = blog_article_tags.tag_id").
+		Where("blog_article_tags.article_id", article.Id).
+		Order("blog_tags.name").
+		Array()
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询文章标签失败")
+	}
+	for _, v := range rows {
+		tagNames = append(tagNames, v.String())
+	}
+
+	categoryName := ""
+	if article.CategoryId > 0 {
+		v, err := dao.BlogCategories.Ctx(ctx).Where("id", article.CategoryId).Value("name")
+		if err != nil {
+			return nil, gerror.Wrap(err, "查询分类失败")
+		}
+		categoryName = v.String()
+	}
+
+	data := &v1.SEOData{
+		Language:        ArticleLanguage(ctx, article),
+		MetaTitle:       firstNonEmpty(stored.MetaTitle, article.Title),
+		MetaDescription: firstNonEmpty(stored.MetaDescription, article.Summary),
+		MetaKeywords:    firstNonEmpty(stored.MetaKeywords, strings.Join(tagNames, ",")),
+		CanonicalURL:    firstNonEmpty(stored.CanonicalUrl, site.ArticleURL(article.Slug)),
+	}
+	data.OGTitle = firstNonEmpty(stored.OgTitle, data.MetaTitle)
+	data.OGDescription = firstNonEmpty(stored.OgDescription, data.MetaDescription)
+	// 没有设置分享图片与特色图片时使用自动生成的分享卡片
+	shareImage := ""
+	if stored.OgImage == "" && article.FeaturedImage == "" {
+		if shareImage, err = ShareImage().URL(ctx, ShareSubjectArticle, article.Id); err != nil {
+			return nil, err
+		}
+	}
+	data.OGImage = site.AbsURL(firstNonEmpty(stored.OgImage, article.FeaturedImage, shareImage))
+	data.TwitterTitle = firstNonEmpty(stored.TwitterTitle, data.OGTitle)
+	data.TwitterDesc = firstNonEmpty(stored.TwitterDescription, data.OGDescription)
+	data.TwitterImage = site.AbsURL(firstNonEmpty(stored.TwitterImage, data.OGImage))
+
+	// 作者取文章的实际作者，查询不到时回退到站点作者
+	authorName := site.Author
+	if author := User().Brief(ctx, article.AuthorId); author != nil {
+		authorName = author.DisplayName
+	}
+	jsonLd, err := buildBlogPostingJsonLd(site, article, data, authorName, categoryName, tagNames)
+	if err != nil {
+		return nil, err
+	}
+	data.JsonLd = jsonLd
+	data.WebmentionURL, data.PingbackURL = WebmentionEndpoints(ctx)
+	return data, nil
+}
+
+// buildBlogPostingJsonLd 生成 schema.org BlogPosting 结构化数据
+func buildBlogPostingJsonLd(site *SiteInfo, article *entity.BlogArticles, data *v1.SEOData, authorName, section string, keywords []string) (json.RawMessage, error) {
+	doc := map[string]interface{}{
+		"@context":    "https://schema.org",
+		"@type":       "BlogPosting",
+		"headline":    article.Title,
+		"description": data.MetaDescription,
+		"url":         data.CanonicalURL,
+		"inLanguage":  data.Language,
+		"mainEntityOfPage": map[string]interface{}{
+			"@type": "WebPage",
+			"@id":   data.CanonicalURL,
+		},
+		"author": map[string]interface{}{
+			"@type": "Person",
+			"name":  authorName,
+		},
+		"publisher": map[string]interface{}{
+			"@type": "Organization",
+			"name":  site.Title,
+			"url":   site.URL,
+		},
+	}
+	if data.OGImage != "" {
+		doc["image"] = []string{data.OGImage}
+	}
+	published := article.PublishAt
+	if published == nil {
+		published = article.CreatedAt
+	}
+	if published != nil {
+		doc["datePublished"] = published.Time.Format(time.RFC3339)
+	}
+	if article.UpdatedAt != nil {
+		doc["dateModified"] = article.UpdatedAt.Time.Format(time.RFC3339)
+	}
+	if section != "" {
+		doc["articleSection"] = section
+	}
+	if len(keywords) > 0 {
+		doc["keywords"] = strings.Join(keywords, ",")
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, gerror.Wrap(err, "生成JSON-LD失败")
+	}
+	return b, nil
+}
+
+// firstNonEmpty 返回第一个非空字符串
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"server/internal/dao"
+	"server/internal/model/do"
+	"server/internal/model/entity"
+)
+
+const seriesSlugMaxLen = 120
+
+// SeriesInput 创建或更新系列的参数
+type SeriesInput struct {
+	Title       string
+	Slug        string // 为空时根据标题生成（创建）或保持不变（更新）
+	Description string
+	CoverImage  string
+}
+
+// SeriesInfo 系列及其已发布文章数
+type SeriesInfo struct {
+	Series       *entity.BlogSeries
+	ArticleCount int
+}
+
+// SeriesIndex 系列索引页：系列信息与按顺序排列的已发布文章
+type SeriesIndex struct {
+	Series   *entity.BlogSeries
+	Articles []*entity.BlogArticles
+}
+
+// SeriesNav 文章在系列中的位置与上一篇/下一篇
+type SeriesNav struct {
+	Series   *entity.BlogSeries
+	Position int // 从 1 开始
+	Total    int
+	Prev     *entity.BlogArticles
+	Next     *entity.BlogArticles
+}
+
+// IBlogSeries 文章系列服务接口
+type IBlogSeries interface {
+	// List 全部系列，按更新时间倒序
+	List(ctx context.Context) ([]*SeriesInfo, error)
+	// Index 按 slug 查询系列索引页
+	Index(ctx context.Context, slug string) (*SeriesIndex, error)
+	// Create 创建系列
+	Create(ctx context.Context, in *SeriesInput) (*entity.BlogSeries, error)
+	// Update 更新系列
+	Update(ctx context.Context, id int64, in *SeriesInput) error
+	// Delete 删除系列（文章本身不受影响）
+	Delete(ctx context.Context, id int64) error
+	// SetArticles 按给定顺序整体替换系列中的文章；已属于其他系列的文章会移入本系列
+	SetArticles(ctx context.Context, seriesId int64, articleIds []int64) error
+	// Navigation 文章的系列导航，文章不属于任何系列时返回 nil
+	Navigation(ctx context.Context, articleId int64) (*SeriesNav, error)
+}
+
+type sBlogSeries struct{}
+
+// BlogSeries 文章系列服务实例
+func BlogSeries() IBlogSeries {
+	return &sBlogSeries{}
+}
+
+// List 系列列表
+func (s *sBlogSeries) List(ctx context.Context) ([]*SeriesInfo, error) {
+	var series []*entity.BlogSeries
+	if err := dao.BlogSeries.Ctx(ctx).Order("updated_at DESC, id DESC").Scan(&series); err != nil {
+		return nil, gerror.Wrap(err, "查询系列列表失败")
+	}
+	counts := make(map[int64]int)
+	if len(series) > 0 {
+		rows, err := dao.BlogSeriesArticles.Ctx(ctx).
+			Fields("blog_series_articles.series_id", "COUNT(1) AS cnt").
+			InnerJoin("blog_articles a", "a.id = blog_series_articles.article_id").
+			Where("a.status", ArticleStatusPublished).
+			Where("a.is_private", false).
+			WhereNull("a.deleted_at").
+			Group("blog_series_articles.series_id").
+			All()
+		if err != nil {
+			return nil, gerror.Wrap(err, "统计系列文章失败")
+		}
+		for _, r := range rows {
+			counts[r["series_id"].Int64()] = r["cnt"].Int()
+		}
+	}
+	list := make([]*SeriesInfo, 0, len(series))
+	for _, item := range series {
+		list = append(list, &SeriesInfo{Series: item, ArticleCount: counts[item.Id]})
+	}
+	return list, nil
+}
+
+// Index 系列索引页
+func (s *sBlogSeries) Index(ctx context.Context, slug string) (*SeriesIndex, error) {
+	key := "series:" + slug
+	if v, ok := blogContentCache.get(key); ok {
+		return v.(*SeriesIndex), nil
+	}
+	var series *entity.BlogSeries
+	if err := dao.BlogSeries.Ctx(ctx).Where("slug", slug).Scan(&series); err != nil {
+		return nil, gerror.Wrap(err, "查询系列失败")
+	}
+	if series == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "系列不存在")
+	}
+	articles, err := s.publishedArticles(ctx, series.Id)
+	if err != nil {
+		return nil, err
+	}
+	index := &SeriesIndex{Series: series, Articles: articles}
+	blogContentCache.set(ctx, key, index)
+	return index, nil
+}
+
+// Create 创建系列
+func (s *sBlogSeries) Create(ctx context.Context, in *SeriesInput) (*entity.BlogSeries, error) {
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+		return nil, gerror.New("系列标题不能为空")
+	}
+	slug, err := resolveSlug(s.model(ctx), in.Slug, title, "series", seriesSlugMaxLen, 0)
+	if err != nil {
+		return nil, err
+	}
+	id, err := dao.BlogSeries.Ctx(ctx).Data(do.BlogSeries{
+		Title:       title,
+		Slug:        slug,
+		Description: in.Description,
+		CoverImage:  in.CoverImage,
+		CreatedAt:   gtime.Now(),
+		UpdatedAt:   gtime.Now(),
+	}).InsertAndGetId()
+	if err != nil {
+		return nil, gerror.Wrap(err, "创建系列失败")
+	}
+	var series *entity.BlogSeries
+	if err = dao.BlogSeries.Ctx(ctx).Where("id", id).Scan(&series); err != nil {
+		return nil, gerror.Wrap(err, "查询系列失败")
+	}
+	InvalidateContentCaches()
+	return series, nil
+}
+
+// Update 更新系列
+func (s *sBlogSeries) Update(ctx context.Context, id int64, in *SeriesInput) error {
+	var cur *entity.BlogSeries
+	if err := dao.BlogSeries.Ctx(ctx).Where("id", id).Scan(&cur); err != nil {
+		return gerror.Wrap(err, "查询系列失败")
+	}
+	if cur == nil {
+		return gerror.NewCode(gcode.CodeNotFound, "系列不存在")
+	}
+	data := g.Map{
+		"description": in.Description,
+		"cover_image": in.CoverImage,
+		"updated_at":  gtime.Now(),
+	}
+	if title := strings.TrimSpace(in.Title); title != "" {
+		data["title"] = title
+	}
+	if in.Slug != "" && in.Slug != cur.Slug {
+		slug, err := resolveSlug(s.model(ctx), in.Slug, "", "series", seriesSlugMaxLen, id)
+		if err != nil {
+			return err
+		}
+		data["slug"] = slug
+	}
+	if _, err := dao.BlogSeries.Ctx(ctx).Where("id", id).Data(data).Update(); err != nil {
+		return gerror.Wrap(err, "更新系列失败")
+	}
+	InvalidateContentCaches()
+	return nil
+}
+
+// Delete 删除系列
+func (s *sBlogSeries) Delete(ctx context.Context, id int64) error {
+	res, err := dao.BlogSeries.Ctx(ctx).Where("id", id).Delete()
+	if err != nil {
+		return gerror.Wrap(err, "删除系列失败")
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return gerror.NewCode(gcode.CodeNotFound, "系列不存在")
+	}
+	InvalidateContentCaches()
+	return nil
+}
+
+// SetArticles 设置系列文章顺序
+func (s *sBlogSeries) SetArticles(ctx context.Context, seriesId int64, articleIds []int64) error {
+	seen := make(map[int64]bool, len(articleIds))
+	for _, id := range articleIds {
+		if seen[id] {
+			return gerror.NewCodef(gcode.CodeInvalidParameter, "文章ID重复: %d", id)
+		}
+		seen[id] = true
+	}
+	err := dao.BlogSeries.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		n, err := dao.BlogSeries.Ctx(ctx).TX(tx).Where("id", seriesId).LockUpdate().Count()
+		if err != nil {
+			return gerror.Wrap(err, "查询系列失败")
+		}
+		if n == 0 {
+			return gerror.NewCode(gcode.CodeNotFound, "系列不存在")
+		}
+		if len(articleIds) > 0 {
+			n, err = dao.BlogArticles.Ctx(ctx).TX(tx).WhereIn("id", articleIds).WhereNull("deleted_at").Count()
+			if err != nil {
+				return gerror.Wrap(err, "查询文章失败")
+			}
+			if n != len(articleIds) {
+				return gerror.NewCode(gcode.CodeInvalidParameter, "部分文章不存在")
+			}
+		}
+
+		// 清空本系列，并把目标文章从其他系列中移出
+		m := dao.BlogSeriesArticles.Ctx(ctx).TX(tx).Where("series_id", seriesId)
+		if len(articleIds) > 0 {
+			m = dao.BlogSeriesArticles.Ctx(ctx).TX(tx).Where("series_id = ? OR article_id IN (?)", seriesId, articleIds)
+		}
+		if _, err = m.Delete(); err != nil {
+			return gerror.Wrap(err, "清理系列文章失败")
+		}
+		for i, articleId := range articleIds {
+			if _, err = dao.BlogSeriesArticles.Ctx(ctx).TX(tx).Data(do.BlogSeriesArticles{
+				SeriesId:  seriesId,
+				ArticleId: articleId,
+				Position:  i,
+				CreatedAt: gtime.Now(),
+			}).Insert(); err != nil {
+				return gerror.Wrap(err, "保存系列文章失败")
+			}
+		}
+		_, err = dao.BlogSeries.Ctx(ctx).TX(tx).Where("id", seriesId).Data(g.Map{"updated_at": gtime.Now()}).Update()
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	InvalidateContentCaches()
+	return nil
+}
+
+// Navigation 系列导航；仅已发布文章参与排序，只缓存公开文章的导航
+func (s *sBlogSeries) Navigation(ctx context.Context, articleId int64) (*SeriesNav, error) {
+	key := fmt.Sprintf("series-nav:%d", articleId)
+	if v, ok := blogContentCache.get(key); ok {
+		return v.(*SeriesNav), nil
+	}
+	seriesId, err := dao.BlogSeriesArticles.Ctx(ctx).Where("article_id", articleId).Value("series_id")
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询文章系列失败")
+	}
+	var nav *SeriesNav
+	if !seriesId.IsEmpty() {
+		var series *entity.BlogSeries
+		if err = dao.BlogSeries.Ctx(ctx).Where("id", seriesId.Int64()).Scan(&series); err != nil {
+			return nil, gerror.Wrap(err, "查询系列失败")
+		}
+		articles, err := s.publishedArticles(ctx, seriesId.Int64())
+		if err != nil {
+			return nil, err
+		}
+		nav = &SeriesNav{Series: series, Total: len(articles)}
+		for i, a := range articles {
+			if a.Id != articleId {
+				continue
+			}
+			nav.Position = i + 1
+			if i > 0 {
+				nav.Prev = articles[i-1]
+			}
+			if i+1 < len(articles) {
+				nav.Next = articles[i+1]
+			}
+		}
+	}
+	public, err := isPublicArticle(ctx, articleId)
+	if err != nil {
+		return nil, err
+	}
+	if public {
+		blogContentCache.set(ctx, key, nav)
+	}
+	return nav, nil
+}
+
+// publishedArticles 系列中按顺序排列的已发布文章（不含正文）
+func (s *sBlogSeries) publishedArticles(ctx context.Context, seriesId int64) ([]*entity.BlogArticles, error) {
+	var articles []*entity.BlogArticles
+	err := dao.BlogArticles.Ctx(ctx).
+		FieldsEx(dao.BlogArticles.Columns().SearchVector, dao.BlogArticles.Columns().Content, dao.BlogArticles.Columns().HtmlContent).
+		InnerJoin("blog_series_articles sa", "sa.article_id = blog_articles.id").
+		Where("sa.series_id", seriesId).
+		Where("blog_articles.status", ArticleStatusPublished).
+		Where("blog_articles.is_private", false).
+		WhereNull("blog_articles.deleted_at").
+		Order("sa.position ASC, blog_articles.id ASC").
+		Scan(&articles)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询系列文章失败")
+	}
+	return articles, nil
+}
+
+func (s *sBlogSeries) model(ctx context.Context) func() *gdb.Model {
+	return func() *gdb.Model { return dao.BlogSeries.Ctx(ctx) }
+}
@@ -2,17 +2,32 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/util/gconv"
 	"github.com/google/uuid"
 
+	v1 "server/api/blog/v1"
 	"server/internal/dao"
 	"server/internal/model/entity"
+	"server/internal/service/auth"
+)
+
+// 文章状态
+const (
+	ArticleStatusDraft     = "draft"
+	ArticleStatusPublished = "published"
+	ArticleStatusScheduled = "scheduled"
+	ArticleStatusPrivate   = "private"
+	ArticleStatusArchive   = "archive"
 )
 
 // BlogSimpleService 简化版博客服务
@@ -36,17 +51,35 @@ func (s *BlogSimpleService) CreateArticle(ctx context.Context, req map[string]in
 	if content == "" {
 		return nil, gerror.New("文章内容不能为空")
 	}
+
+	// 未指定slug时根据标题生成，指定时检查是否已存在
+	var err error
 	if slug == "" {
-		return nil, gerror.New("文章URL标识不能为空")
+		if slug, err = GenerateArticleSlug(ctx, title, 0); err != nil {
+			return nil, err
+		}
+	} else {
+		existURL, err := dao.BlogArticles.Ctx(ctx).Where("slug", slug).Count()
+		if err != nil {
+			return nil, gerror.Wrap(err, "检查URL标识失败")
+		}
+		if existURL == 0 {
+			existURL, err = dao.BlogArticleTranslations.Ctx(ctx).Where("slug", slug).Count()
+			if err != nil {
+				return nil, gerror.Wrap(err, "检查URL标识失败")
+			}
+		}
+		if existURL > 0 {
+			return nil, gerror.New("URL标识已存在，请更换")
+		}
 	}
 
-	// 检查slug是否已存在
-	existURL, err := dao.BlogArticles.Ctx(ctx).Where("slug", slug).Count()
-	if err != nil {
-		return nil, gerror.Wrap(err, "检查URL标识失败")
-	}
-	if existURL > 0 {
-		return nil, gerror.New("URL标识已存在，请更换")
+	// 原文语言，未指定时为站点默认语言
+	language := gconv.String(req["language"])
+	if language != "" {
+		if language, err = ValidateArticleLanguage(ctx, language); err != nil {
+			return nil, err
+		}
 	}
 
 	// 验证分类是否存在
@@ -77,72 +110,162 @@ func (s *BlogSimpleService) CreateArticle(ctx context.Context, req map[string]in
 		status = "draft"
 	}
 
-	var publishAt *gtime.Time
-	if status == "published" {
-		publishAt = gtime.Now()
+	status, publishAt, err := resolvePublishState(status, req["publishAt"], nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// 作者默认为当前登录用户；导入等无登录态的内部调用通过 authorId 显式指定
+	authorId := gconv.Int64(req["authorId"])
+	if authorId <= 0 {
+		authorId = auth.CurrentUserID(ctx)
+	}
+	if authorId == 0 {
+		return nil, gerror.NewCode(gcode.CodeNotAuthorized, "未登录")
 	}
 
 	// 构建插入数据，明确排除id字段，让数据库自动生成
 	articleId := uuid.New().String()
+	featuredImage := gconv.String(req["featuredImage"])
 	insertData := map[string]interface{}{
-		"article_id":   articleId,
-		"category_id":  categoryId,
-		"title":        title,
-		"slug":         slug,
-		"summary":      summary,
-		"content":      content,
-		"html_content": htmlContent,
-		"author_id":    1,
-		"status":       status,
-		"is_draft":     status != "published",
-		"read_time":    readTime,
-		"publish_at":   publishAt,
-		"created_at":   gtime.Now(),
-		"updated_at":   gtime.Now(),
-	}
+		"article_id":     articleId,
+		"category_id":    categoryId,
+		"title":          title,
+		"slug":           slug,
+		"summary":        summary,
+		"content":        content,
+		"html_content":   htmlContent,
+		"featured_image": featuredImage,
+		"author_id":      authorId,
+		"language":       language,
+		"status":         status,
+		"is_draft":       status != "published",
+		"read_time":      readTime,
+		"publish_at":     publishAt,
+		"created_at":     gtime.Now(),
+		"updated_at":     gtime.Now(),
+	}
+
+	// 文章、SEO数据、标签与初始版本在同一事务中写入，任一步失败时整体回滚
+	var article *entity.BlogArticles
+	err = dao.BlogArticles.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		if _, err := dao.BlogArticles.Ctx(ctx).TX(tx).Data(insertData).Insert(); err != nil {
+			return gerror.Wrap(err, "创建文章失败")
+		}
+
+		one, err := dao.BlogArticles.Ctx(ctx).TX(tx).Where("article_id", articleId).One()
+		if err != nil {
+			return gerror.Wrap(err, "查询文章失败")
+		}
+
+		// 新文章占用的slug不再作为其他文章的重定向来源
+		if err = recordSlugChange(ctx, gconv.Int64(one["id"]), "", slug); err != nil {
+			return err
+		}
+
+		// 构建返回的文章对象
+		article = &entity.BlogArticles{
+			Id:            gconv.Int64(one["id"]),
+			ArticleId:     insertData["article_id"].(string),
+			CategoryId:    categoryId,
+			Title:         title,
+			Slug:          slug,
+			Summary:       summary,
+			Content:       content,
+			HtmlContent:   htmlContent,
+			FeaturedImage: featuredImage,
+			AuthorId:      authorId,
+			Language:      language,
+			Status:        status,
+			IsDraft:       status != "published",
+			ReadTime:      readTime,
+			PublishAt:     publishAt,
+			CreatedAt:     gtime.Now(),
+			UpdatedAt:     gtime.Now(),
+		}
+
+		// 保存标签（不存在的标签自动创建）
+		if names, ok := tagNamesFromReq(req); ok {
+			if err = BlogTag().SetArticleTags(ctx, article.Id, names); err != nil {
+				return err
+			}
+		}
 
-	// 插入数据并获取结果
-	_, err = dao.BlogArticles.Ctx(ctx).Data(insertData).Insert()
+		// 保存SEO数据；结构化数据的关键词取自标签，需在标签写入之后生成
+		seo, _ := req["seo"].(*v1.SEOInput)
+		if err = BlogSEO().Save(ctx, article, seo); err != nil {
+			return err
+		}
+
+		// 记录初始版本
+		_, err = BlogVersion().Record(ctx, article.Id, VersionChangeCreate, "")
+		return err
+	})
 	if err != nil {
-		return nil, gerror.Wrap(err, "创建文章失败")
-	}
-
-	one, err := dao.BlogArticles.Ctx(ctx).Where("article_id", articleId).One()
-
-	// 构建返回的文章对象
-	article := &entity.BlogArticles{
-		Id:          gconv.Int64(one["id"]),
-		ArticleId:   insertData["article_id"].(string),
-		CategoryId:  categoryId,
-		Title:       title,
-		Slug:        slug,
-		Summary:     summary,
-		Content:     content,
-		HtmlContent: htmlContent,
-		AuthorId:    1,
-		Status:      status,
-		IsDraft:     status != "published",
-		ReadTime:    readTime,
-		PublishAt:   publishAt,
-		CreatedAt:   gtime.Now(),
-		UpdatedAt:   gtime.Now(),
+		return nil, err
 	}
 
+	InvalidateContentCaches()
+
 	return article, nil
 }
 
+// ArticleListInput 文章列表查询参数
+type ArticleListInput struct {
+	Page       int
+	Size       int
+	Status     string
+	CategoryId int64
+	Tag        string // 标签 slug 或名称
+	Search     string // 全文搜索关键词，非空时按相关度排序
+	AuthorId   int64
+	Author     string // 作者用户名，与 AuthorId 同时指定时取交集
+	Lang       string // 请求的语言，返回对应的翻译；回退方式为 none 时排除没有该语言版本的文章
+	// IncludeDescendants 分类过滤是否包含子分类
+	IncludeDescendants bool
+}
+
 // ListArticles 获取文章列表
-func (s *BlogSimpleService) ListArticles(ctx context.Context, page, size int, status string) ([]*entity.BlogArticles, int, error) {
+func (s *BlogSimpleService) ListArticles(ctx context.Context, in *ArticleListInput) ([]*entity.BlogArticles, int, error) {
 	query := dao.BlogArticles.Ctx(ctx).Where("deleted_at IS NULL")
 
-	// 状态过滤
-	if status != "" {
-		query = query.Where("status", status)
+	// 状态过滤：未登录只能查看已发布的公开文章，避免草稿、定时与私密文章暴露
+	if in.Status != "" && auth.IsAuthenticated(ctx) {
+		query = query.Where("status", in.Status)
 	} else {
 		query = query.Where("status", "published")
 	}
+	if !auth.IsAuthenticated(ctx) {
+		query = query.Where("is_private", false)
+	}
+
+	// 分类、标签过滤
+	query = ApplyArticleFilters(query, in.CategoryId, in.IncludeDescendants, in.Tag)
+
+	// 作者过滤
+	if in.AuthorId > 0 {
+		query = query.Where("author_id", in.AuthorId)
+	}
+	if in.Author != "" {
+		query = query.Where("author_id IN (SELECT id FROM users WHERE username = ?)", strings.ToLower(strings.TrimSpace(in.Author)))
+	}
+
+	// 语言过滤
+	query = BlogTranslation().FilterLanguage(ctx, query, in.Lang)
+
+	// 全文搜索
+	order := "created_at DESC"
+	if in.Search != "" {
+		tsQuery := BuildSearchQuery(in.Search)
+		if tsQuery == "" {
+			return nil, 0, nil
+		}
+		query = query.Where("search_vector @@ to_tsquery('simple', ?)", tsQuery)
+		order = fmt.Sprintf("ts_rank(search_vector, to_tsquery('simple', %s)) DESC, created_at DESC", quoteLiteral(tsQuery))
+	}
 
 	// 分页参数
+	page, size := in.Page, in.Size
 	if page <= 0 {
 		page = 1
 	}
@@ -159,13 +282,18 @@ func (s *BlogSimpleService) ListArticles(ctx context.Context, page, size int, st
 	// 查询列表
 	offset := (page - 1) * size
 	var articles []*entity.BlogArticles
-	err = query.Order("created_at DESC").
+	err = query.FieldsEx(dao.BlogArticles.Columns().SearchVector).
+		Order(gdb.Raw(order)).
 		Limit(offset, size).
 		Scan(&articles)
 	if err != nil {
 		return nil, 0, gerror.Wrap(err, "查询文章列表失败")
 	}
 
+	if articles, err = BlogTranslation().Localize(ctx, articles, in.Lang); err != nil {
+		return nil, 0, err
+	}
+
 	return articles, total, nil
 }
 
@@ -201,9 +329,6 @@ func (s *BlogSimpleService) UpdateArticle(ctx context.Context, req map[string]in
 	if content == "" {
 		return gerror.New("文章内容不能为空")
 	}
-	if slug == "" {
-		return gerror.New("文章URL标识不能为空")
-	}
 
 	// 检查文章是否存在
 	existArticle, err := dao.BlogArticles.Ctx(ctx).Where("id", id).Where("deleted_at IS NULL").One()
@@ -213,20 +338,47 @@ func (s *BlogSimpleService) UpdateArticle(ctx context.Context, req map[string]in
 	if existArticle.IsEmpty() {
 		return gerror.New("文章不存在")
 	}
+	if err = CheckOwnership(ctx, existArticle["author_id"].Int64()); err != nil {
+		return err
+	}
 
-	// 检查slug是否与其他文章冲突
+	// 未指定slug时保持不变；检查slug是否与其他文章（包括回收站中的）冲突
+	oldSlug := existArticle["slug"].String()
+	if slug == "" {
+		slug = oldSlug
+	}
 	existSlug, err := dao.BlogArticles.Ctx(ctx).
 		Where("slug", slug).
 		Where("id != ?", id).
-		Where("deleted_at IS NULL").
 		Count()
 	if err != nil {
 		return gerror.Wrap(err, "检查URL标识失败")
 	}
+	if existSlug == 0 {
+		existSlug, err = dao.BlogArticleTranslations.Ctx(ctx).Where("slug", slug).Count()
+		if err != nil {
+			return gerror.Wrap(err, "检查URL标识失败")
+		}
+	}
 	if existSlug > 0 {
 		return gerror.New("URL标识已存在，请更换")
 	}
 
+	// 原文语言：未指定时保持不变，不能与已有翻译的语言相同
+	language := existArticle["language"].String()
+	if v := gconv.String(req["language"]); v != "" {
+		if language, err = ValidateArticleLanguage(ctx, v); err != nil {
+			return err
+		}
+		n, err := dao.BlogArticleTranslations.Ctx(ctx).Where("article_id", id).Where("language", language).Count()
+		if err != nil {
+			return gerror.Wrap(err, "检查翻译失败")
+		}
+		if n > 0 {
+			return gerror.New("该语言已有翻译，不能作为原文语言")
+		}
+	}
+
 	// 验证分类是否存在
 	categoryId := gconv.Int64(req["categoryId"])
 	if categoryId > 0 {
@@ -260,9 +412,9 @@ func (s *BlogSimpleService) UpdateArticle(ctx context.Context, req map[string]in
 		status = "draft"
 	}
 
-	var publishAt *gtime.Time
-	if status == "published" {
-		publishAt = gtime.Now()
+	status, publishAt, err := resolvePublishState(status, req["publishAt"], existArticle["publish_at"].GTime())
+	if err != nil {
+		return err
 	}
 
 	// 构建更新数据
@@ -272,6 +424,7 @@ func (s *BlogSimpleService) UpdateArticle(ctx context.Context, req map[string]in
 		"summary":      summary,
 		"content":      content,
 		"html_content": htmlContent,
+		"language":     language,
 		"category_id":  categoryId,
 		"status":       status,
 		"is_draft":     status != "published",
@@ -291,20 +444,104 @@ func (s *BlogSimpleService) UpdateArticle(ctx context.Context, req map[string]in
 		updateData["featured_image"] = featuredImage
 	}
 
-	// 执行更新
-	_, err = dao.BlogArticles.Ctx(ctx).
-		Where("id", id).
-		Data(updateData).
-		Update()
+	// 文章、重定向、SEO数据、标签与版本在同一事务中写入，任一步失败时整体回滚
+	err = dao.BlogArticles.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.BlogArticles.Ctx(ctx).TX(tx).
+			Where("id", id).
+			Data(updateData).
+			Update()
+		if err != nil {
+			return gerror.Wrap(err, "更新文章失败")
+		}
+
+		// slug 变更后旧地址重定向到新地址
+		if err = recordSlugChange(ctx, id, oldSlug, slug); err != nil {
+			return err
+		}
+
+		// 标签：未传时保持不变，传入（包括空列表）时整体替换
+		if names, ok := tagNamesFromReq(req); ok {
+			if err = BlogTag().SetArticleTags(ctx, id, names); err != nil {
+				return err
+			}
+		}
+
+		// 保存SEO数据；结构化数据的关键词取自标签，需在标签写入之后生成
+		var article *entity.BlogArticles
+		if err = dao.BlogArticles.Ctx(ctx).TX(tx).Where("id", id).Scan(&article); err != nil {
+			return gerror.Wrap(err, "查询文章失败")
+		}
+		seo, _ := req["seo"].(*v1.SEOInput)
+		if err = BlogSEO().Save(ctx, article, seo); err != nil {
+			return err
+		}
+
+		// 记录版本
+		_, err = BlogVersion().Record(ctx, id, VersionChangeUpdate, gconv.String(req["changeSummary"]))
+		return err
+	})
 	if err != nil {
-		return gerror.Wrap(err, "更新文章失败")
+		return err
 	}
 
+	InvalidateContentCaches()
+
 	g.Log().Info(ctx, "BlogSimpleService.UpdateArticle", "id", id, "title", title)
 
 	return nil
 }
 
+// tagNamesFromReq 从请求中提取标签名称，ok 表示请求中携带了标签字段
+func tagNamesFromReq(req map[string]interface{}) (names []string, ok bool) {
+	tags, ok := req["tags"].([]v1.TagInput)
+	if !ok || tags == nil {
+		return nil, false
+	}
+	names = make([]string, 0, len(tags))
+	for _, t := range tags {
+		names = append(names, t.Name)
+	}
+	return names, true
+}
+
+// DeleteArticle 软删除文章，删除前记录一个删除版本
+func (s *BlogSimpleService) DeleteArticle(ctx context.Context, id int64) error {
+	exist, err := dao.BlogArticles.Ctx(ctx).Where("id", id).Where("deleted_at IS NULL").Count()
+	if err != nil {
+		return gerror.Wrap(err, "检查文章失败")
+	}
+	if exist == 0 {
+		return gerror.New("文章不存在")
+	}
+	if err = checkArticleOwnership(ctx, id); err != nil {
+		return err
+	}
+
+	// 删除版本与软删除在同一事务内完成
+	err = dao.BlogArticles.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		if _, err := (&sBlogVersion{}).record(ctx, tx, id, VersionChangeDelete, ""); err != nil {
+			return err
+		}
+		_, err := dao.BlogArticles.Ctx(ctx).TX(tx).
+			Where("id", id).
+			Data(g.Map{"deleted_at": gtime.Now(), "updated_at": gtime.Now()}).
+			Update()
+		if err != nil {
+			return gerror.Wrap(err, "删除文章失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	InvalidateContentCaches()
+
+	g.Log().Info(ctx, "BlogSimpleService.DeleteArticle", "id", id)
+
+	return nil
+}
+
 // ListCategories 获取分类列表
 func (s *BlogSimpleService) ListCategories(ctx context.Context) ([]*entity.BlogCategories, error) {
 	var categories []*entity.BlogCategories
@@ -320,6 +557,44 @@ func (s *BlogSimpleService) ListCategories(ctx context.Context) ([]*entity.BlogC
 	return categories, nil
 }
 
+// resolvePublishState 根据请求的状态与发布时间确定最终状态和 publish_at
+//
+//   - published 且发布时间在未来：转为 scheduled，由定时发布任务到点发布
+//   - scheduled 必须指定发布时间；时间已过则直接发布
+//   - 未指定发布时间时保留原有 publish_at，首次发布取当前时间
+func resolvePublishState(status string, requested interface{}, current *gtime.Time) (string, *gtime.Time, error) {
+	var at *gtime.Time
+	switch v := requested.(type) {
+	case *time.Time:
+		if v != nil {
+			at = gtime.NewFromTime(*v)
+		}
+	case *gtime.Time:
+		at = v
+	}
+	if at == nil {
+		at = current
+	}
+
+	now := gtime.Now()
+	switch status {
+	case ArticleStatusScheduled:
+		if at == nil {
+			return "", nil, gerror.New("定时发布需要指定发布时间")
+		}
+		if !at.After(now) {
+			status = ArticleStatusPublished
+		}
+	case ArticleStatusPublished:
+		if at == nil {
+			at = now
+		} else if at.After(now) {
+			status = ArticleStatusScheduled
+		}
+	}
+	return status, at, nil
+}
+
 // processMarkdown 处理Markdown内容
 func (s *BlogSimpleService) processMarkdown(content string) string {
 	// 简单的Markdown到HTML转换
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"server/internal/service/configcache"
+)
+
+// 博客站点信息配置（blog/default 命名空间）
+const (
+	blogConfigNamespace = "blog"
+	blogConfigEnv       = "default"
+)
+
+// SiteInfo 站点基础信息，用于订阅源、站点地图等对外输出
+type SiteInfo struct {
+	Title       string
+	Description string
+	URL         string // 前端站点根地址，不含末尾斜杠
+	APIURL      string // 后端API根地址，不含末尾斜杠
+	Author      string
+	Language    string
+}
+
+// LoadSiteInfo 从动态配置读取站点信息
+func LoadSiteInfo(ctx context.Context) *SiteInfo {
+	return &SiteInfo{
+		Title:       configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "site_title", "JieCool"),
+		Description: configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "site_description", "JieCool 博客"),
+		URL:         strings.TrimRight(configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "site_url", "http://localhost:53000"), "/"),
+		APIURL:      strings.TrimRight(configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "api_url", "http://localhost:8080"), "/"),
+		Author:      configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "site_author", "JieCool"),
+		Language:    configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "site_language", "zh-CN"),
+	}
+}
+
+// AbsURL 将站内相对路径转换为绝对地址，已是绝对地址时原样返回
+func (s *SiteInfo) AbsURL(path string) string {
+	if path == "" || strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return s.URL + path
+}
+
+// CategoryURL 分类文章列表在前端的访问地址
+func (s *SiteInfo) CategoryURL(slug string) string {
+	return s.URL + "/blog?category=" + url.QueryEscape(slug)
+}
+
+// TagURL 标签文章列表在前端的访问地址
+func (s *SiteInfo) TagURL(slug string) string {
+	return s.URL + "/blog?tag=" + url.QueryEscape(slug)
+}
+
+// ArticleURL 文章在前端的访问地址
+func (s *SiteInfo) ArticleURL(slug string) string {
+	return s.URL + "/blog/" + slug
+}
+
+// RawDocument 直接写入响应体的文档（订阅源、站点地图等）
+type RawDocument struct {
+	Body         []byte
+	ContentType  string
+	ETag         string
+	LastModified time.Time // 内容最近更新时间，未知时为零值
+}
+
+// BodyETag 基于内容摘要生成强 ETag
+func BodyETag(body []byte) string {
+	return fmt.Sprintf(`"%x"`, md5.Sum(body))
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/google/uuid"
+)
+
+// Slugify 将名称转换为 URL 友好的标识：小写字母、数字与汉字保留，其余字符折叠为连字符
+func Slugify(name string, maxLen int) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+			dash = false
+		default:
+			if !dash && b.Len() > 0 {
+				b.WriteByte('-')
+				dash = true
+			}
+		}
+	}
+	slug := strings.Trim(b.String(), "-")
+	if maxLen > 0 {
+		runes := []rune(slug)
+		if len(runes) > maxLen {
+			slug = strings.Trim(string(runes[:maxLen]), "-")
+		}
+	}
+	return slug
+}
+
+// uniqueSlug 在指定表中生成不冲突的 slug，冲突时追加 -2、-3…；excludeId 为更新时排除的自身ID
+func uniqueSlug(m func() *gdb.Model, base, prefix string, maxLen int, excludeId int64) (string, error) {
+	return uniqueSlugFunc(base, prefix, maxLen, func(slug string) (bool, error) {
+		q := m().Where("slug", slug)
+		if excludeId > 0 {
+			q = q.WhereNot("id", excludeId)
+		}
+		n, err := q.Count()
+		return n > 0, err
+	})
+}
+
+// uniqueSlugFunc 由 taken 判断 slug 是否已被占用，用于需要同时检查多张表的场景
+func uniqueSlugFunc(base, prefix string, maxLen int, taken func(slug string) (bool, error)) (string, error) {
+	if base == "" {
+		base = prefix + "-" + strings.ReplaceAll(uuid.New().String(), "-", "")[:8]
+	}
+	slug := base
+	for i := 2; i < 100; i++ {
+		used, err := taken(slug)
+		if err != nil {
+			return "", gerror.Wrap(err, "检查URL标识失败")
+		}
+		if !used {
+			return slug, nil
+		}
+		suffix := fmt.Sprintf("-%d", i)
+		runes := []rune(base)
+		if maxLen > 0 && len(runes)+len(suffix) > maxLen {
+			runes = runes[:maxLen-len(suffix)]
+		}
+		slug = string(runes) + suffix
+	}
+	return "", gerror.New("无法生成唯一的URL标识")
+}
+
+// resolveSlug 校验调用方指定的 slug（格式与唯一性），未指定时根据 name 生成唯一 slug
+func resolveSlug(m func() *gdb.Model, slug, name, prefix string, maxLen int, excludeId int64) (string, error) {
+	if slug = strings.TrimSpace(slug); slug == "" {
+		return uniqueSlug(m, Slugify(name, maxLen), prefix, maxLen, excludeId)
+	}
+	if Slugify(slug, maxLen) != slug {
+		return "", gerror.New("URL标识只能包含小写字母、数字、汉字和连字符")
+	}
+	q := m().Where("slug", slug)
+	if excludeId > 0 {
+		q = q.WhereNot("id", excludeId)
+	}
+	n, err := q.Count()
+	if err != nil {
+		return "", gerror.Wrap(err, "检查URL标识失败")
+	}
+	if n > 0 {
+		return "", gerror.New("URL标识已存在，请更换")
+	}
+	return slug, nil
+}
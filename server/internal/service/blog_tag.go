@@ -0,0 +1,391 @@
+package service
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"server/internal/dao"
+	"server/internal/model/do"
+	"server/internal/model/entity"
+)
+
+// 标签字段限制（与 blog_tags 表结构一致）
+const (
+	tagNameMaxLen   = 50
+	tagSlugMaxLen   = 50
+	tagDefaultColor = "#6B7280"
+)
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// TagInput 创建或更新标签的参数
+type TagInput struct {
+	Name        string
+	Slug        string // 为空时根据名称生成
+	Description string
+	Color       string
+	IsActive    *bool
+}
+
+// TagListInput 标签列表查询参数
+type TagListInput struct {
+	Page            int
+	Size            int
+	Keyword         string
+	Sort            string // name/count
+	IncludeInactive bool
+}
+
+// TagCloudItem 标签云条目
+type TagCloudItem struct {
+	Tag    *entity.BlogTags
+	Weight int // 1..levels
+}
+
+// IBlogTag 博客标签服务接口
+type IBlogTag interface {
+	// List 分页查询标签
+	List(ctx context.Context, in *TagListInput) (tags []*entity.BlogTags, total int, err error)
+	// GetBySlug 按 slug 查询标签
+	GetBySlug(ctx context.Context, slug string) (*entity.BlogTags, error)
+	// Create 创建标签
+	Create(ctx context.Context, in *TagInput) (*entity.BlogTags, error)
+	// Update 更新标签
+	Update(ctx context.Context, id int64, in *TagInput) error
+	// Delete 删除标签及其文章关联
+	Delete(ctx context.Context, id int64) error
+	// Merge 将 source 标签合并到 target：转移文章关联后删除 source
+	Merge(ctx context.Context, sourceId, targetId int64) error
+	// SetArticleTags 按名称设置文章标签（不存在的标签自动创建），整体替换原有关联
+	SetArticleTags(ctx context.Context, articleId int64, names []string) error
+	// Cloud 按文章数生成带权重的标签云
+	Cloud(ctx context.Context, limit, levels int) ([]*TagCloudItem, error)
+}
+
+type sBlogTag struct{}
+
+// BlogTag 博客标签服务实例
+func BlogTag() IBlogTag {
+	return &sBlogTag{}
+}
+
+// List 标签列表
+func (s *sBlogTag) List(ctx context.Context, in *TagListInput) (tags []*entity.BlogTags, total int, err error) {
+	page, size := in.Page, in.Size
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 50
+	}
+	m := dao.BlogTags.Ctx(ctx)
+	if !in.IncludeInactive {
+		m = m.Where("is_active", true)
+	}
+	if kw := strings.TrimSpace(in.Keyword); kw != "" {
+		m = m.Where("(name ILIKE ? OR slug ILIKE ?)", "%"+kw+"%", "%"+kw+"%")
+	}
+	total, err = m.Count()
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询标签总数失败")
+	}
+	order := "name ASC"
+	if in.Sort == "count" {
+		order = "article_count DESC, name ASC"
+	}
+	if err = m.Order(order).Limit((page-1)*size, size).Scan(&tags); err != nil {
+		return nil, 0, gerror.Wrap(err, "查询标签列表失败")
+	}
+	return tags, total, nil
+}
+
+// GetBySlug 按 slug 查询
+func (s *sBlogTag) GetBySlug(ctx context.Context, slug string) (*entity.BlogTags, error) {
+	var tag *entity.BlogTags
+	if err := dao.BlogTags.Ctx(ctx).Where("slug", slug).Scan(&tag); err != nil {
+		return nil, gerror.Wrap(err, "查询标签失败")
+	}
+	if tag == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "标签不存在")
+	}
+	return tag, nil
+}
+
+// Create 创建标签
+func (s *sBlogTag) Create(ctx context.Context, in *TagInput) (*entity.BlogTags, error) {
+	var tag *entity.BlogTags
+	err := dao.BlogTags.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var err error
+		tag, err = s.create(ctx, tx, in)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	InvalidateContentCaches()
+	return tag, nil
+}
+
+func (s *sBlogTag) create(ctx context.Context, tx gdb.TX, in *TagInput) (*entity.BlogTags, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return nil, gerror.New("标签名称不能为空")
+	}
+	if len([]rune(name)) > tagNameMaxLen {
+		return nil, gerror.Newf("标签名称不能超过%d个字符", tagNameMaxLen)
+	}
+	exist, err := dao.BlogTags.Ctx(ctx).TX(tx).Where("LOWER(name) = LOWER(?)", name).Count()
+	if err != nil {
+		return nil, gerror.Wrap(err, "检查标签名称失败")
+	}
+	if exist > 0 {
+		return nil, gerror.New("标签名称已存在")
+	}
+	color, err := normalizeTagColor(in.Color)
+	if err != nil {
+		return nil, err
+	}
+	slug, err := s.resolveSlug(ctx, tx, in.Slug, name, 0)
+	if err != nil {
+		return nil, err
+	}
+	isActive := true
+	if in.IsActive != nil {
+		isActive = *in.IsActive
+	}
+
+	id, err := dao.BlogTags.Ctx(ctx).TX(tx).Data(do.BlogTags{
+		Name:        name,
+		Slug:        slug,
+		Description: in.Description,
+		Color:       color,
+		IsActive:    isActive,
+		CreatedAt:   gtime.Now(),
+		UpdatedAt:   gtime.Now(),
+	}).InsertAndGetId()
+	if err != nil {
+		return nil, gerror.Wrap(err, "创建标签失败")
+	}
+	var tag *entity.BlogTags
+	if err = dao.BlogTags.Ctx(ctx).TX(tx).Where("id", id).Scan(&tag); err != nil {
+		return nil, gerror.Wrap(err, "查询标签失败")
+	}
+	return tag, nil
+}
+
+// Update 更新标签
+func (s *sBlogTag) Update(ctx context.Context, id int64, in *TagInput) error {
+	err := dao.BlogTags.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		var cur *entity.BlogTags
+		if err := dao.BlogTags.Ctx(ctx).TX(tx).Where("id", id).Scan(&cur); err != nil {
+			return gerror.Wrap(err, "查询标签失败")
+		}
+		if cur == nil {
+			return gerror.NewCode(gcode.CodeNotFound, "标签不存在")
+		}
+
+		data := g.Map{"updated_at": gtime.Now()}
+		if name := strings.TrimSpace(in.Name); name != "" && name != cur.Name {
+			if len([]rune(name)) > tagNameMaxLen {
+				return gerror.Newf("标签名称不能超过%d个字符", tagNameMaxLen)
+			}
+			exist, err := dao.BlogTags.Ctx(ctx).TX(tx).Where("LOWER(name) = LOWER(?)", name).WhereNot("id", id).Count()
+			if err != nil {
+				return gerror.Wrap(err, "检查标签名称失败")
+			}
+			if exist > 0 {
+				return gerror.New("标签名称已存在")
+			}
+			data["name"] = name
+		}
+		if in.Slug != "" && in.Slug != cur.Slug {
+			slug, err := s.resolveSlug(ctx, tx, in.Slug, "", id)
+			if err != nil {
+				return err
+			}
+			data["slug"] = slug
+		}
+		if in.Color != "" {
+			color, err := normalizeTagColor(in.Color)
+			if err != nil {
+				return err
+			}
+			data["color"] = color
+		}
+		data["description"] = in.Description
+		if in.IsActive != nil {
+			data["is_active"] = *in.IsActive
+		}
+		if _, err := dao.BlogTags.Ctx(ctx).TX(tx).Where("id", id).Data(data).Update(); err != nil {
+			return gerror.Wrap(err, "更新标签失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	InvalidateContentCaches()
+	return nil
+}
+
+// Delete 删除标签
+func (s *sBlogTag) Delete(ctx context.Context, id int64) error {
+	affected, err := dao.BlogTags.Ctx(ctx).Where("id", id).Delete()
+	if err != nil {
+		return gerror.Wrap(err, "删除标签失败")
+	}
+	if n, _ := affected.RowsAffected(); n == 0 {
+		return gerror.NewCode(gcode.CodeNotFound, "标签不存在")
+	}
+	InvalidateContentCaches()
+	return nil
+}
+
+// Merge 合并标签
+func (s *sBlogTag) Merge(ctx context.Context, sourceId, targetId int64) error {
+	if sourceId == targetId {
+		return gerror.New("不能将标签合并到自身")
+	}
+	err := dao.BlogTags.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		n, err := dao.BlogTags.Ctx(ctx).TX(tx).WhereIn("id", []int64{sourceId, targetId}).Count()
+		if err != nil {
+			return gerror.Wrap(err, "查询标签失败")
+		}
+		if n != 2 {
+			return gerror.NewCode(gcode.CodeNotFound, "标签不存在")
+		}
+		// 转移 source 的文章关联，已同时拥有两个标签的文章跳过
+		if _, err = tx.Exec(`INSERT INTO blog_article_tags (article_id, tag_id, created_at)
+			SELECT article_id, ?, created_at FROM blog_article_tags WHERE tag_id = ?
+			ON CONFLICT (article_id, tag_id) DO NOTHING`, targetId, sourceId); err != nil {
+			return gerror.Wrap(err, "转移标签关联失败")
+		}
+		if _, err = dao.BlogTags.Ctx(ctx).TX(tx).Where("id", sourceId).Delete(); err != nil {
+			return gerror.Wrap(err, "删除被合并标签失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	InvalidateContentCaches()
+	return nil
+}
+
+// SetArticleTags 设置文章标签
+func (s *sBlogTag) SetArticleTags(ctx context.Context, articleId int64, names []string) error {
+	return dao.BlogArticleTags.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		ids, err := s.ensureTags(ctx, tx, names)
+		if err != nil {
+			return err
+		}
+		m := dao.BlogArticleTags.Ctx(ctx).TX(tx).Where("article_id", articleId)
+		if len(ids) > 0 {
+			m = m.WhereNotIn("tag_id", ids)
+		}
+		if _, err = m.Delete(); err != nil {
+			return gerror.Wrap(err, "清理文章标签失败")
+		}
+		for _, tagId := range ids {
+			if _, err = tx.Exec(`INSERT INTO blog_article_tags (article_id, tag_id, created_at) VALUES (?, ?, NOW())
+				ON CONFLICT (article_id, tag_id) DO NOTHING`, articleId, tagId); err != nil {
+				return gerror.Wrap(err, "保存文章标签失败")
+			}
+		}
+		return nil
+	})
+}
+
+// ensureTags 按名称（忽略大小写）或 slug 查找标签，不存在时自动创建，返回去重后的标签ID
+func (s *sBlogTag) ensureTags(ctx context.Context, tx gdb.TX, names []string) ([]int64, error) {
+	var (
+		ids  []int64
+		seen = make(map[int64]bool)
+	)
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		var tag *entity.BlogTags
+		err := dao.BlogTags.Ctx(ctx).TX(tx).
+			Where("LOWER(name) = LOWER(?) OR slug = ?", name, strings.ToLower(name)).
+			Limit(1).
+			Scan(&tag)
+		if err != nil {
+			return nil, gerror.Wrap(err, "查询标签失败")
+		}
+		if tag == nil {
+			if tag, err = s.create(ctx, tx, &TagInput{Name: name}); err != nil {
+				return nil, err
+			}
+		}
+		if !seen[tag.Id] {
+			seen[tag.Id] = true
+			ids = append(ids, tag.Id)
+		}
+	}
+	return ids, nil
+}
+
+// Cloud 标签云：权重按文章数取对数后线性映射到 1..levels，避免热门标签过度放大
+func (s *sBlogTag) Cloud(ctx context.Context, limit, levels int) ([]*TagCloudItem, error) {
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if levels <= 1 {
+		levels = 5
+	}
+	var tags []*entity.BlogTags
+	err := dao.BlogTags.Ctx(ctx).
+		Where("is_active", true).
+		Where("article_count > 0").
+		Order("article_count DESC, name ASC").
+		Limit(limit).
+		Scan(&tags)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询标签云失败")
+	}
+	if len(tags) == 0 {
+		return []*TagCloudItem{}, nil
+	}
+
+	minCount, maxCount := tags[len(tags)-1].ArticleCount, tags[0].ArticleCount
+	lo, hi := math.Log(float64(minCount)), math.Log(float64(maxCount))
+	items := make([]*TagCloudItem, 0, len(tags))
+	for _, t := range tags {
+		weight := (levels + 1) / 2
+		if hi > lo {
+			weight = 1 + int(math.Round((math.Log(float64(t.ArticleCount))-lo)/(hi-lo)*float64(levels-1)))
+		}
+		items = append(items, &TagCloudItem{Tag: t, Weight: weight})
+	}
+	// 标签云按名称展示
+	sort.Slice(items, func(i, j int) bool { return items[i].Tag.Name < items[j].Tag.Name })
+	return items, nil
+}
+
+// resolveSlug 校验或生成标签 slug
+func (s *sBlogTag) resolveSlug(ctx context.Context, tx gdb.TX, slug, name string, excludeId int64) (string, error) {
+	return resolveSlug(func() *gdb.Model { return dao.BlogTags.Ctx(ctx).TX(tx) }, slug, name, "tag", tagSlugMaxLen, excludeId)
+}
+
+func normalizeTagColor(color string) (string, error) {
+	color = strings.TrimSpace(color)
+	if color == "" {
+		return tagDefaultColor, nil
+	}
+	if !tagColorPattern.MatchString(color) {
+		return "", gerror.New("标签颜色格式应为 #RRGGBB")
+	}
+	return strings.ToUpper(color), nil
+}
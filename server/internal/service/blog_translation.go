@@ -0,0 +1,546 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	v1 "server/api/blog/v1"
+	"server/internal/dao"
+	"server/internal/model/do"
+	"server/internal/model/entity"
+	"server/internal/service/auth"
+	"server/internal/service/configcache"
+	"server/internal/service/pinyin"
+)
+
+// 翻译状态
+const (
+	TranslationStatusDraft     = "draft"
+	TranslationStatusPublished = "published"
+)
+
+// 请求的语言没有对应版本时的处理方式（配置 translation_fallback）
+const (
+	// translationFallbackOriginal 返回原文
+	translationFallbackOriginal = "original"
+	// translationFallbackNone 视为不存在：详情返回 404，列表中排除
+	translationFallbackNone = "none"
+)
+
+// languageTagPattern 规范化后的语言标签：2-3 位主标签，后接若干子标签
+var languageTagPattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// NormalizeLanguage 规范化 BCP 47 语言标签：主标签小写、地区大写、书写系统首字母大写（zh_hant_tw → zh-Hant-TW），
+// 无法识别时返回空字符串
+func NormalizeLanguage(lang string) string {
+	lang = strings.TrimSpace(strings.ReplaceAll(lang, "_", "-"))
+	if lang == "" {
+		return ""
+	}
+	parts := strings.Split(lang, "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		switch p := parts[i]; len(p) {
+		case 2:
+			parts[i] = strings.ToUpper(p)
+		case 4:
+			parts[i] = strings.ToUpper(p[:1]) + strings.ToLower(p[1:])
+		default:
+			parts[i] = strings.ToLower(p)
+		}
+	}
+	lang = strings.Join(parts, "-")
+	if !languageTagPattern.MatchString(lang) {
+		return ""
+	}
+	return lang
+}
+
+// primaryLanguage 语言标签的主标签（zh-CN → zh）
+func primaryLanguage(lang string) string {
+	if i := strings.IndexByte(lang, '-'); i > 0 {
+		return lang[:i]
+	}
+	return lang
+}
+
+// siteLanguage 站点默认语言（规范化后）
+func siteLanguage(ctx context.Context) string {
+	return NormalizeLanguage(LoadSiteInfo(ctx).Language)
+}
+
+// ArticleLanguage 文章原文语言，未设置时为站点默认语言
+func ArticleLanguage(ctx context.Context, article *entity.BlogArticles) string {
+	return firstNonEmpty(article.Language, siteLanguage(ctx))
+}
+
+// ValidateArticleLanguage 校验并规范化文章或翻译使用的语言；配置了 site_languages 时只允许其中的语言
+func ValidateArticleLanguage(ctx context.Context, lang string) (string, error) {
+	normalized := NormalizeLanguage(lang)
+	if normalized == "" {
+		return "", gerror.NewCode(gcode.CodeInvalidParameter, "语言标签无效")
+	}
+	allowed := configcache.GetStrings(ctx, blogConfigNamespace, blogConfigEnv, "site_languages")
+	if len(allowed) == 0 {
+		return normalized, nil
+	}
+	for _, a := range allowed {
+		if NormalizeLanguage(a) == normalized {
+			return normalized, nil
+		}
+	}
+	return "", gerror.NewCodef(gcode.CodeInvalidParameter, "站点未启用语言: %s", normalized)
+}
+
+// translationFallback 请求的语言缺失时的处理方式
+func translationFallback(ctx context.Context) string {
+	if strings.TrimSpace(configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "translation_fallback", translationFallbackOriginal)) == translationFallbackNone {
+		return translationFallbackNone
+	}
+	return translationFallbackOriginal
+}
+
+// TranslationInput 保存翻译的参数
+type TranslationInput struct {
+	ArticleId int64
+	Language  string
+	Title     string
+	Slug      string // 指定时按 Slugify 规范化；为空时沿用已有翻译的 slug，新翻译根据标题生成
+	Summary   string // 为空时从正文生成
+	Content   string
+	Status    string
+	SEO       *v1.SEOInput
+}
+
+// ArticleAlternate 文章的一个语言版本
+type ArticleAlternate struct {
+	Language string
+	Slug     string
+	Title    string
+	Original bool // 是否为原文
+}
+
+// ApplyTranslation 返回叠加了翻译标题、slug、摘要、正文与语言的文章副本；tr 为 nil 时返回原文章
+func ApplyTranslation(article *entity.BlogArticles, tr *entity.BlogArticleTranslations) *entity.BlogArticles {
+	if tr == nil {
+		return article
+	}
+	localized := *article
+	localized.Title = tr.Title
+	localized.Slug = tr.Slug
+	localized.Summary = tr.Summary
+	localized.Content = tr.Content
+	localized.HtmlContent = tr.HtmlContent
+	localized.Language = tr.Language
+	if tr.UpdatedAt != nil && (localized.UpdatedAt == nil || tr.UpdatedAt.After(localized.UpdatedAt)) {
+		localized.UpdatedAt = tr.UpdatedAt
+	}
+	return &localized
+}
+
+// IBlogTranslation 文章多语言翻译服务接口
+type IBlogTranslation interface {
+	// Save 新建或更新文章在指定语言下的翻译（按文章ID与语言唯一）
+	Save(ctx context.Context, in *TranslationInput) (*entity.BlogArticleTranslations, error)
+	// Delete 删除文章在指定语言下的翻译
+	Delete(ctx context.Context, articleId int64, language string) error
+	// List 文章的全部翻译（包括草稿），按语言排序
+	List(ctx context.Context, articleId int64) ([]*entity.BlogArticleTranslations, error)
+	// GetBySlug 按翻译 slug 查询文章与翻译；未发布的翻译仅对已登录用户可见
+	GetBySlug(ctx context.Context, slug string) (*entity.BlogArticles, *entity.BlogArticleTranslations, error)
+	// Select 为文章选择与 lang 最匹配的翻译：先精确匹配，再按主语言匹配；返回 nil 表示使用原文，
+	// 没有匹配版本且回退方式为 none 时返回 CodeNotFound
+	Select(ctx context.Context, article *entity.BlogArticles, lang string) (*entity.BlogArticleTranslations, error)
+	// Localize 将列表中的文章替换为 lang 对应的已发布翻译，没有翻译的保持原文
+	Localize(ctx context.Context, articles []*entity.BlogArticles, lang string) ([]*entity.BlogArticles, error)
+	// Alternates 批量查询文章的全部语言版本（原文在前），只有原文的文章不出现在结果中
+	Alternates(ctx context.Context, articleIds []int64, includeDrafts bool) (map[int64][]*ArticleAlternate, error)
+	// WhereLanguage 在文章查询上限定存在 lang 主语言版本（原文或已发布翻译）的文章
+	WhereLanguage(ctx context.Context, m *gdb.Model, lang string) *gdb.Model
+	// FilterLanguage 回退方式为 none 时按 WhereLanguage 过滤，否则原样返回
+	FilterLanguage(ctx context.Context, m *gdb.Model, lang string) *gdb.Model
+}
+
+type sBlogTranslation struct{}
+
+// BlogTranslation 文章多语言翻译服务实例
+func BlogTranslation() IBlogTranslation {
+	return &sBlogTranslation{}
+}
+
+// Save 保存翻译
+func (s *sBlogTranslation) Save(ctx context.Context, in *TranslationInput) (*entity.BlogArticleTranslations, error) {
+	article, err := s.article(ctx, in.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+	if err = CheckOwnership(ctx, article.AuthorId); err != nil {
+		return nil, err
+	}
+
+	lang, err := ValidateArticleLanguage(ctx, in.Language)
+	if err != nil {
+		return nil, err
+	}
+	if lang == ArticleLanguage(ctx, article) {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "翻译语言不能与原文语言相同")
+	}
+
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+		return nil, gerror.New("翻译标题不能为空")
+	}
+	if strings.TrimSpace(in.Content) == "" {
+		return nil, gerror.New("翻译内容不能为空")
+	}
+	status := in.Status
+	if status == "" {
+		status = TranslationStatusDraft
+	}
+	if status != TranslationStatusDraft && status != TranslationStatusPublished {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "翻译状态无效")
+	}
+
+	var existing *entity.BlogArticleTranslations
+	err = dao.BlogArticleTranslations.Ctx(ctx).
+		Where(do.BlogArticleTranslations{ArticleId: article.Id, Language: lang}).
+		Scan(&existing)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询翻译失败")
+	}
+	var excludeId int64
+	if existing != nil {
+		excludeId = existing.Id
+	}
+
+	// 翻译与文章共用 /blog/<slug> 地址，slug 不能与任何文章、其他翻译或文章旧地址重复
+	taken := func(slug string) (bool, error) {
+		return s.slugTaken(ctx, slug, excludeId)
+	}
+	// 指定的 slug 按文章 slug 的规则规范化，只剩下分隔符等无效字符时拒绝
+	slug := Slugify(in.Slug, articleSlugMaxLen)
+	switch {
+	case slug == "" && strings.TrimSpace(in.Slug) != "":
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "URL标识需包含字母、数字或汉字")
+	case slug != "":
+		used, err := taken(slug)
+		if err != nil {
+			return nil, gerror.Wrap(err, "检查URL标识失败")
+		}
+		if used {
+			return nil, gerror.New("URL标识已存在，请更换")
+		}
+	case existing != nil:
+		slug = existing.Slug
+	default:
+		base := Slugify(pinyin.Romanize(title, "-"), articleSlugMaxLen)
+		if slug, err = uniqueSlugFunc(base, primaryLanguage(lang), articleSlugMaxLen, taken); err != nil {
+			return nil, err
+		}
+	}
+
+	summary := strings.TrimSpace(in.Summary)
+	if summary == "" {
+		summary = BlogSimple().generateSummary(in.Content, 200)
+	}
+	seo := in.SEO
+	if seo == nil {
+		seo = &v1.SEOInput{}
+	}
+	_, err = dao.BlogArticleTranslations.Ctx(ctx).Data(do.BlogArticleTranslations{
+		ArticleId:          article.Id,
+		Language:           lang,
+		Title:              title,
+		Slug:               slug,
+		Summary:            summary,
+		Content:            in.Content,
+		HtmlContent:        BlogSimple().processMarkdown(in.Content),
+		Status:             status,
+		MetaTitle:          strings.TrimSpace(seo.MetaTitle),
+		MetaDescription:    strings.TrimSpace(seo.MetaDescription),
+		MetaKeywords:       strings.TrimSpace(seo.MetaKeywords),
+		OgTitle:            strings.TrimSpace(seo.OGTitle),
+		OgDescription:      strings.TrimSpace(seo.OGDescription),
+		OgImage:            strings.TrimSpace(seo.OGImage),
+		TwitterTitle:       strings.TrimSpace(seo.TwitterTitle),
+		TwitterDescription: strings.TrimSpace(seo.TwitterDesc),
+		TwitterImage:       strings.TrimSpace(seo.TwitterImage),
+		CanonicalUrl:       strings.TrimSpace(seo.CanonicalURL),
+		UpdatedAt:          gtime.Now(),
+	}).OnConflict("article_id", "language").Save()
+	if err != nil {
+		return nil, gerror.Wrap(err, "保存翻译失败")
+	}
+
+	InvalidateContentCaches()
+
+	var saved *entity.BlogArticleTranslations
+	err = dao.BlogArticleTranslations.Ctx(ctx).
+		Where(do.BlogArticleTranslations{ArticleId: article.Id, Language: lang}).
+		Scan(&saved)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询翻译失败")
+	}
+
+	g.Log().Info(ctx, "BlogTranslation.Save", "articleId", article.Id, "language", lang, "status", status)
+	return saved, nil
+}
+
+// Delete 删除翻译
+func (s *sBlogTranslation) Delete(ctx context.Context, articleId int64, language string) error {
+	lang := NormalizeLanguage(language)
+	if lang == "" {
+		return gerror.NewCode(gcode.CodeInvalidParameter, "语言标签无效")
+	}
+	if err := checkArticleOwnership(ctx, articleId); err != nil {
+		return err
+	}
+	res, err := dao.BlogArticleTranslations.Ctx(ctx).
+		Where(do.BlogArticleTranslations{ArticleId: articleId, Language: lang}).
+		Delete()
+	if err != nil {
+		return gerror.Wrap(err, "删除翻译失败")
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return gerror.NewCode(gcode.CodeNotFound, "翻译不存在")
+	}
+
+	InvalidateContentCaches()
+	return nil
+}
+
+// List 文章的全部翻译
+func (s *sBlogTranslation) List(ctx context.Context, articleId int64) ([]*entity.BlogArticleTranslations, error) {
+	if _, err := s.article(ctx, articleId); err != nil {
+		return nil, err
+	}
+	var list []*entity.BlogArticleTranslations
+	err := dao.BlogArticleTranslations.Ctx(ctx).
+		Where(dao.BlogArticleTranslations.Columns().ArticleId, articleId).
+		Order("language ASC").
+		Scan(&list)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询翻译列表失败")
+	}
+	return list, nil
+}
+
+// GetBySlug 按翻译 slug 查询
+func (s *sBlogTranslation) GetBySlug(ctx context.Context, slug string) (*entity.BlogArticles, *entity.BlogArticleTranslations, error) {
+	var tr *entity.BlogArticleTranslations
+	if err := dao.BlogArticleTranslations.Ctx(ctx).Where("slug", slug).Scan(&tr); err != nil {
+		return nil, nil, gerror.Wrap(err, "查询翻译失败")
+	}
+	if tr == nil || (tr.Status != TranslationStatusPublished && !auth.IsAuthenticated(ctx)) {
+		return nil, nil, gerror.NewCode(gcode.CodeNotFound, "文章不存在")
+	}
+	article, err := s.article(ctx, tr.ArticleId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return article, tr, nil
+}
+
+// Select 选择翻译
+func (s *sBlogTranslation) Select(ctx context.Context, article *entity.BlogArticles, lang string) (*entity.BlogArticleTranslations, error) {
+	lang = NormalizeLanguage(lang)
+	original := ArticleLanguage(ctx, article)
+	if lang == "" || lang == original {
+		return nil, nil
+	}
+
+	m := dao.BlogArticleTranslations.Ctx(ctx).Where(dao.BlogArticleTranslations.Columns().ArticleId, article.Id)
+	if !auth.IsAuthenticated(ctx) {
+		m = m.Where("status", TranslationStatusPublished)
+	}
+	var list []*entity.BlogArticleTranslations
+	if err := m.Order("language ASC").Scan(&list); err != nil {
+		return nil, gerror.Wrap(err, "查询翻译失败")
+	}
+	for _, tr := range list {
+		if tr.Language == lang {
+			return tr, nil
+		}
+	}
+
+	// 没有精确匹配时按主语言匹配，原文优先（请求 zh-TW 而原文为 zh-CN 时返回原文）
+	primary := primaryLanguage(lang)
+	if primaryLanguage(original) == primary {
+		return nil, nil
+	}
+	for _, tr := range list {
+		if primaryLanguage(tr.Language) == primary {
+			return tr, nil
+		}
+	}
+
+	if translationFallback(ctx) == translationFallbackNone {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "文章没有该语言的版本")
+	}
+	return nil, nil
+}
+
+// Localize 批量替换为翻译
+func (s *sBlogTranslation) Localize(ctx context.Context, articles []*entity.BlogArticles, lang string) ([]*entity.BlogArticles, error) {
+	lang = NormalizeLanguage(lang)
+	if lang == "" || len(articles) == 0 {
+		return articles, nil
+	}
+	primary := primaryLanguage(lang)
+	site := siteLanguage(ctx)
+
+	ids := make([]int64, 0, len(articles))
+	for _, a := range articles {
+		if a.Language != lang && primaryLanguage(firstNonEmpty(a.Language, site)) != primary {
+			ids = append(ids, a.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return articles, nil
+	}
+
+	var list []*entity.BlogArticleTranslations
+	err := dao.BlogArticleTranslations.Ctx(ctx).
+		WhereIn(dao.BlogArticleTranslations.Columns().ArticleId, ids).
+		Where("status", TranslationStatusPublished).
+		Where("split_part(language, '-', 1) = ?", primary).
+		Order("language ASC").
+		Scan(&list)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询翻译失败")
+	}
+	// 每篇文章优先取精确匹配的翻译，其次取同一主语言的第一个翻译
+	best := make(map[int64]*entity.BlogArticleTranslations, len(list))
+	for _, tr := range list {
+		if cur, ok := best[tr.ArticleId]; !ok || (tr.Language == lang && cur.Language != lang) {
+			best[tr.ArticleId] = tr
+		}
+	}
+
+	out := make([]*entity.BlogArticles, len(articles))
+	for i, a := range articles {
+		out[i] = ApplyTranslation(a, best[a.Id])
+	}
+	return out, nil
+}
+
+// Alternates 批量查询语言版本
+func (s *sBlogTranslation) Alternates(ctx context.Context, articleIds []int64, includeDrafts bool) (map[int64][]*ArticleAlternate, error) {
+	out := make(map[int64][]*ArticleAlternate)
+	if len(articleIds) == 0 {
+		return out, nil
+	}
+	m := dao.BlogArticleTranslations.Ctx(ctx).
+		Fields("article_id, language, slug, title").
+		WhereIn(dao.BlogArticleTranslations.Columns().ArticleId, articleIds)
+	if !includeDrafts {
+		m = m.Where("status", TranslationStatusPublished)
+	}
+	var list []*entity.BlogArticleTranslations
+	if err := m.Order("article_id ASC, language ASC").Scan(&list); err != nil {
+		return nil, gerror.Wrap(err, "查询翻译失败")
+	}
+	if len(list) == 0 {
+		return out, nil
+	}
+
+	translated := make([]int64, 0, len(list))
+	for _, tr := range list {
+		if _, ok := out[tr.ArticleId]; !ok {
+			out[tr.ArticleId] = nil
+			translated = append(translated, tr.ArticleId)
+		}
+	}
+	var originals []*entity.BlogArticles
+	err := dao.BlogArticles.Ctx(ctx).
+		Fields("id, language, slug, title").
+		WhereIn("id", translated).
+		Scan(&originals)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询文章失败")
+	}
+	site := siteLanguage(ctx)
+	for _, a := range originals {
+		out[a.Id] = append(out[a.Id], &ArticleAlternate{
+			Language: firstNonEmpty(a.Language, site),
+			Slug:     a.Slug,
+			Title:    a.Title,
+			Original: true,
+		})
+	}
+	for _, tr := range list {
+		out[tr.ArticleId] = append(out[tr.ArticleId], &ArticleAlternate{
+			Language: tr.Language,
+			Slug:     tr.Slug,
+			Title:    tr.Title,
+		})
+	}
+	for id, alts := range out {
+		// 原文已被删除的翻译不单独输出
+		if len(alts) < 2 || !alts[0].Original {
+			delete(out, id)
+		}
+	}
+	return out, nil
+}
+
+// WhereLanguage 按语言过滤文章
+func (s *sBlogTranslation) WhereLanguage(ctx context.Context, m *gdb.Model, lang string) *gdb.Model {
+	lang = NormalizeLanguage(lang)
+	if lang == "" {
+		return m
+	}
+	primary := primaryLanguage(lang)
+	return m.Where(`(split_part(COALESCE(NULLIF(blog_articles.language, ''), ?), '-', 1) = ? OR EXISTS (
+		SELECT 1 FROM blog_article_translations bat
+		WHERE bat.article_id = blog_articles.id AND bat.status = ? AND split_part(bat.language, '-', 1) = ?))`,
+		siteLanguage(ctx), primary, TranslationStatusPublished, primary)
+}
+
+// FilterLanguage 按回退方式过滤文章
+func (s *sBlogTranslation) FilterLanguage(ctx context.Context, m *gdb.Model, lang string) *gdb.Model {
+	if translationFallback(ctx) != translationFallbackNone {
+		return m
+	}
+	return s.WhereLanguage(ctx, m, lang)
+}
+
+// article 查询未删除的文章
+func (s *sBlogTranslation) article(ctx context.Context, id int64) (*entity.BlogArticles, error) {
+	var article *entity.BlogArticles
+	err := dao.BlogArticles.Ctx(ctx).
+		FieldsEx(dao.BlogArticles.Columns().SearchVector).
+		Where("id", id).
+		WhereNull("deleted_at").
+		Scan(&article)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询文章失败")
+	}
+	if article == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "文章不存在")
+	}
+	return article, nil
+}
+
+// slugTaken slug 是否已被文章（包括回收站中的）、其他翻译或文章旧地址占用
+func (s *sBlogTranslation) slugTaken(ctx context.Context, slug string, excludeId int64) (bool, error) {
+	if n, err := dao.BlogArticles.Ctx(ctx).Where("slug", slug).Count(); err != nil || n > 0 {
+		return n > 0, err
+	}
+	if n, err := dao.BlogArticleSlugRedirects.Ctx(ctx).Where("old_slug", slug).Count(); err != nil || n > 0 {
+		return n > 0, err
+	}
+	q := dao.BlogArticleTranslations.Ctx(ctx).Where("slug", slug)
+	if excludeId > 0 {
+		q = q.WhereNot("id", excludeId)
+	}
+	n, err := q.Count()
+	return n > 0, err
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+	"server/internal/service/auth"
+	"server/internal/service/configcache"
+)
+
+// fileURLPattern 匹配本站文件服务地址中的文件UUID（下载、缩略图、信息接口）
+var fileURLPattern = regexp.MustCompile(`/file/(?:download|thumbnail|info)/([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})`)
+
+// trashPurgeBatchSize 单次清理的最大文章数，剩余的在下一轮处理
+const trashPurgeBatchSize = 100
+
+// TrashItem 回收站文章
+type TrashItem struct {
+	Article *entity.BlogArticles
+	// PurgeAt 预计被永久删除的时间
+	PurgeAt *gtime.Time
+}
+
+// IBlogTrash 博客回收站服务接口
+type IBlogTrash interface {
+	// List 按删除时间倒序分页查询回收站中的文章
+	List(ctx context.Context, page, size int) (items []*TrashItem, total int, err error)
+	// Restore 从回收站恢复文章，并记录恢复版本
+	Restore(ctx context.Context, id int64) error
+	// Purge 立即永久删除回收站中的指定文章
+	Purge(ctx context.Context, id int64) error
+	// PurgeExpired 永久删除超过保留期的文章，返回被删除的文章ID
+	PurgeExpired(ctx context.Context) (ids []int64, err error)
+	// RetentionDays 回收站保留天数
+	RetentionDays(ctx context.Context) int
+}
+
+type sBlogTrash struct{}
+
+// BlogTrash 博客回收站服务实例
+func BlogTrash() IBlogTrash {
+	return &sBlogTrash{}
+}
+
+// RetentionDays 回收站保留天数，由 blog/default trash_retention_days 配置
+func (s *sBlogTrash) RetentionDays(ctx context.Context) int {
+	days := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "trash_retention_days", 30)
+	if days < 1 {
+		days = 1
+	}
+	return days
+}
+
+// List 回收站列表
+func (s *sBlogTrash) List(ctx context.Context, page, size int) (items []*TrashItem, total int, err error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 10
+	}
+	m := dao.BlogArticles.Ctx(ctx).WhereNotNull("deleted_at")
+	// 作者只能看到自己删除的文章
+	if !auth.HasRole(ctx, auth.RoleEditor) {
+		m = m.Where("author_id", auth.CurrentUserID(ctx))
+	}
+	total, err = m.Count()
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询回收站文章总数失败")
+	}
+	var articles []*entity.BlogArticles
+	err = m.FieldsEx(dao.BlogArticles.Columns().SearchVector, dao.BlogArticles.Columns().Content, dao.BlogArticles.Columns().HtmlContent).
+		Order("deleted_at DESC, id DESC").
+		Limit((page-1)*size, size).
+		Scan(&articles)
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询回收站文章失败")
+	}
+
+	days := s.RetentionDays(ctx)
+	items = make([]*TrashItem, 0, len(articles))
+	for _, a := range articles {
+		item := &TrashItem{Article: a}
+		if a.DeletedAt != nil {
+			item.PurgeAt = a.DeletedAt.AddDate(0, 0, days)
+		}
+		items = append(items, item)
+	}
+	return items, total, nil
+}
+
+// Restore 恢复文章
+func (s *sBlogTrash) Restore(ctx context.Context, id int64) error {
+	if err := checkArticleOwnership(ctx, id); err != nil {
+		return err
+	}
+	err := dao.BlogArticles.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		res, err := dao.BlogArticles.Ctx(ctx).TX(tx).
+			Where("id", id).
+			WhereNotNull("deleted_at").
+			Data(g.Map{"deleted_at": nil, "updated_at": gtime.Now()}).
+			Update()
+		if err != nil {
+			return gerror.Wrap(err, "恢复文章失败")
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return gerror.NewCode(gcode.CodeNotFound, "回收站中不存在该文章")
+		}
+		if _, err = (&sBlogVersion{}).record(ctx, tx, id, VersionChangeRestore, "从回收站恢复"); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	InvalidateContentCaches()
+	g.Log().Info(ctx, "BlogTrash.Restore", "id", id)
+	return nil
+}
+
+// Purge 永久删除指定文章
+func (s *sBlogTrash) Purge(ctx context.Context, id int64) error {
+	if err := checkArticleOwnership(ctx, id); err != nil {
+		return err
+	}
+	ids, err := s.purge(ctx, dao.BlogArticles.Ctx(ctx).Where("id", id).WhereNotNull("deleted_at"))
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return gerror.NewCode(gcode.CodeNotFound, "回收站中不存在该文章")
+	}
+	return nil
+}
+
+// PurgeExpired 清理过期文章
+func (s *sBlogTrash) PurgeExpired(ctx context.Context) (ids []int64, err error) {
+	cutoff := gtime.Now().AddDate(0, 0, -s.RetentionDays(ctx))
+	return s.purge(ctx, dao.BlogArticles.Ctx(ctx).
+		WhereNotNull("deleted_at").
+		WhereLT("deleted_at", cutoff).
+		Order("deleted_at ASC").
+		Limit(trashPurgeBatchSize))
+}
+
+// purge 物理删除 m 选中的文章；版本、评论、标签关联、SEO 数据与译文随外键级联删除，
+// 标签与分类计数由触发器维护。删除后释放文章及其译文中不再被引用的图片与分享卡片。
+func (s *sBlogTrash) purge(ctx context.Context, m *gdb.Model) (ids []int64, err error) {
+	var articles []*entity.BlogArticles
+	if err = m.Fields("id", "featured_image", "content").Scan(&articles); err != nil {
+		return nil, gerror.Wrap(err, "查询待删除文章失败")
+	}
+	if len(articles) == 0 {
+		return nil, nil
+	}
+
+	images := make(map[string]bool)
+	for _, a := range articles {
+		ids = append(ids, a.Id)
+		if uuid := fileUUIDFromURL(a.FeaturedImage); uuid != "" {
+			images[uuid] = true
+		}
+		for _, match := range fileURLPattern.FindAllStringSubmatch(a.Content, -1) {
+			images[match[1]] = true
+		}
+	}
+	var seoRows []*entity.BlogSeoData
+	if err = dao.BlogSeoData.Ctx(ctx).Fields("og_image", "twitter_image").WhereIn("article_id", ids).Scan(&seoRows); err != nil {
+		return nil, gerror.Wrap(err, "查询文章SEO数据失败")
+	}
+	for _, row := range seoRows {
+		for _, u := range []string{row.OgImage, row.TwitterImage} {
+			if uuid := fileUUIDFromURL(u); uuid != "" {
+				images[uuid] = true
+			}
+		}
+	}
+	var translations []*entity.BlogArticleTranslations
+	if err = dao.BlogArticleTranslations.Ctx(ctx).Fields("content", "og_image", "twitter_image").WhereIn("article_id", ids).Scan(&translations); err != nil {
+		return nil, gerror.Wrap(err, "查询文章译文失败")
+	}
+	for _, row := range translations {
+		for _, u := range []string{row.OgImage, row.TwitterImage} {
+			if uuid := fileUUIDFromURL(u); uuid != "" {
+				images[uuid] = true
+			}
+		}
+		for _, match := range fileURLPattern.FindAllStringSubmatch(row.Content, -1) {
+			images[match[1]] = true
+		}
+	}
+	shareImages, err := dao.ShareImages.Ctx(ctx).
+		Where("subject_type", ShareSubjectArticle).
+		WhereIn("subject_id", ids).
+		Array("file_uuid")
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询文章分享卡片失败")
+	}
+	for _, uuid := range shareImages {
+		images[uuid.String()] = true
+	}
+
+	if _, err = dao.BlogArticles.Ctx(ctx).WhereIn("id", ids).WhereNotNull("deleted_at").Delete(); err != nil {
+		return nil, gerror.Wrap(err, "永久删除文章失败")
+	}
+	if _, err = dao.ShareImages.Ctx(ctx).Where("subject_type", ShareSubjectArticle).WhereIn("subject_id", ids).Delete(); err != nil {
+		return nil, gerror.Wrap(err, "删除文章分享卡片失败")
+	}
+	InvalidateContentCaches()
+	g.Log().Infof(ctx, "永久删除回收站文章 %d 篇: %v", len(ids), ids)
+
+	for uuid := range images {
+		if err := releaseUnreferencedFile(ctx, uuid); err != nil {
+			g.Log().Warningf(ctx, "释放文章图片失败: uuid=%s err=%v", uuid, err)
+		}
+	}
+	return ids, nil
+}
+
+// releaseUnreferencedFile 文件不再被任何文章、译文、SEO 数据、系列封面、微博或分享卡片引用时，交由文件服务软删除，
+// 之后由文件清理任务按其保留期物理删除
+func releaseUnreferencedFile(ctx context.Context, uuid string) error {
+	var file *entity.Files
+	if err := dao.Files.Ctx(ctx).Fields("id", "file_uuid", "file_status").Where("file_uuid", uuid).Scan(&file); err != nil {
+		return gerror.Wrap(err, "查询文件失败")
+	}
+	if file == nil || file.FileStatus == "deleted" {
+		return nil
+	}
+
+	like := "%" + uuid + "%"
+	checks := []*gdb.Model{
+		dao.BlogArticles.Ctx(ctx).Where("featured_image LIKE ? OR content LIKE ?", like, like),
+		dao.BlogSeoData.Ctx(ctx).Where("og_image LIKE ? OR twitter_image LIKE ?", like, like),
+		dao.BlogArticleTranslations.Ctx(ctx).Where("content LIKE ? OR og_image LIKE ? OR twitter_image LIKE ?", like, like, like),
+		dao.BlogSeries.Ctx(ctx).Where("cover_image LIKE ?", like),
+		dao.WeiboAssets.Ctx(ctx).Where("file_id", file.Id),
+		dao.ShareImages.Ctx(ctx).Where("file_uuid", uuid),
+		dao.Users.Ctx(ctx).Where("avatar_file_uuid", uuid),
+	}
+	for _, m := range checks {
+		n, err := m.Count()
+		if err != nil {
+			return gerror.Wrap(err, "检查文件引用失败")
+		}
+		if n > 0 {
+			return nil
+		}
+	}
+	if err := File().DeleteFile(ctx, uuid); err != nil {
+		return err
+	}
+	g.Log().Infof(ctx, "已释放未被引用的文章图片: %s", uuid)
+	return nil
+}
+
+// fileUUIDFromURL 提取本站文件地址中的文件UUID，非本站文件返回空串
+func fileUUIDFromURL(u string) string {
+	if match := fileURLPattern.FindStringSubmatch(u); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// StartTrashPurger 启动回收站清理调度器，每小时清理一次超过保留期的文章
+func StartTrashPurger(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				g.Log().Info(ctx, "回收站清理调度器已停止")
+				return
+			case <-ticker.C:
+				for {
+					ids, err := BlogTrash().PurgeExpired(ctx)
+					if err != nil {
+						g.Log().Errorf(ctx, "回收站清理失败: %v", err)
+						break
+					}
+					if len(ids) < trashPurgeBatchSize {
+						break
+					}
+				}
+			}
+		}
+	}()
+}
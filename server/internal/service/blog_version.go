@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"server/internal/dao"
+	"server/internal/model/do"
+	"server/internal/model/entity"
+	"server/internal/service/auth"
+	"server/internal/service/textdiff"
+)
+
+// 文章版本变更类型
+const (
+	VersionChangeCreate  = "create"
+	VersionChangeUpdate  = "update"
+	VersionChangeDelete  = "delete"
+	VersionChangeRestore = "restore"
+)
+
+// VersionMeta 版本中保存的文章元数据快照
+type VersionMeta struct {
+	Slug          string `json:"slug"`
+	CategoryId    int64  `json:"categoryId"`
+	Status        string `json:"status"`
+	FeaturedImage string `json:"featuredImage"`
+}
+
+// VersionDiffData 写入 diff_data 的内容：与上一版本的行级增删统计及元数据快照
+type VersionDiffData struct {
+	Added   int         `json:"added"`
+	Removed int         `json:"removed"`
+	Meta    VersionMeta `json:"meta"`
+}
+
+// VersionDiff 两个版本之间的差异
+type VersionDiff struct {
+	From     *entity.BlogArticleVersions
+	To       *entity.BlogArticleVersions
+	Lines    []textdiff.Line
+	Stats    textdiff.Stats
+	TitleOld string
+	TitleNew string
+}
+
+// IBlogVersion 文章版本历史服务接口
+type IBlogVersion interface {
+	// Record 以文章当前内容记录一个新版本，返回版本号
+	Record(ctx context.Context, articleId int64, changeType, changeSummary string) (version int, err error)
+	// List 分页查询文章版本（按版本号倒序）
+	List(ctx context.Context, articleId int64, page, size int) (items []*entity.BlogArticleVersions, total int, err error)
+	// Get 查询指定版本
+	Get(ctx context.Context, articleId int64, version int) (*entity.BlogArticleVersions, error)
+	// Diff 比较两个版本正文的行级差异
+	Diff(ctx context.Context, articleId int64, from, to int) (*VersionDiff, error)
+	// Restore 将文章恢复为指定版本的内容，并记录为新的版本
+	Restore(ctx context.Context, articleId int64, version int, changeSummary string) (newVersion int, err error)
+}
+
+type sBlogVersion struct{}
+
+// BlogVersion 文章版本历史服务实例
+func BlogVersion() IBlogVersion {
+	return &sBlogVersion{}
+}
+
+// Record 记录版本
+func (s *sBlogVersion) Record(ctx context.Context, articleId int64, changeType, changeSummary string) (version int, err error) {
+	err = dao.BlogArticleVersions.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		version, err = s.record(ctx, tx, articleId, changeType, changeSummary)
+		return err
+	})
+	return version, err
+}
+
+// record 在事务内锁定文章行后写入版本，保证同一文章的版本号连续且不重复
+func (s *sBlogVersion) record(ctx context.Context, tx gdb.TX, articleId int64, changeType, changeSummary string) (int, error) {
+	var article *entity.BlogArticles
+	err := dao.BlogArticles.Ctx(ctx).TX(tx).
+		FieldsEx(dao.BlogArticles.Columns().SearchVector).
+		Where(dao.BlogArticles.Columns().Id, articleId).
+		LockUpdate().
+		Scan(&article)
+	if err != nil {
+		return 0, gerror.Wrap(err, "查询文章失败")
+	}
+	if article == nil {
+		return 0, gerror.New("文章不存在")
+	}
+
+	var prev *entity.BlogArticleVersions
+	err = dao.BlogArticleVersions.Ctx(ctx).TX(tx).
+		Where(dao.BlogArticleVersions.Columns().ArticleId, articleId).
+		OrderDesc(dao.BlogArticleVersions.Columns().Version).
+		Limit(1).
+		Scan(&prev)
+	if err != nil {
+		return 0, gerror.Wrap(err, "查询历史版本失败")
+	}
+
+	version := 1
+	prevContent := ""
+	if prev != nil {
+		version = prev.Version + 1
+		prevContent = prev.Content
+	}
+	stats := textdiff.Count(prevContent, article.Content)
+	diffData, _ := json.Marshal(VersionDiffData{
+		Added:   stats.Added,
+		Removed: stats.Removed,
+		Meta: VersionMeta{
+			Slug:          article.Slug,
+			CategoryId:    article.CategoryId,
+			Status:        article.Status,
+			FeaturedImage: article.FeaturedImage,
+		},
+	})
+
+	data := do.BlogArticleVersions{
+		ArticleId:     articleId,
+		Version:       version,
+		Title:         article.Title,
+		Content:       article.Content,
+		HtmlContent:   article.HtmlContent,
+		Summary:       article.Summary,
+		ChangeType:    changeType,
+		ChangeSummary: changeSummary,
+		DiffData:      string(diffData),
+		CreatedAt:     gtime.Now(),
+	}
+	if operatorId := currentOperatorId(ctx); operatorId > 0 {
+		data.OperatorId = operatorId
+	}
+	if _, err = dao.BlogArticleVersions.Ctx(ctx).TX(tx).Data(data).Insert(); err != nil {
+		return 0, gerror.Wrap(err, "保存文章版本失败")
+	}
+	return version, nil
+}
+
+// List 版本列表
+func (s *sBlogVersion) List(ctx context.Context, articleId int64, page, size int) (items []*entity.BlogArticleVersions, total int, err error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+	cols := dao.BlogArticleVersions.Columns()
+	m := dao.BlogArticleVersions.Ctx(ctx).Where(cols.ArticleId, articleId)
+	total, err = m.Count()
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "统计文章版本失败")
+	}
+	err = m.FieldsEx(cols.Content, cols.HtmlContent).
+		OrderDesc(cols.Version).
+		Limit((page-1)*size, size).
+		Scan(&items)
+	if err != nil {
+		return nil, total, gerror.Wrap(err, "查询文章版本失败")
+	}
+	return items, total, nil
+}
+
+// Get 版本详情
+func (s *sBlogVersion) Get(ctx context.Context, articleId int64, version int) (*entity.BlogArticleVersions, error) {
+	var v *entity.BlogArticleVersions
+	err := dao.BlogArticleVersions.Ctx(ctx).
+		Where(dao.BlogArticleVersions.Columns().ArticleId, articleId).
+		Where(dao.BlogArticleVersions.Columns().Version, version).
+		Scan(&v)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询文章版本失败")
+	}
+	if v == nil {
+		return nil, gerror.Newf("版本 %d 不存在", version)
+	}
+	return v, nil
+}
+
+// Diff 版本差异
+func (s *sBlogVersion) Diff(ctx context.Context, articleId int64, from, to int) (*VersionDiff, error) {
+	fromVer, err := s.Get(ctx, articleId, from)
+	if err != nil {
+		return nil, err
+	}
+	toVer, err := s.Get(ctx, articleId, to)
+	if err != nil {
+		return nil, err
+	}
+	lines := textdiff.Diff(fromVer.Content, toVer.Content)
+	return &VersionDiff{
+		From:     fromVer,
+		To:       toVer,
+		Lines:    lines,
+		Stats:    textdiff.Summarize(lines),
+		TitleOld: fromVer.Title,
+		TitleNew: toVer.Title,
+	}, nil
+}
+
+// Restore 恢复版本
+//
+// 与配置回滚一致：不修改历史，而是把目标版本内容写回文章并产生一个新的版本。
+// 仅恢复标题、正文、摘要，slug、分类、状态保持当前值。
+func (s *sBlogVersion) Restore(ctx context.Context, articleId int64, version int, changeSummary string) (newVersion int, err error) {
+	if err = checkArticleOwnership(ctx, articleId); err != nil {
+		return 0, err
+	}
+	target, err := s.Get(ctx, articleId, version)
+	if err != nil {
+		return 0, err
+	}
+	if changeSummary == "" {
+		changeSummary = fmt.Sprintf("恢复至版本 %d", version)
+	}
+
+	err = dao.BlogArticleVersions.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		affected, err := dao.BlogArticles.Ctx(ctx).TX(tx).
+			Where(dao.BlogArticles.Columns().Id, articleId).
+			WhereNull(dao.BlogArticles.Columns().DeletedAt).
+			Data(g.Map{
+				dao.BlogArticles.Columns().Title:       target.Title,
+				dao.BlogArticles.Columns().Content:     target.Content,
+				dao.BlogArticles.Columns().HtmlContent: target.HtmlContent,
+				dao.BlogArticles.Columns().Summary:     target.Summary,
+				dao.BlogArticles.Columns().UpdatedAt:   gtime.Now(),
+			}).
+			UpdateAndGetAffected()
+		if err != nil {
+			return gerror.Wrap(err, "恢复文章内容失败")
+		}
+		if affected == 0 {
+			return gerror.New("文章不存在或已删除")
+		}
+		newVersion, err = s.record(ctx, tx, articleId, VersionChangeRestore, changeSummary)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	InvalidateContentCaches()
+	return newVersion, nil
+}
+
+// currentOperatorId 当前操作者ID：登录用户的ID，匿名或后台任务为0
+func currentOperatorId(ctx context.Context) int64 {
+	return auth.CurrentUserID(ctx)
+}
@@ -0,0 +1,572 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/text/gstr"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+	"server/internal/service/antispam"
+	"server/internal/service/auth"
+	"server/internal/service/configcache"
+	"server/internal/service/webmention"
+)
+
+// 提及状态
+const (
+	WebmentionStatusPending  = "pending"
+	WebmentionStatusVerified = "verified"
+	WebmentionStatusRejected = "rejected"
+	WebmentionStatusDeleted  = "deleted"
+	WebmentionStatusBlocked  = "blocked"
+)
+
+// 发送记录状态
+const (
+	WebmentionSendPending    = "pending"
+	WebmentionSendSent       = "sent"
+	WebmentionSendFailed     = "failed"
+	WebmentionSendNoEndpoint = "no_endpoint"
+)
+
+// 提及来源协议
+const (
+	ProtocolWebmention = "webmention"
+	ProtocolPingback   = "pingback"
+)
+
+const (
+	// webmentionBatchSize 每轮校验、扫描、发送的最大数量
+	webmentionBatchSize = 20
+	// webmentionClaimTimeout 认领后未完成的任务超过该时间重新处理
+	webmentionClaimTimeout = 10 * time.Minute
+	// webmentionContentMaxLen 提及内容摘录的最大字数
+	webmentionContentMaxLen = 1000
+)
+
+var (
+	// webmentionReceiveLimiter 按 IP 限制接收频率
+	webmentionReceiveLimiter = antispam.NewLimiter()
+
+	webmentionClientMu sync.RWMutex
+	webmentionClient   webmention.Doer
+)
+
+// SetWebmentionClient 替换抓取来源、发现端点和发送通知使用的 HTTP 客户端（例如指向本地桩服务），传 nil 恢复默认客户端
+func SetWebmentionClient(client webmention.Doer) {
+	webmentionClientMu.Lock()
+	defer webmentionClientMu.Unlock()
+	webmentionClient = client
+}
+
+// webmentionHTTP 当前使用的客户端；未注入时按配置创建默认客户端（默认拒绝访问内网地址）
+func webmentionHTTP(ctx context.Context) webmention.Doer {
+	webmentionClientMu.RLock()
+	client := webmentionClient
+	webmentionClientMu.RUnlock()
+	if client != nil {
+		return client
+	}
+	timeout := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "webmention_timeout_seconds", 10)
+	allowPrivate := configcache.GetBool(ctx, blogConfigNamespace, blogConfigEnv, "webmention_allow_private", false)
+	return webmention.NewHTTPClient(time.Duration(timeout)*time.Second, allowPrivate)
+}
+
+// IBlogWebmention Webmention 与 Pingback 服务接口
+type IBlogWebmention interface {
+	// Receive 接收通知：校验地址并以待校验状态入库，来源页面由后台任务异步抓取校验
+	Receive(ctx context.Context, source, target, protocol string) error
+	// List 文章下的提及；匿名访客只能查看公开文章已校验的提及
+	List(ctx context.Context, articleId int64, status, mentionType string, page, size int) ([]*entity.BlogWebmentions, int, error)
+	// Counts 文章已校验提及按类型计数
+	Counts(ctx context.Context, articleId int64) (map[string]int, error)
+	// Block 屏蔽提及，来源再次通知也不会重新展示
+	Block(ctx context.Context, id int64) error
+	// Sends 文章的发送记录
+	Sends(ctx context.Context, articleId int64) ([]*entity.BlogWebmentionSends, error)
+	// VerifyDue 校验到期的待校验提及，返回本轮处理数量
+	VerifyDue(ctx context.Context) (int, error)
+	// ScanArticles 扫描最近发布或更新的文章中的外部链接，写入待发送通知，返回扫描的文章数
+	ScanArticles(ctx context.Context) (int, error)
+	// SendDue 发送到期的通知，返回本轮处理数量
+	SendDue(ctx context.Context) (int, error)
+}
+
+type sBlogWebmention struct{}
+
+// BlogWebmention Webmention 服务实例
+func BlogWebmention() IBlogWebmention {
+	return &sBlogWebmention{}
+}
+
+func webmentionEnabled(ctx context.Context) bool {
+	return configcache.GetBool(ctx, blogConfigNamespace, blogConfigEnv, "webmention_enabled", true)
+}
+
+// WebmentionEndpoints 对外声明的 Webmention 与 Pingback 端点，未开启时返回空字符串
+func WebmentionEndpoints(ctx context.Context) (webmentionURL, pingbackURL string) {
+	if !webmentionEnabled(ctx) {
+		return "", ""
+	}
+	site := LoadSiteInfo(ctx)
+	return site.APIURL + "/blog/webmention", site.APIURL + "/blog/pingback"
+}
+
+// articleByTarget 将目标地址解析为公开文章（前台文章地址 /blog/<slug>），不是本站文章时返回 nil
+func articleByTarget(ctx context.Context, site *SiteInfo, target string) (*entity.BlogArticles, error) {
+	t, err := url.Parse(target)
+	if err != nil {
+		return nil, nil
+	}
+	base, err := url.Parse(site.URL)
+	if err != nil || !strings.EqualFold(t.Host, base.Host) {
+		return nil, nil
+	}
+	prefix := strings.TrimSuffix(base.Path, "/") + "/blog/"
+	if !strings.HasPrefix(t.Path, prefix) {
+		return nil, nil
+	}
+	slug := strings.Trim(strings.TrimPrefix(t.Path, prefix), "/")
+	if slug == "" || strings.Contains(slug, "/") {
+		return nil, nil
+	}
+	var article *entity.BlogArticles
+	if err = publishedArticles(ctx).Where("slug", slug).Scan(&article); err != nil {
+		return nil, gerror.Wrap(err, "查询文章失败")
+	}
+	return article, nil
+}
+
+// Receive 接收通知
+func (s *sBlogWebmention) Receive(ctx context.Context, source, target, protocol string) error {
+	if !webmentionEnabled(ctx) {
+		return gerror.NewCode(gcode.CodeNotSupported, "未开启 Webmention")
+	}
+	source, target = strings.TrimSpace(source), strings.TrimSpace(target)
+	if !webmention.ValidURL(source) || !webmention.ValidURL(target) {
+		return gerror.NewCode(gcode.CodeInvalidParameter, "source 与 target 必须是 http/https 地址")
+	}
+	if webmention.NormalizeURL(source) == webmention.NormalizeURL(target) {
+		return gerror.NewCode(gcode.CodeInvalidParameter, "source 与 target 不能相同")
+	}
+
+	ip := RequestIP(ctx)
+	limit := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "webmention_receive_per_hour", 30)
+	if !webmentionReceiveLimiter.Allow("ip:"+ip, limit, time.Hour) {
+		return gerror.NewCode(gcode.CodeOperationFailed, "请求过于频繁，请稍后再试")
+	}
+
+	article, err := articleByTarget(ctx, LoadSiteInfo(ctx), target)
+	if err != nil {
+		return err
+	}
+	if article == nil {
+		return gerror.NewCode(gcode.CodeNotFound, "target 不是本站可接收通知的文章")
+	}
+
+	// 同一来源再次通知（更新或删除）时重新校验；被屏蔽的保持屏蔽
+	_, err = g.DB().Ctx(ctx).Exec(ctx, `INSERT INTO blog_webmentions (article_id, source, target, protocol, ip)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (source, target) DO UPDATE SET
+			status = CASE WHEN blog_webmentions.status = ? THEN blog_webmentions.status ELSE ? END,
+			article_id = EXCLUDED.article_id,
+			protocol = EXCLUDED.protocol,
+			ip = EXCLUDED.ip,
+			attempts = 0,
+			next_attempt_at = NOW(),
+			updated_at = NOW()`,
+		article.Id, source, target, protocol, ip, WebmentionStatusBlocked, WebmentionStatusPending)
+	if err != nil {
+		return gerror.Wrap(err, "保存通知失败")
+	}
+	return nil
+}
+
+// List 提及列表
+func (s *sBlogWebmention) List(ctx context.Context, articleId int64, status, mentionType string, page, size int) ([]*entity.BlogWebmentions, int, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 50
+	}
+	if status == "" {
+		status = WebmentionStatusVerified
+	}
+	if !auth.IsAuthenticated(ctx) {
+		if status != WebmentionStatusVerified {
+			return nil, 0, gerror.NewCode(gcode.CodeNotAuthorized, "仅登录用户可查看未校验或已屏蔽的提及")
+		}
+		count, err := publishedArticles(ctx).Where("id", articleId).Count()
+		if err != nil {
+			return nil, 0, gerror.Wrap(err, "查询文章失败")
+		}
+		if count == 0 {
+			return nil, 0, gerror.NewCode(gcode.CodeNotFound, "文章不存在")
+		}
+	}
+	m := dao.BlogWebmentions.Ctx(ctx).Where("article_id", articleId).Where("status", status)
+	if mentionType != "" {
+		m = m.Where("mention_type", mentionType)
+	}
+	total, err := m.Count()
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询提及总数失败")
+	}
+	var items []*entity.BlogWebmentions
+	err = m.Order("COALESCE(published_at, created_at) ASC, id ASC").Limit((page-1)*size, size).Scan(&items)
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询提及失败")
+	}
+	return items, total, nil
+}
+
+// Counts 按类型计数
+func (s *sBlogWebmention) Counts(ctx context.Context, articleId int64) (map[string]int, error) {
+	rows, err := dao.BlogWebmentions.Ctx(ctx).
+		Fields("mention_type, COUNT(*) AS total").
+		Where("article_id", articleId).
+		Where("status", WebmentionStatusVerified).
+		Group("mention_type").
+		All()
+	if err != nil {
+		return nil, gerror.Wrap(err, "统计提及失败")
+	}
+	counts := map[string]int{}
+	for _, r := range rows {
+		counts[r["mention_type"].String()] = r["total"].Int()
+	}
+	return counts, nil
+}
+
+// Block 屏蔽提及
+func (s *sBlogWebmention) Block(ctx context.Context, id int64) error {
+	result, err := dao.BlogWebmentions.Ctx(ctx).Where("id", id).Data(g.Map{
+		"status":     WebmentionStatusBlocked,
+		"updated_at": gtime.Now(),
+	}).Update()
+	if err != nil {
+		return gerror.Wrap(err, "屏蔽提及失败")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return gerror.NewCode(gcode.CodeNotFound, "提及不存在")
+	}
+	return nil
+}
+
+// Sends 发送记录
+func (s *sBlogWebmention) Sends(ctx context.Context, articleId int64) ([]*entity.BlogWebmentionSends, error) {
+	var items []*entity.BlogWebmentionSends
+	if err := dao.BlogWebmentionSends.Ctx(ctx).Where("article_id", articleId).OrderAsc("id").Scan(&items); err != nil {
+		return nil, gerror.Wrap(err, "查询发送记录失败")
+	}
+	return items, nil
+}
+
+// webmentionBackoff 第 attempts 次失败后的重试间隔：1、2、4…分钟
+func webmentionBackoff(attempts int) time.Duration {
+	backoff := time.Minute << uint(attempts-1)
+	if backoff <= 0 || backoff > 6*time.Hour {
+		backoff = 6 * time.Hour
+	}
+	return backoff
+}
+
+// webmentionRetryable 是否为可以重试的临时错误（网络错误、5xx）
+func webmentionRetryable(err error) bool {
+	var statusErr *webmention.StatusError
+	switch {
+	case errors.Is(err, webmention.ErrGone), errors.Is(err, webmention.ErrNoLink),
+		errors.Is(err, webmention.ErrNoEndpoint), errors.Is(err, webmention.ErrPrivateAddress):
+		return false
+	case errors.As(err, &statusErr):
+		return !statusErr.Permanent()
+	}
+	return true
+}
+
+// safeURL 只保留 http/https 地址，避免来源页面中的 javascript: 等地址被原样展示
+func safeURL(raw string) string {
+	if webmention.ValidURL(raw) {
+		return gstr.SubStrRune(raw, 0, 2048)
+	}
+	return ""
+}
+
+// VerifyDue 校验提及
+func (s *sBlogWebmention) VerifyDue(ctx context.Context) (int, error) {
+	var items []*entity.BlogWebmentions
+	err := g.DB().Ctx(ctx).GetScan(ctx, &items, `UPDATE blog_webmentions
+		SET attempts = attempts + 1, next_attempt_at = NOW() + make_interval(secs => ?)
+		WHERE id IN (
+			SELECT id FROM blog_webmentions
+			WHERE status = ? AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, int(webmentionClaimTimeout.Seconds()), WebmentionStatusPending, webmentionBatchSize)
+	if err != nil {
+		return 0, gerror.Wrap(err, "认领待校验提及失败")
+	}
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	client := webmentionHTTP(ctx)
+	maxAttempts := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "webmention_max_attempts", 3)
+	for _, item := range items {
+		mention, err := webmention.Verify(ctx, client, item.Source, item.Target)
+		data := g.Map{"updated_at": gtime.Now()}
+		switch {
+		case err == nil:
+			data["status"] = WebmentionStatusVerified
+			data["mention_type"] = mention.Type
+			data["url"] = firstNonEmpty(safeURL(mention.URL), item.Source)
+			data["title"] = gstr.SubStrRune(mention.Title, 0, 500)
+			data["content"] = gstr.SubStrRune(mention.Content, 0, webmentionContentMaxLen)
+			data["author_name"] = gstr.SubStrRune(mention.AuthorName, 0, 200)
+			data["author_url"] = safeURL(mention.AuthorURL)
+			data["author_photo"] = safeURL(mention.AuthorPhoto)
+			data["published_at"] = nil
+			if !mention.Published.IsZero() {
+				data["published_at"] = gtime.New(mention.Published)
+			}
+			data["verified_at"] = gtime.Now()
+			data["last_error"] = ""
+		case webmentionRetryable(err) && item.Attempts < maxAttempts:
+			data["next_attempt_at"] = gtime.Now().Add(webmentionBackoff(item.Attempts))
+			data["last_error"] = err.Error()
+		default:
+			// 曾经校验通过的提及在来源删除或不再链接时标记为已删除
+			data["status"] = WebmentionStatusRejected
+			if item.VerifiedAt != nil {
+				data["status"] = WebmentionStatusDeleted
+			}
+			data["last_error"] = err.Error()
+		}
+		if _, err := dao.BlogWebmentions.Ctx(ctx).Where("id", item.Id).Data(data).Update(); err != nil {
+			g.Log().Errorf(ctx, "更新提及状态失败: id=%d err=%v", item.Id, err)
+		}
+	}
+	return len(items), nil
+}
+
+// ScanArticles 扫描文章外部链接
+//
+// 只扫描 webmention_scan_window_hours 内发布或更新的文章，避免开启功能时向全部历史文章的链接发送通知；
+// 文章更新后重新通知现有链接，并对已删除的链接再通知一次，让目标移除提及。受密码保护的文章不发送。
+func (s *sBlogWebmention) ScanArticles(ctx context.Context) (int, error) {
+	if !webmentionEnabled(ctx) || !configcache.GetBool(ctx, blogConfigNamespace, blogConfigEnv, "webmention_send_enabled", true) {
+		return 0, nil
+	}
+	window := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "webmention_scan_window_hours", 48)
+	var articles []struct {
+		Id          int64
+		Slug        string
+		HtmlContent string
+		ChangedAt   *gtime.Time
+	}
+	err := g.DB().Ctx(ctx).GetScan(ctx, &articles, `SELECT a.id, a.slug, a.html_content, COALESCE(a.updated_at, a.created_at) AS changed_at
+		FROM blog_articles a
+		LEFT JOIN blog_webmention_scans s ON s.article_id = a.id
+		WHERE a.status = ? AND a.is_private = false AND a.deleted_at IS NULL AND a.password_hash = ''
+		  AND COALESCE(a.publish_at, a.created_at) <= NOW()
+		  AND COALESCE(a.updated_at, a.created_at) > NOW() - make_interval(hours => ?)
+		  AND (s.article_id IS NULL OR COALESCE(a.updated_at, a.created_at) > s.scanned_at)
+		ORDER BY a.id
+		LIMIT ?`, ArticleStatusPublished, window, webmentionBatchSize)
+	if err != nil {
+		return 0, gerror.Wrap(err, "查询待扫描文章失败")
+	}
+
+	site := LoadSiteInfo(ctx)
+	for _, a := range articles {
+		links := externalLinks(site, webmention.ExtractLinks(a.HtmlContent, site.ArticleURL(a.Slug)))
+		err := dao.BlogWebmentionSends.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			for _, link := range links {
+				_, err := tx.Exec(`INSERT INTO blog_webmention_sends (article_id, target) VALUES (?, ?)
+					ON CONFLICT (article_id, target) DO UPDATE SET
+						status = ?, attempts = 0, next_attempt_at = NOW(), link_removed = false, updated_at = NOW()`,
+					a.Id, link, WebmentionSendPending)
+				if err != nil {
+					return gerror.Wrap(err, "写入待发送通知失败")
+				}
+			}
+			removed := tx.Model(dao.BlogWebmentionSends.Table()).Ctx(ctx).
+				Where("article_id", a.Id).
+				Where("status", WebmentionSendSent)
+			if len(links) > 0 {
+				removed = removed.WhereNotIn("target", links)
+			}
+			_, err := removed.Data(g.Map{
+				"status":          WebmentionSendPending,
+				"attempts":        0,
+				"next_attempt_at": gtime.Now(),
+				"link_removed":    true,
+				"updated_at":      gtime.Now(),
+			}).Update()
+			if err != nil {
+				return gerror.Wrap(err, "写入待发送通知失败")
+			}
+			// 未发送成功的已删除链接不再需要通知
+			staleQuery := tx.Model(dao.BlogWebmentionSends.Table()).Ctx(ctx).
+				Where("article_id", a.Id).
+				Where("link_removed", false).
+				WhereNot("status", WebmentionSendSent)
+			if len(links) > 0 {
+				staleQuery = staleQuery.WhereNotIn("target", links)
+			}
+			if _, err = staleQuery.Delete(); err != nil {
+				return gerror.Wrap(err, "清理发送记录失败")
+			}
+			_, err = tx.Exec(`INSERT INTO blog_webmention_scans (article_id, scanned_at) VALUES (?, ?)
+				ON CONFLICT (article_id) DO UPDATE SET scanned_at = EXCLUDED.scanned_at`, a.Id, a.ChangedAt)
+			if err != nil {
+				return gerror.Wrap(err, "记录文章扫描失败")
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(articles), nil
+}
+
+// externalLinks 排除指向本站前台与后端的链接
+func externalLinks(site *SiteInfo, links []string) []string {
+	own := map[string]bool{}
+	for _, raw := range []string{site.URL, site.APIURL} {
+		if u, err := url.Parse(raw); err == nil && u.Host != "" {
+			own[strings.ToLower(u.Host)] = true
+		}
+	}
+	out := make([]string, 0, len(links))
+	for _, link := range links {
+		u, err := url.Parse(link)
+		if err != nil || own[strings.ToLower(u.Host)] || len(link) > 2048 {
+			continue
+		}
+		out = append(out, link)
+	}
+	return out
+}
+
+// SendDue 发送通知
+func (s *sBlogWebmention) SendDue(ctx context.Context) (int, error) {
+	var items []*entity.BlogWebmentionSends
+	err := g.DB().Ctx(ctx).GetScan(ctx, &items, `UPDATE blog_webmention_sends
+		SET attempts = attempts + 1, next_attempt_at = NOW() + make_interval(secs => ?)
+		WHERE id IN (
+			SELECT id FROM blog_webmention_sends
+			WHERE status = ? AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, int(webmentionClaimTimeout.Seconds()), WebmentionSendPending, webmentionBatchSize)
+	if err != nil {
+		return 0, gerror.Wrap(err, "认领待发送通知失败")
+	}
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ArticleId)
+	}
+	slugs := map[int64]string{}
+	rows, err := dao.BlogArticles.Ctx(ctx).Fields("id, slug").WhereIn("id", ids).All()
+	if err != nil {
+		return 0, gerror.Wrap(err, "查询文章失败")
+	}
+	for _, r := range rows {
+		slugs[r["id"].Int64()] = r["slug"].String()
+	}
+
+	site := LoadSiteInfo(ctx)
+	client := webmentionHTTP(ctx)
+	maxAttempts := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "webmention_max_attempts", 3)
+	for _, item := range items {
+		source := site.ArticleURL(slugs[item.ArticleId])
+		data := g.Map{"updated_at": gtime.Now()}
+		endpoint, err := webmention.Discover(ctx, client, item.Target)
+		code := 0
+		if err == nil {
+			data["endpoint"] = endpoint.URL
+			if endpoint.Pingback {
+				data["protocol"] = ProtocolPingback
+				code, err = webmention.Ping(ctx, client, endpoint.URL, source, item.Target)
+			} else {
+				data["protocol"] = ProtocolWebmention
+				code, err = webmention.Send(ctx, client, endpoint.URL, source, item.Target)
+			}
+			data["status_code"] = code
+		}
+		switch {
+		case err == nil && item.LinkRemoved:
+			if _, err := dao.BlogWebmentionSends.Ctx(ctx).Where("id", item.Id).Delete(); err != nil {
+				g.Log().Errorf(ctx, "删除发送记录失败: id=%d err=%v", item.Id, err)
+			}
+			continue
+		case err == nil:
+			data["status"] = WebmentionSendSent
+			data["sent_at"] = gtime.Now()
+			data["last_error"] = ""
+		case errors.Is(err, webmention.ErrNoEndpoint):
+			data["status"] = WebmentionSendNoEndpoint
+			data["last_error"] = ""
+		case webmentionRetryable(err) && item.Attempts < maxAttempts:
+			data["next_attempt_at"] = gtime.Now().Add(webmentionBackoff(item.Attempts))
+			data["last_error"] = err.Error()
+		default:
+			data["status"] = WebmentionSendFailed
+			data["last_error"] = err.Error()
+		}
+		if _, err := dao.BlogWebmentionSends.Ctx(ctx).Where("id", item.Id).Data(data).Update(); err != nil {
+			g.Log().Errorf(ctx, "更新发送记录失败: id=%d err=%v", item.Id, err)
+		}
+	}
+	return len(items), nil
+}
+
+// StartWebmentionWorker 启动 Webmention 任务：校验收到的提及、扫描新发布的文章并发送通知，
+// 间隔由 blog/default webmention_worker_interval_seconds 配置
+func StartWebmentionWorker(ctx context.Context) {
+	go func() {
+		for {
+			interval := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "webmention_worker_interval_seconds", 30)
+			if interval < 5 {
+				interval = 5
+			}
+			select {
+			case <-ctx.Done():
+				g.Log().Info(ctx, "Webmention 任务已停止")
+				return
+			case <-time.After(time.Duration(interval) * time.Second):
+				if _, err := BlogWebmention().VerifyDue(ctx); err != nil {
+					g.Log().Errorf(ctx, "校验提及失败: %v", err)
+				}
+				if _, err := BlogWebmention().ScanArticles(ctx); err != nil {
+					g.Log().Errorf(ctx, "扫描文章链接失败: %v", err)
+				}
+				if _, err := BlogWebmention().SendDue(ctx); err != nil {
+					g.Log().Errorf(ctx, "发送 Webmention 失败: %v", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"server/internal/service/webmention"
+)
+
+func TestWebmentionClientInjection(t *testing.T) {
+	ctx := context.Background()
+	target := "https://blog.example/posts/hello"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = io.WriteString(w, `<div class="h-entry"><a class="u-in-reply-to" href="`+target+`">re</a></div>`)
+	}))
+	defer srv.Close()
+
+	SetWebmentionClient(srv.Client())
+	m, err := webmention.Verify(ctx, webmentionHTTP(ctx), srv.URL, target)
+	if err != nil || m.Type != webmention.TypeReply {
+		t.Fatalf("injected client: got %+v, %v", m, err)
+	}
+
+	// 恢复默认客户端后，本地桩服务属于内网地址，应被拒绝且不再重试
+	SetWebmentionClient(nil)
+	_, err = webmention.Verify(ctx, webmentionHTTP(ctx), srv.URL, target)
+	if !errors.Is(err, webmention.ErrPrivateAddress) || webmentionRetryable(err) {
+		t.Fatalf("default client: got %v", err)
+	}
+}
+
+func TestWebmentionRetryable(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{webmention.ErrGone, false},
+		{webmention.ErrNoLink, false},
+		{webmention.ErrNoEndpoint, false},
+		{fmt.Errorf("dial: %w", webmention.ErrPrivateAddress), false},
+		{&webmention.StatusError{Code: 404}, false},
+		{&webmention.StatusError{Code: 503}, true},
+		{errors.New("connection reset"), true},
+	}
+	for _, tc := range cases {
+		if got := webmentionRetryable(tc.err); got != tc.want {
+			t.Errorf("%v: got %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestExternalLinks(t *testing.T) {
+	site := &SiteInfo{URL: "https://Blog.example", APIURL: "https://api.blog.example/api"}
+	links := externalLinks(site, []string{
+		"https://blog.example/posts/a",
+		"https://api.blog.example/file/download/1",
+		"https://other.example/b",
+	})
+	if want := []string{"https://other.example/b"}; !reflect.DeepEqual(links, want) {
+		t.Fatalf("got %q", links)
+	}
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/gogf/gf/v2/frame/g"
+
+	"server/internal/service/configcache"
+)
+
+// RequestIP 当前请求的客户端地址，供限流、去重与反垃圾使用；没有请求时返回空字符串。
+//
+// 默认使用 TCP 连接的远端地址，避免被 X-Forwarded-For 等 Header 伪造；只有远端地址属于
+// blog/default trusted_proxies 配置的可信代理（IP 或 CIDR）时，才采用 X-Forwarded-For 中
+// 自右向左第一个不属于可信代理的地址。
+func RequestIP(ctx context.Context) string {
+	r := g.RequestFromCtx(ctx)
+	if r == nil {
+		return ""
+	}
+	return resolveClientIP(r.GetRemoteIp(), r.Header.Values("X-Forwarded-For"), trustedProxies(ctx))
+}
+
+// resolveClientIP 根据远端地址与 X-Forwarded-For 取值确定客户端地址
+func resolveClientIP(remote string, forwarded []string, trusted []*net.IPNet) string {
+	if !ipTrusted(remote, trusted) {
+		return remote
+	}
+	var hops []string
+	for _, v := range forwarded {
+		hops = append(hops, strings.Split(v, ",")...)
+	}
+	client := remote
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		client = hop
+		if !ipTrusted(hop, trusted) {
+			break
+		}
+	}
+	return client
+}
+
+func ipTrusted(addr string, trusted []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range trusted {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// trustedProxies 解析可信代理配置，无效的条目被忽略
+func trustedProxies(ctx context.Context) []*net.IPNet {
+	var out []*net.IPNet
+	for _, s := range configcache.GetStrings(ctx, blogConfigNamespace, blogConfigEnv, "trusted_proxies") {
+		if !strings.Contains(s, "/") {
+			if ip := net.ParseIP(s); ip != nil {
+				bits := 8 * net.IPv6len
+				if ip.To4() != nil {
+					ip, bits = ip.To4(), 8*net.IPv4len
+				}
+				out = append(out, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			}
+			continue
+		}
+		if _, n, err := net.ParseCIDR(s); err == nil {
+			out = append(out, n)
+		}
+	}
+	return out
+}
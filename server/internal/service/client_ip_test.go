@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"server/internal/service/configcache"
+)
+
+func TestResolveClientIP(t *testing.T) {
+	configcache.Set(blogConfigNamespace, blogConfigEnv, "trusted_proxies", "10.0.0.0/8, 192.0.2.1, bad")
+	defer configcache.Set(blogConfigNamespace, blogConfigEnv, "trusted_proxies", "")
+	trusted := trustedProxies(context.Background())
+	if len(trusted) != 2 {
+		t.Fatalf("trusted proxies: got %v", trusted)
+	}
+
+	cases := []struct {
+		name      string
+		remote    string
+		forwarded []string
+		want      string
+	}{
+		{"direct client ignores header", "203.0.113.5", []string{"198.51.100.1"}, "203.0.113.5"},
+		{"trusted proxy", "10.1.2.3", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"forged hops before real client", "10.1.2.3", []string{"1.1.1.1, 198.51.100.1"}, "198.51.100.1"},
+		{"proxy chain", "10.1.2.3", []string{"198.51.100.1, 192.0.2.1", "10.9.9.9"}, "198.51.100.1"},
+		{"invalid hop", "10.1.2.3", []string{"junk, 198.51.100.1"}, "198.51.100.1"},
+		{"no header", "192.0.2.1", nil, "192.0.2.1"},
+	}
+	for _, tc := range cases {
+		if got := resolveClientIP(tc.remote, tc.forwarded, trusted); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+	if got := resolveClientIP("10.1.2.3", []string{"198.51.100.1"}, []*net.IPNet(nil)); got != "10.1.2.3" {
+		t.Errorf("no trusted proxies: got %s", got)
+	}
+}
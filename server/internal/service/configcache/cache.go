@@ -2,6 +2,9 @@ package configcache
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -116,6 +119,22 @@ func Get(ctx context.Context, ns, env, key string) (ConfigItem, bool) {
 	return it, ok
 }
 
+// Set 写入单个配置项（只影响内存缓存，不落库），用于测试或在未连接数据库时注入配置
+func Set(ns, env, key string, value interface{}) {
+	mu.Lock()
+	defer mu.Unlock()
+	if store == nil {
+		store = make(map[string]map[string]map[string]ConfigItem)
+	}
+	if store[ns] == nil {
+		store[ns] = make(map[string]map[string]ConfigItem)
+	}
+	if store[ns][env] == nil {
+		store[ns][env] = make(map[string]ConfigItem)
+	}
+	store[ns][env][key] = ConfigItem{Namespace: ns, Env: env, Key: key, Value: value, Enabled: true}
+}
+
 // Stats 当前缓存条目数
 func Stats() int {
 	mu.RLock()
@@ -127,4 +146,71 @@ func Stats() int {
 		}
 	}
 	return total
-}
\ No newline at end of file
+}
+
+// GetString 读取字符串配置，不存在或为空时返回默认值（兼容 JSON 字符串两侧的引号）
+func GetString(ctx context.Context, ns, env, key, def string) string {
+	it, ok := Get(ctx, ns, env, key)
+	if !ok || it.Value == nil {
+		return def
+	}
+	s := strings.Trim(g.NewVar(it.Value).String(), " \t\r\n\"")
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+// GetInt 读取数值配置，不存在或无法解析时返回默认值
+func GetInt(ctx context.Context, ns, env, key string, def int) int {
+	s := GetString(ctx, ns, env, key, "")
+	if s == "" {
+		return def
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return def
+	}
+	return int(n)
+}
+
+// GetBool 读取布尔配置，不存在或无法解析时返回默认值
+func GetBool(ctx context.Context, ns, env, key string, def bool) bool {
+	s := GetString(ctx, ns, env, key, "")
+	if s == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return def
+	}
+	return b
+}
+
+// GetStrings 读取字符串列表配置，支持 JSON 数组或逗号/换行分隔的字符串
+func GetStrings(ctx context.Context, ns, env, key string) []string {
+	it, ok := Get(ctx, ns, env, key)
+	if !ok || it.Value == nil {
+		return nil
+	}
+	var raw []string
+	v := g.NewVar(it.Value)
+	if s := strings.TrimSpace(v.String()); strings.HasPrefix(s, "[") {
+		_ = json.Unmarshal([]byte(s), &raw)
+	} else if list, isList := it.Value.([]interface{}); isList {
+		for _, e := range list {
+			raw = append(raw, g.NewVar(e).String())
+		}
+	} else {
+		raw = strings.FieldsFunc(strings.Trim(s, "\""), func(r rune) bool {
+			return r == ',' || r == '\n' || r == '，'
+		})
+	}
+	out := make([]string, 0, len(raw))
+	for _, s := range raw {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,111 @@
+// Package counter 提供进程内的计数缓冲与去重窗口，用于把高频的计数写入合并为批量更新。
+package counter
+
+import (
+	"sync"
+	"time"
+)
+
+// Buffer 按 (ID, 字段) 累积计数增量，由调用方定期 Drain 后批量写入数据库
+type Buffer struct {
+	mu     sync.Mutex
+	deltas map[int64]map[string]int
+}
+
+// NewBuffer 创建计数缓冲
+func NewBuffer() *Buffer {
+	return &Buffer{deltas: make(map[int64]map[string]int)}
+}
+
+// Add 累加增量
+func (b *Buffer) Add(id int64, field string, delta int) {
+	if delta == 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	fields := b.deltas[id]
+	if fields == nil {
+		fields = make(map[string]int)
+		b.deltas[id] = fields
+	}
+	fields[field] += delta
+}
+
+// Pending 返回尚未写入的增量，用于在响应中展示最新计数
+func (b *Buffer) Pending(id int64, field string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.deltas[id][field]
+}
+
+// Drain 取出并清空全部增量（已抵消为 0 的字段不返回）
+func (b *Buffer) Drain() map[int64]map[string]int {
+	b.mu.Lock()
+	deltas := b.deltas
+	b.deltas = make(map[int64]map[string]int)
+	b.mu.Unlock()
+
+	for id, fields := range deltas {
+		for field, delta := range fields {
+			if delta == 0 {
+				delete(fields, field)
+			}
+		}
+		if len(fields) == 0 {
+			delete(deltas, id)
+		}
+	}
+	return deltas
+}
+
+// Restore 将写入失败的增量放回缓冲，等待下一次写入
+func (b *Buffer) Restore(deltas map[int64]map[string]int) {
+	for id, fields := range deltas {
+		for field, delta := range fields {
+			b.Add(id, field, delta)
+		}
+	}
+}
+
+// Window 去重窗口：同一个 key 在窗口期内只放行一次
+//
+// 记录分为当前与上一个两个时间桶，每经过一个窗口期轮换一次并整体丢弃更早的桶，
+// 清理过期记录不需要遍历，内存占用只与最近两个窗口期内的 key 数量有关。
+type Window struct {
+	mu       sync.Mutex
+	current  map[string]time.Time
+	previous map[string]time.Time
+	rotated  time.Time // 当前桶的起始时间
+	now      func() time.Time
+}
+
+// NewWindow 创建去重窗口
+func NewWindow() *Window {
+	return &Window{current: make(map[string]time.Time), previous: make(map[string]time.Time), now: time.Now}
+}
+
+// First 判断 key 是否为窗口期内首次出现，首次出现时记录
+func (w *Window) First(key string, window time.Duration) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	now := w.now()
+	if elapsed := now.Sub(w.rotated); elapsed >= window {
+		// 超过两个窗口期没有轮换时上一个桶也已全部过期
+		if elapsed >= 2*window {
+			w.previous = make(map[string]time.Time)
+		} else {
+			w.previous = w.current
+		}
+		w.current = make(map[string]time.Time)
+		w.rotated = now
+	}
+	if at, ok := w.current[key]; ok && now.Sub(at) < window {
+		return false
+	}
+	if at, ok := w.previous[key]; ok && now.Sub(at) < window {
+		return false
+	}
+	w.current[key] = now
+	return true
+}
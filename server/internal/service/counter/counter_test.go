@@ -0,0 +1,75 @@
+package counter
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuffer(t *testing.T) {
+	b := NewBuffer()
+	b.Add(1, "views", 2)
+	b.Add(1, "views", 1)
+	b.Add(1, "likes", 1)
+	b.Add(1, "likes", -1)
+	b.Add(2, "shares", 0)
+	if got := b.Pending(1, "views"); got != 3 {
+		t.Fatalf("pending: got %d", got)
+	}
+
+	deltas := b.Drain()
+	if want := map[int64]map[string]int{1: {"views": 3}}; !reflect.DeepEqual(deltas, want) {
+		t.Fatalf("drain: got %v, want %v", deltas, want)
+	}
+	if got := b.Drain(); len(got) != 0 {
+		t.Fatalf("second drain: got %v", got)
+	}
+
+	b.Add(1, "views", 1)
+	b.Restore(deltas)
+	if got := b.Pending(1, "views"); got != 4 {
+		t.Fatalf("after restore: got %d", got)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	cases := []struct {
+		offset time.Duration
+		key    string
+		want   bool
+	}{
+		{0, "a", true},
+		{0, "a", false},
+		{30 * time.Minute, "b", true},
+		{59 * time.Minute, "a", false},
+		{60 * time.Minute, "a", true},  // a 已过期，轮换后重新记录
+		{80 * time.Minute, "b", false}, // b 在上一个桶中且未过期
+		{90 * time.Minute, "b", true},
+		{4 * time.Hour, "a", true}, // 长时间无请求后两个桶都已过期
+		{4 * time.Hour, "a", false},
+	}
+	w := NewWindow()
+	now := start
+	w.now = func() time.Time { return now }
+	for i, tc := range cases {
+		now = start.Add(tc.offset)
+		if got := w.First(tc.key, time.Hour); got != tc.want {
+			t.Errorf("case %d (%s at %s): got %v, want %v", i, tc.key, tc.offset, got, tc.want)
+		}
+	}
+}
+
+func TestWindowMemoryBounded(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	w := NewWindow()
+	w.now = func() time.Time { return now }
+	for i := 0; i < 10000; i++ {
+		now = now.Add(time.Second)
+		w.First(fmt.Sprint(i), time.Minute)
+	}
+	if n := len(w.current) + len(w.previous); n > 120 {
+		t.Fatalf("tracking %d keys for a one minute window", n)
+	}
+}
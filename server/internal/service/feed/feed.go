@@ -0,0 +1,339 @@
+// Package feed 将文章列表编码为 RSS 2.0、Atom 1.0 与 JSON Feed 1.1 格式
+package feed
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"time"
+)
+
+// 支持的订阅格式
+const (
+	FormatRSS  = "rss"
+	FormatAtom = "atom"
+	FormatJSON = "json"
+)
+
+// ContentTypes 各格式对应的响应 Content-Type
+var ContentTypes = map[string]string{
+	FormatRSS:  "application/rss+xml; charset=utf-8",
+	FormatAtom: "application/atom+xml; charset=utf-8",
+	FormatJSON: "application/feed+json; charset=utf-8",
+}
+
+// Feed 与具体格式无关的订阅源
+type Feed struct {
+	Title       string
+	Description string
+	Link        string // 站点（或分类/标签）页面地址
+	FeedURL     string // 订阅源自身地址
+	Language    string
+	Author      string
+	Updated     time.Time
+	Items       []Item
+}
+
+// Item 订阅条目
+type Item struct {
+	ID          string // 全局唯一标识
+	Title       string
+	Link        string
+	Summary     string
+	ContentHTML string
+	Image       string
+	Categories  []string
+	Published   time.Time
+	Updated     time.Time
+}
+
+// Encode 按格式编码
+func Encode(f *Feed, format string) ([]byte, error) {
+	switch format {
+	case FormatAtom:
+		return Atom(f)
+	case FormatJSON:
+		return JSON(f)
+	default:
+		return RSS(f)
+	}
+}
+
+type rssRoot struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Content string     `xml:"xmlns:content,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language,omitempty"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	AtomLink      rssLink   `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	Guid        rssGuid       `xml:"guid"`
+	PubDate     string        `xml:"pubDate,omitempty"`
+	Description string        `xml:"description"`
+	Content     *cdata        `xml:"content:encoded,omitempty"`
+	Categories  []string      `xml:"category"`
+	Enclosure   *rssEnclosure `xml:"enclosure,omitempty"`
+}
+
+type rssGuid struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Type   string `xml:"type,attr"`
+	Length int    `xml:"length,attr"`
+}
+
+type cdata struct {
+	Value string `xml:",cdata"`
+}
+
+// RSS 编码为 RSS 2.0
+func RSS(f *Feed) ([]byte, error) {
+	ch := rssChannel{
+		Title:       f.Title,
+		Link:        f.Link,
+		Description: f.Description,
+		Language:    f.Language,
+		AtomLink:    rssLink{Href: f.FeedURL, Rel: "self", Type: "application/rss+xml"},
+	}
+	if !f.Updated.IsZero() {
+		ch.LastBuildDate = f.Updated.Format(time.RFC1123Z)
+	}
+	for _, it := range f.Items {
+		ri := rssItem{
+			Title:       it.Title,
+			Link:        it.Link,
+			Guid:        rssGuid{Value: it.ID},
+			Description: it.Summary,
+			Categories:  it.Categories,
+		}
+		if !it.Published.IsZero() {
+			ri.PubDate = it.Published.Format(time.RFC1123Z)
+		}
+		if it.ContentHTML != "" {
+			ri.Content = &cdata{Value: it.ContentHTML}
+		}
+		if it.Image != "" {
+			ri.Enclosure = &rssEnclosure{URL: it.Image, Type: imageMimeType(it.Image)}
+		}
+		ch.Items = append(ch.Items, ri)
+	}
+	return marshalXML(rssRoot{
+		Version: "2.0",
+		Content: "http://purl.org/rss/1.0/modules/content/",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Channel: ch,
+	})
+}
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Lang     string      `xml:"xml:lang,attr,omitempty"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Author   *atomAuthor `xml:"author,omitempty"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Links      []atomLink     `xml:"link"`
+	Published  string         `xml:"published,omitempty"`
+	Updated    string         `xml:"updated"`
+	Summary    *atomText      `xml:"summary,omitempty"`
+	Content    *atomText      `xml:"content,omitempty"`
+	Categories []atomCategory `xml:"category"`
+}
+
+// Atom 编码为 Atom 1.0
+func Atom(f *Feed) ([]byte, error) {
+	af := atomFeed{
+		Lang:     f.Language,
+		ID:       f.FeedURL,
+		Title:    f.Title,
+		Subtitle: f.Description,
+		Updated:  f.Updated.Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: f.Link, Rel: "alternate", Type: "text/html"},
+			{Href: f.FeedURL, Rel: "self", Type: "application/atom+xml"},
+		},
+	}
+	if f.Author != "" {
+		af.Author = &atomAuthor{Name: f.Author}
+	}
+	for _, it := range f.Items {
+		e := atomEntry{
+			ID:      it.ID,
+			Title:   it.Title,
+			Links:   []atomLink{{Href: it.Link, Rel: "alternate", Type: "text/html"}},
+			Updated: it.Updated.Format(time.RFC3339),
+		}
+		if !it.Published.IsZero() {
+			e.Published = it.Published.Format(time.RFC3339)
+		}
+		if it.Summary != "" {
+			e.Summary = &atomText{Type: "text", Value: it.Summary}
+		}
+		if it.ContentHTML != "" {
+			e.Content = &atomText{Type: "html", Value: it.ContentHTML}
+		}
+		if it.Image != "" {
+			e.Links = append(e.Links, atomLink{Href: it.Image, Rel: "enclosure", Type: imageMimeType(it.Image)})
+		}
+		for _, c := range it.Categories {
+			e.Categories = append(e.Categories, atomCategory{Term: c})
+		}
+		af.Entries = append(af.Entries, e)
+	}
+	return marshalXML(af)
+}
+
+type jsonFeed struct {
+	Version     string           `json:"version"`
+	Title       string           `json:"title"`
+	HomePageURL string           `json:"home_page_url,omitempty"`
+	FeedURL     string           `json:"feed_url,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Language    string           `json:"language,omitempty"`
+	Authors     []jsonFeedAuthor `json:"authors,omitempty"`
+	Items       []jsonFeedItem   `json:"items"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedItem struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url,omitempty"`
+	Title         string   `json:"title,omitempty"`
+	ContentHTML   string   `json:"content_html,omitempty"`
+	ContentText   string   `json:"content_text,omitempty"`
+	Summary       string   `json:"summary,omitempty"`
+	Image         string   `json:"image,omitempty"`
+	DatePublished string   `json:"date_published,omitempty"`
+	DateModified  string   `json:"date_modified,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+}
+
+// JSON 编码为 JSON Feed 1.1
+func JSON(f *Feed) ([]byte, error) {
+	jf := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     f.FeedURL,
+		Description: f.Description,
+		Language:    f.Language,
+		Items:       make([]jsonFeedItem, 0, len(f.Items)),
+	}
+	if f.Author != "" {
+		jf.Authors = []jsonFeedAuthor{{Name: f.Author}}
+	}
+	for _, it := range f.Items {
+		ji := jsonFeedItem{
+			ID:          it.ID,
+			URL:         it.Link,
+			Title:       it.Title,
+			ContentHTML: it.ContentHTML,
+			Summary:     it.Summary,
+			Image:       it.Image,
+			Tags:        it.Categories,
+		}
+		// JSON Feed 要求 content_html 与 content_text 至少有一项，无正文时以摘要代替
+		if ji.ContentHTML == "" {
+			ji.ContentText = it.Summary
+		}
+		if !it.Published.IsZero() {
+			ji.DatePublished = it.Published.Format(time.RFC3339)
+		}
+		if !it.Updated.IsZero() {
+			ji.DateModified = it.Updated.Format(time.RFC3339)
+		}
+		jf.Items = append(jf.Items, ji)
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(jf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func marshalXML(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// imageMimeType 根据扩展名推断图片类型，无法判断时返回 image/jpeg
+func imageMimeType(url string) string {
+	switch {
+	case hasSuffixFold(url, ".png"):
+		return "image/png"
+	case hasSuffixFold(url, ".gif"):
+		return "image/gif"
+	case hasSuffixFold(url, ".webp"):
+		return "image/webp"
+	case hasSuffixFold(url, ".svg"):
+		return "image/svg+xml"
+	default:
+		return "image/jpeg"
+	}
+}
+
+func hasSuffixFold(s, suffix string) bool {
+	return len(s) >= len(suffix) && bytes.EqualFold([]byte(s[len(s)-len(suffix):]), []byte(suffix))
+}
@@ -28,6 +28,9 @@ type IFile interface {
 	// UploadFile 上传文件
 	UploadFile(ctx context.Context, file *multipart.FileHeader, category string, uploaderID int64, uploaderIP string, userAgent string, applicationName ...string) (*entity.Files, error)
 
+	// SaveContent 保存内存中的文件内容（如导入、抓取的远程文件），contentType 在扩展名无法识别类型时使用
+	SaveContent(ctx context.Context, fileName string, content []byte, contentType string, category string, uploaderID int64, uploaderIP string, userAgent string, applicationName ...string) (*entity.Files, error)
+
 	// GetFileByUUID 根据UUID获取文件
 	GetFileByUUID(ctx context.Context, fileUUID string) (*entity.Files, error)
 
@@ -79,22 +82,30 @@ func (s *sFile) UploadFile(ctx context.Context, file *multipart.FileHeader, cate
 		return nil, gerror.Wrap(err, "读取文件内容失败")
 	}
 
+	return s.SaveContent(ctx, file.Filename, content, file.Header.Get("Content-Type"), category, uploaderID, uploaderIP, userAgent, applicationName...)
+}
+
+// SaveContent 保存内存中的文件内容
+func (s *sFile) SaveContent(ctx context.Context, fileName string, content []byte, contentType string, category string, uploaderID int64, uploaderIP string, userAgent string, applicationName ...string) (*entity.Files, error) {
+	var err error
+	fileSize := int64(len(content))
+
 	// 检查文件大小（限制为50MB）
 	maxSize := int64(50 * 1024 * 1024) // 50MB
-	if file.Size > maxSize {
-		return nil, gerror.Newf("文件大小超过限制，最大允许50MB，当前文件大小: %d字节", file.Size)
+	if fileSize > maxSize {
+		return nil, gerror.Newf("文件大小超过限制，最大允许50MB，当前文件大小: %d字节", fileSize)
 	}
 
 	// 获取文件扩展名和MIME类型
-	extension := strings.ToLower(filepath.Ext(file.Filename))
+	extension := strings.ToLower(filepath.Ext(fileName))
 	if extension != "" {
 		extension = extension[1:] // 去掉点号
 	}
 
 	mimeType := utility.GetMimeTypeFromExtension(extension)
 	if mimeType == "application/octet-stream" {
-		// 尝试从HTTP头获取MIME类型
-		mimeType = file.Header.Get("Content-Type")
+		// 使用调用方提供的MIME类型（如HTTP头）
+		mimeType = contentType
 		if mimeType == "" {
 			mimeType = "application/octet-stream"
 		}
@@ -140,7 +151,7 @@ func (s *sFile) UploadFile(ctx context.Context, file *multipart.FileHeader, cate
 
 	// 准备元数据
 	metadata := g.Map{
-		"original_filename": file.Filename,
+		"original_filename": fileName,
 		"upload_time":       time.Now().Format("2006-01-02 15:04:05"),
 		"content_type":      mimeType,
 	}
@@ -185,9 +196,9 @@ func (s *sFile) UploadFile(ctx context.Context, file *multipart.FileHeader, cate
 			) RETURNING id, file_uuid`
 
 		result, err := tx.GetValue(insertSQL,
-			file.Filename,          // $1 file_name
+			fileName,               // $1 file_name
 			extension,              // $2 file_extension
-			file.Size,              // $3 file_size
+			fileSize,               // $3 file_size
 			mimeType,               // $4 mime_type
 			fileHash,               // $5 file_hash
 			fileMd5,                // $6 file_md5
@@ -0,0 +1,47 @@
+package importer
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// Markdown 图片：![alt](url "title")，url 可用尖括号包裹
+	markdownImagePattern = regexp.MustCompile(`(!\[[^\]]*\]\(\s*<?)([^\s)>]+)(>?(?:\s+["'(][^)]*)?\s*\))`)
+	// HTML 图片：<img ... src="url" ...>
+	htmlImagePattern = regexp.MustCompile(`(?i)(<img\b[^>]*?\bsrc\s*=\s*["'])([^"']+)(["'])`)
+)
+
+// ImageRefs 提取正文中引用的图片地址（去重，保持出现顺序），忽略 data URI
+func ImageRefs(content string) []string {
+	var refs []string
+	seen := make(map[string]bool)
+	for _, pattern := range []*regexp.Regexp{markdownImagePattern, htmlImagePattern} {
+		for _, m := range pattern.FindAllStringSubmatch(content, -1) {
+			ref := strings.TrimSpace(m[2])
+			if ref == "" || strings.HasPrefix(ref, "data:") || seen[ref] {
+				continue
+			}
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// RewriteImages 按映射替换图片地址，只改写图片语法中的地址，正文中的同名文本不受影响
+func RewriteImages(content string, mapping map[string]string) string {
+	if len(mapping) == 0 {
+		return content
+	}
+	for _, pattern := range []*regexp.Regexp{markdownImagePattern, htmlImagePattern} {
+		content = pattern.ReplaceAllStringFunc(content, func(s string) string {
+			m := pattern.FindStringSubmatch(s)
+			if to, ok := mapping[strings.TrimSpace(m[2])]; ok {
+				return m[1] + to + m[3]
+			}
+			return s
+		})
+	}
+	return content
+}
@@ -0,0 +1,222 @@
+// Package importer 解析 Hexo/Hugo 的 Markdown 归档与 WordPress WXR 导出文件，
+// 产出与存储无关的文章列表，供博客导入服务写入数据库
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 导入来源格式
+const (
+	FormatMarkdown = "markdown"
+	FormatWXR      = "wxr"
+)
+
+// 压缩包限制：单个条目与解压总量
+const (
+	maxEntrySize = 50 << 20
+	maxTotalSize = 500 << 20
+)
+
+// Post 待导入的文章
+type Post struct {
+	Source        string // 来源：压缩包内路径或 WXR 条目链接
+	Title         string
+	Slug          string    // 为空时由导入服务根据标题生成
+	Date          time.Time // 发布时间，零值表示未知
+	Updated       time.Time // 最后修改时间，零值表示同发布时间
+	Tags          []string
+	Category      []string // 分类路径，由顶级到末级
+	CategorySlugs []string // 与 Category 对应的 slug（WXR 提供），可为空
+	Draft         bool
+	Private       bool
+	Summary       string
+	Cover         string // 封面图引用
+	Content       string // Markdown 或 HTML 正文
+	Dir           string // 压缩包内所在目录，用于解析相对路径的图片
+	AssetDir      string // Hexo post_asset_folder 资源目录
+}
+
+// Source 解析后的导入来源
+type Source struct {
+	Format   string
+	Posts    []*Post
+	Warnings []string
+	files    map[string]*zip.File
+}
+
+// Parse 解析上传的文件：zip 压缩包（Markdown 文件或其中的 WXR 导出）或 WXR XML 文件
+func Parse(data []byte) (*Source, error) {
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return parseZip(data)
+	}
+	if looksLikeWXR(data) {
+		posts, warnings, err := ParseWXR(data)
+		if err != nil {
+			return nil, err
+		}
+		return &Source{Format: FormatWXR, Posts: posts, Warnings: warnings}, nil
+	}
+	return nil, errors.New("不支持的文件格式，请上传 Markdown 压缩包（zip）或 WordPress 导出文件（WXR）")
+}
+
+func parseZip(data []byte) (*Source, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errors.New("无法读取 zip 压缩包: " + err.Error())
+	}
+	src := &Source{Format: FormatMarkdown, files: make(map[string]*zip.File)}
+	var markdown, wxr []*zip.File
+	var total uint64
+	for _, f := range zr.File {
+		name := cleanZipPath(f.Name)
+		if name == "" || f.FileInfo().IsDir() || isIgnored(name) {
+			continue
+		}
+		if f.UncompressedSize64 > maxEntrySize {
+			src.Warnings = append(src.Warnings, name+": 文件过大，已忽略")
+			continue
+		}
+		if total += f.UncompressedSize64; total > maxTotalSize {
+			return nil, errors.New("压缩包解压后体积超过限制")
+		}
+		src.files[name] = f
+		switch strings.ToLower(path.Ext(name)) {
+		case ".md", ".markdown":
+			markdown = append(markdown, f)
+		case ".xml":
+			wxr = append(wxr, f)
+		}
+	}
+
+	// 压缩包中没有 Markdown 时尝试其中的 WXR 导出
+	if len(markdown) == 0 && len(wxr) > 0 {
+		src.Format = FormatWXR
+		for _, f := range wxr {
+			content, err := readZipFile(f)
+			if err != nil || !looksLikeWXR(content) {
+				continue
+			}
+			posts, warnings, err := ParseWXR(content)
+			if err != nil {
+				return nil, err
+			}
+			src.Posts = append(src.Posts, posts...)
+			src.Warnings = append(src.Warnings, warnings...)
+		}
+		return src, nil
+	}
+
+	sort.Slice(markdown, func(i, j int) bool { return markdown[i].Name < markdown[j].Name })
+	for _, f := range markdown {
+		name := cleanZipPath(f.Name)
+		content, err := readZipFile(f)
+		if err != nil {
+			src.Warnings = append(src.Warnings, name+": "+err.Error())
+			continue
+		}
+		post, err := ParseMarkdown(name, content)
+		if err != nil {
+			src.Warnings = append(src.Warnings, name+": "+err.Error())
+			continue
+		}
+		if post != nil {
+			src.Posts = append(src.Posts, post)
+		}
+	}
+	return src, nil
+}
+
+// ReadFile 读取压缩包内的文件，不存在时返回 false
+func (s *Source) ReadFile(name string) ([]byte, bool) {
+	f, ok := s.files[cleanZipPath(name)]
+	if !ok {
+		return nil, false
+	}
+	content, err := readZipFile(f)
+	if err != nil {
+		return nil, false
+	}
+	return content, true
+}
+
+// ResolveLocal 将文章中的本地图片引用解析为压缩包内路径。
+// 依次尝试：文章目录、Hexo 资源目录、压缩包根目录以及 Hexo source/、Hugo static/ 目录
+func (s *Source) ResolveLocal(post *Post, ref string) (string, bool) {
+	if len(s.files) == 0 || IsRemote(ref) || strings.HasPrefix(ref, "data:") {
+		return "", false
+	}
+	ref = strings.SplitN(strings.SplitN(ref, "#", 2)[0], "?", 2)[0]
+	var candidates []string
+	if strings.HasPrefix(ref, "/") {
+		for _, root := range []string{"", "source", "static", "public"} {
+			candidates = append(candidates, path.Join(root, ref))
+		}
+		// 站点可能整体位于压缩包的某个子目录下
+		for name := range s.files {
+			if strings.HasSuffix(name, ref) {
+				candidates = append(candidates, name)
+			}
+		}
+	} else {
+		candidates = append(candidates, path.Join(post.Dir, ref))
+		if post.AssetDir != "" {
+			candidates = append(candidates, path.Join(post.AssetDir, ref))
+		}
+		candidates = append(candidates, ref, path.Join("source", ref), path.Join("static", ref))
+	}
+	for _, c := range candidates {
+		if c = cleanZipPath(c); c != "" {
+			if _, ok := s.files[c]; ok {
+				return c, true
+			}
+		}
+	}
+	return "", false
+}
+
+// IsRemote 是否为 http(s) 远程地址
+func IsRemote(ref string) bool {
+	lower := strings.ToLower(ref)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "//")
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(io.LimitReader(rc, maxEntrySize))
+}
+
+// cleanZipPath 规范化压缩包内路径，拒绝越出根目录的路径
+func cleanZipPath(name string) string {
+	name = path.Clean("/" + strings.ReplaceAll(name, "\\", "/"))
+	return strings.TrimPrefix(name, "/")
+}
+
+// isIgnored 忽略 macOS 元数据、隐藏文件与主题、依赖目录
+func isIgnored(name string) bool {
+	for _, part := range strings.Split(name, "/") {
+		if part == "__MACOSX" || part == "node_modules" || part == "themes" || (strings.HasPrefix(part, ".") && part != ".") {
+			return true
+		}
+	}
+	return false
+}
+
+func looksLikeWXR(data []byte) bool {
+	head := data
+	if len(head) > 4096 {
+		head = head[:4096]
+	}
+	return bytes.Contains(head, []byte("<rss")) && bytes.Contains(data, []byte("wordpress.org/export"))
+}
@@ -0,0 +1,186 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestImageRefsAndRewrite(t *testing.T) {
+	content := `![a](img/a.png "title") ![b]( <b c.png> ) ![inline](data:image/png;base64,xx)
+<img class="x" src='https://example.com/c.jpg' alt=""> ![a again](img/a.png)
+text img/a.png stays`
+	want := []string{"img/a.png", "https://example.com/c.jpg"}
+	if got := ImageRefs(content); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ImageRefs: got %q, want %q", got, want)
+	}
+
+	out := RewriteImages(content, map[string]string{
+		"img/a.png":                 "/file/download/1",
+		"https://example.com/c.jpg": "/file/download/2",
+	})
+	for _, s := range []string{`![a](/file/download/1 "title")`, `src='/file/download/2'`, `![a again](/file/download/1)`, "text img/a.png stays"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("RewriteImages: %q missing in %q", s, out)
+		}
+	}
+}
+
+func TestParseMarkdown(t *testing.T) {
+	cases := []struct {
+		name string
+		file string
+		data string
+		want *Post
+	}{
+		{
+			name: "hexo",
+			file: "source/_posts/2019-01-02-hello.md",
+			data: "---\ntitle: Hello\ntags: [Go, go, web]\ncategories:\n  - [Tech, Backend]\ncover: cover.png\n---\nIntro\n<!-- more -->\n{% asset_img pic.png \"A pic\" %}\n",
+			want: &Post{
+				Source: "source/_posts/2019-01-02-hello.md", Title: "Hello", Slug: "hello",
+				Date:     time.Date(2019, 1, 2, 0, 0, 0, 0, time.Local),
+				Tags:     []string{"Go", "web"},
+				Category: []string{"Tech", "Backend"},
+				Summary:  "Intro", Cover: "cover.png",
+				Content: "Intro\n<!-- more -->\n![A pic](pic.png)",
+				Dir:     "source/_posts", AssetDir: "source/_posts/2019-01-02-hello",
+			},
+		},
+		{
+			name: "hugo page bundle",
+			file: "content/posts/my-post/index.md",
+			data: "+++\ntitle = \"T\"\ndraft = true\ncategories = \"Notes\"\n+++\nBody\r\n",
+			want: &Post{
+				Source: "content/posts/my-post/index.md", Title: "T", Slug: "my-post",
+				Category: []string{"Notes"}, Draft: true, Content: "Body", Dir: "content/posts/my-post",
+			},
+		},
+	}
+	for _, tc := range cases {
+		got, err := ParseMarkdown(tc.file, []byte(tc.data))
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if got.Date.Equal(tc.want.Date) {
+			got.Date = tc.want.Date
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s:\n got %+v\nwant %+v", tc.name, got, tc.want)
+		}
+	}
+
+	if post, err := ParseMarkdown("content/_index.md", []byte("---\ntitle: x\n---\n")); post != nil || err != nil {
+		t.Errorf("_index.md: got %v, %v", post, err)
+	}
+	if _, err := ParseMarkdown("a.md", []byte("no front matter")); err == nil {
+		t.Error("missing front matter: expected error")
+	}
+	if _, err := ParseMarkdown("a.md", []byte("---\ntitle: x\n")); err == nil {
+		t.Error("unclosed front matter: expected error")
+	}
+}
+
+const sampleWXR = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:excerpt="http://wordpress.org/export/1.2/excerpt/"
+	xmlns:content="http://purl.org/rss/1.0/modules/content/" xmlns:wp="http://wordpress.org/export/1.2/">
+<channel>
+	<wp:category><wp:category_nicename>tech</wp:category_nicename><wp:category_parent></wp:category_parent><wp:cat_name>Tech</wp:cat_name></wp:category>
+	<wp:category><wp:category_nicename>go</wp:category_nicename><wp:category_parent>tech</wp:category_parent><wp:cat_name>Go</wp:cat_name></wp:category>
+	<item>
+		<title>Hello</title><link>https://old.example/hello</link>
+		<wp:post_id>1</wp:post_id><wp:post_name>%e4%bd%a0%e5%a5%bd</wp:post_name>
+		<wp:post_date_gmt>2020-03-04 05:06:07</wp:post_date_gmt>
+		<wp:status>publish</wp:status><wp:post_type>post</wp:post_type>
+		<content:encoded><![CDATA[<img src="a.jpg" srcset="a-300.jpg 300w">]]></content:encoded>
+		<excerpt:encoded><![CDATA[Short]]></excerpt:encoded>
+		<category domain="category" nicename="go"><![CDATA[Go]]></category>
+		<category domain="post_tag" nicename="x"><![CDATA[X]]></category>
+		<wp:postmeta><wp:meta_key>_thumbnail_id</wp:meta_key><wp:meta_value>9</wp:meta_value></wp:postmeta>
+	</item>
+	<item>
+		<title>Cover</title><wp:post_id>9</wp:post_id><wp:post_type>attachment</wp:post_type>
+		<wp:attachment_url>https://old.example/cover.jpg</wp:attachment_url>
+	</item>
+	<item><title>About</title><wp:post_id>2</wp:post_id><wp:status>publish</wp:status><wp:post_type>page</wp:post_type></item>
+	<item><title>Old</title><wp:post_id>3</wp:post_id><wp:status>trash</wp:status><wp:post_type>post</wp:post_type></item>
+</channel>
+</rss>`
+
+func TestParseWXR(t *testing.T) {
+	src, err := Parse([]byte(sampleWXR))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if src.Format != FormatWXR || len(src.Posts) != 1 {
+		t.Fatalf("got format %s with %d posts", src.Format, len(src.Posts))
+	}
+	want := &Post{
+		Source: "https://old.example/hello", Title: "Hello", Slug: "你好",
+		Date:          time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC),
+		Tags:          []string{"X"},
+		Category:      []string{"Tech", "Go"},
+		CategorySlugs: []string{"tech", "go"},
+		Summary:       "Short",
+		Cover:         "https://old.example/cover.jpg",
+		Content:       `<img src="a.jpg">`,
+	}
+	if got := src.Posts[0]; !reflect.DeepEqual(got, want) {
+		t.Errorf("\n got %+v\nwant %+v", got, want)
+	}
+	if len(src.Warnings) != 2 {
+		t.Errorf("warnings: %v", src.Warnings)
+	}
+}
+
+func TestParseZipAndResolveLocal(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, body := range map[string]string{
+		"site/source/_posts/post.md":      "---\ntitle: P\n---\n![x](pic.png) ![y](/images/y.png)",
+		"site/source/_posts/post/pic.png": "png",
+		"site/source/images/y.png":        "png",
+		"__MACOSX/site/._post.md":         "junk",
+		"../evil.md":                      "---\ntitle: E\n---\n",
+	} {
+		w, _ := zw.Create(name)
+		_, _ = w.Write([]byte(body))
+	}
+	_ = zw.Close()
+
+	src, err := Parse(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if src.Format != FormatMarkdown || len(src.Posts) != 2 {
+		t.Fatalf("got format %s with %d posts", src.Format, len(src.Posts))
+	}
+	var post *Post
+	for _, p := range src.Posts {
+		if p.Title == "P" {
+			post = p
+		}
+		if strings.Contains(p.Source, "..") {
+			t.Errorf("path escapes archive root: %s", p.Source)
+		}
+	}
+	cases := map[string]string{
+		"pic.png":        "site/source/_posts/post/pic.png",
+		"/images/y.png":  "site/source/images/y.png",
+		"missing.png":    "",
+		"http://x/a.png": "",
+	}
+	for ref, want := range cases {
+		got, ok := src.ResolveLocal(post, ref)
+		if got != want || ok != (want != "") {
+			t.Errorf("ResolveLocal(%q) = %q, %v; want %q", ref, got, ok, want)
+		}
+	}
+
+	if _, err := Parse([]byte("plain text")); err == nil {
+		t.Error("unsupported format: expected error")
+	}
+}
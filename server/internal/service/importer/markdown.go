@@ -0,0 +1,213 @@
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/encoding/gjson"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+var (
+	// Jekyll/Hexo 风格文件名中的日期前缀：2019-01-02-title.md
+	datePrefixPattern = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})-(.+)$`)
+	// Hexo 资源标签：{% asset_img name [title] %}
+	assetImgPattern = regexp.MustCompile(`\{%\s*asset_img\s+(\S+)(?:\s+([^%]*?))?\s*%\}`)
+	// Hexo 摘要分隔符
+	moreMarker = "<!-- more -->"
+)
+
+// ParseMarkdown 解析带 YAML（---）或 TOML（+++）Front Matter 的 Markdown 文件。
+// 没有 Front Matter 的文件与 Hugo 的 _index.md 章节页不视为文章，返回 nil
+func ParseMarkdown(name string, data []byte) (*Post, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	base := path.Base(name)
+	if strings.HasPrefix(base, "_index.") {
+		return nil, nil
+	}
+
+	meta, body, err := splitFrontMatter(data)
+	if err != nil {
+		return nil, err
+	}
+	if meta == nil {
+		return nil, errors.New("缺少 Front Matter，已跳过")
+	}
+
+	dir := path.Dir(name)
+	if dir == "." {
+		dir = ""
+	}
+	stem := strings.TrimSuffix(base, path.Ext(base))
+	post := &Post{
+		Source:  name,
+		Title:   strings.TrimSpace(meta.Get("title").String()),
+		Slug:    strings.TrimSpace(meta.Get("slug").String()),
+		Date:    parseTime(meta.Get("date").Val()),
+		Updated: parseTime(firstNonNil(meta, "updated", "lastmod", "modified")),
+		Tags:    stringList(meta.Get("tags").Val()),
+		Summary: strings.TrimSpace(gconv.String(firstNonNil(meta, "description", "summary", "excerpt"))),
+		Cover:   strings.TrimSpace(coverOf(meta)),
+		Dir:     dir,
+	}
+
+	// Hugo 页面包 dir/index.md 以目录名作为 slug
+	if stem == "index" && dir != "" {
+		stem = path.Base(dir)
+	} else {
+		post.AssetDir = path.Join(dir, stem)
+	}
+	if m := datePrefixPattern.FindStringSubmatch(stem); m != nil {
+		stem = m[2]
+		if post.Date.IsZero() {
+			post.Date = parseTime(m[1])
+		}
+	}
+	if post.Slug == "" {
+		post.Slug = stem
+	}
+	if post.Title == "" {
+		post.Title = stem
+	}
+
+	// 分类：列表视为层级路径（Hexo 语义），嵌套列表取第一组
+	post.Category = categoryPath(meta.Get("categories").Val())
+	if len(post.Category) == 0 {
+		post.Category = stringList(meta.Get("category").Val())
+	}
+
+	// 草稿：Hugo draft: true、Hexo published: false 或位于 _drafts 目录
+	post.Draft = meta.Get("draft").Bool() ||
+		(meta.Contains("published") && !meta.Get("published").Bool()) ||
+		strings.Contains("/"+dir+"/", "/_drafts/")
+
+	content := strings.TrimSpace(string(body))
+	content = assetImgPattern.ReplaceAllStringFunc(content, func(s string) string {
+		m := assetImgPattern.FindStringSubmatch(s)
+		return "![" + strings.Trim(m[2], `"' `) + "](" + m[1] + ")"
+	})
+	if i := strings.Index(content, moreMarker); i >= 0 && post.Summary == "" {
+		post.Summary = strings.TrimSpace(content[:i])
+	}
+	post.Content = content
+	return post, nil
+}
+
+// splitFrontMatter 拆分 Front Matter 与正文，无 Front Matter 时 meta 为 nil
+func splitFrontMatter(data []byte) (*gjson.Json, []byte, error) {
+	var delim string
+	var kind gjson.ContentType
+	switch {
+	case bytes.HasPrefix(data, []byte("---\n")):
+		delim, kind = "---", gjson.ContentTypeYaml
+	case bytes.HasPrefix(data, []byte("+++\n")):
+		delim, kind = "+++", gjson.ContentTypeToml
+	default:
+		return nil, data, nil
+	}
+	rest := data[len(delim)+1:]
+	end := bytes.Index(rest, []byte("\n"+delim))
+	if end < 0 {
+		return nil, nil, errors.New("Front Matter 未闭合")
+	}
+	head, body := rest[:end], rest[end+len(delim)+1:]
+	if len(bytes.TrimSpace(head)) == 0 {
+		return gjson.New(nil), body, nil
+	}
+	meta, err := gjson.LoadContentType(kind, head, true)
+	if err != nil {
+		return nil, nil, errors.New("Front Matter 解析失败: " + err.Error())
+	}
+	return meta, body, nil
+}
+
+func firstNonNil(meta *gjson.Json, keys ...string) interface{} {
+	for _, k := range keys {
+		if v := meta.Get(k); !v.IsNil() && v.String() != "" {
+			return v.Val()
+		}
+	}
+	return nil
+}
+
+func coverOf(meta *gjson.Json) string {
+	for _, k := range []string{"cover", "featured_image", "thumbnail", "image", "banner_img"} {
+		if v := meta.Get(k); !v.IsNil() {
+			if list := stringList(v.Val()); len(list) > 0 {
+				return list[0]
+			}
+		}
+	}
+	if list := stringList(meta.Get("images").Val()); len(list) > 0 {
+		return list[0]
+	}
+	return ""
+}
+
+// stringList 将字符串、逗号分隔字符串或列表转换为去空去重的字符串列表
+func stringList(v interface{}) []string {
+	var items []string
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case string:
+		items = strings.Split(t, ",")
+	case []interface{}:
+		for _, e := range t {
+			if _, nested := e.([]interface{}); nested {
+				items = append(items, stringList(e)...)
+				continue
+			}
+			items = append(items, gconv.String(e))
+		}
+	default:
+		items = gconv.Strings(t)
+	}
+	out := make([]string, 0, len(items))
+	seen := make(map[string]bool, len(items))
+	for _, s := range items {
+		s = strings.TrimSpace(s)
+		if key := strings.ToLower(s); s != "" && !seen[key] {
+			seen[key] = true
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func categoryPath(v interface{}) []string {
+	if list, ok := v.([]interface{}); ok && len(list) > 0 {
+		if nested, ok := list[0].([]interface{}); ok {
+			return stringList(nested)
+		}
+	}
+	return stringList(v)
+}
+
+// parseTime 解析 Front Matter 或 WXR 中的时间，无法解析时返回零值
+func parseTime(v interface{}) time.Time {
+	switch t := v.(type) {
+	case nil:
+		return time.Time{}
+	case time.Time:
+		return t
+	default:
+		s := strings.TrimSpace(gconv.String(t))
+		if s == "" || strings.HasPrefix(s, "0000-00-00") {
+			return time.Time{}
+		}
+		if parsed, err := time.Parse(time.RFC1123Z, s); err == nil {
+			return parsed
+		}
+		if parsed, err := gtime.StrToTime(s); err == nil {
+			return parsed.Time
+		}
+		return time.Time{}
+	}
+}
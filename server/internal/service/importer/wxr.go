@@ -0,0 +1,203 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WordPress 为响应式图片生成的 srcset/sizes 属性指向旧站点的缩放版本，导入时移除
+var srcsetPattern = regexp.MustCompile(`\s(?:srcset|sizes)\s*=\s*("[^"]*"|'[^']*')`)
+
+type wxrRSS struct {
+	Channel wxrChannel `xml:"channel"`
+}
+
+type wxrChannel struct {
+	Categories []wxrCategory `xml:"category"`
+	Items      []wxrItem     `xml:"item"`
+}
+
+type wxrCategory struct {
+	Nicename string `xml:"category_nicename"`
+	Parent   string `xml:"category_parent"`
+	Name     string `xml:"cat_name"`
+}
+
+type wxrItem struct {
+	Title         string        `xml:"title"`
+	Link          string        `xml:"link"`
+	PubDate       string        `xml:"pubDate"`
+	PostId        string        `xml:"post_id"`
+	PostName      string        `xml:"post_name"`
+	PostDate      string        `xml:"post_date"`
+	PostDateGmt   string        `xml:"post_date_gmt"`
+	Modified      string        `xml:"post_modified"`
+	ModifiedGmt   string        `xml:"post_modified_gmt"`
+	Status        string        `xml:"status"`
+	PostType      string        `xml:"post_type"`
+	AttachmentURL string        `xml:"attachment_url"`
+	Encoded       []wxrEncoded  `xml:"encoded"`
+	Terms         []wxrTerm     `xml:"category"`
+	Meta          []wxrPostMeta `xml:"postmeta"`
+}
+
+// wxrEncoded content:encoded 与 excerpt:encoded 本地名相同，按命名空间区分
+type wxrEncoded struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+type wxrTerm struct {
+	Domain   string `xml:"domain,attr"`
+	Nicename string `xml:"nicename,attr"`
+	Name     string `xml:",chardata"`
+}
+
+type wxrPostMeta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+// ParseWXR 解析 WordPress 导出文件（WXR 1.x），只导入文章（post），
+// 跳过页面、附件、导航菜单与回收站中的条目
+func ParseWXR(data []byte) ([]*Post, []string, error) {
+	var rss wxrRSS
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.Strict = false
+	dec.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) { return input, nil }
+	if err := dec.Decode(&rss); err != nil {
+		return nil, nil, errors.New("WXR 解析失败: " + err.Error())
+	}
+
+	// 分类层级：nicename -> 分类
+	categories := make(map[string]wxrCategory, len(rss.Channel.Categories))
+	for _, c := range rss.Channel.Categories {
+		categories[c.Nicename] = c
+	}
+	// 附件：post_id -> 地址，用于解析特色图片
+	attachments := make(map[string]string)
+	for _, item := range rss.Channel.Items {
+		if item.PostType == "attachment" && item.AttachmentURL != "" {
+			attachments[item.PostId] = strings.TrimSpace(item.AttachmentURL)
+		}
+	}
+
+	var posts []*Post
+	var warnings []string
+	skipped := make(map[string]int)
+	for _, item := range rss.Channel.Items {
+		if item.PostType != "post" {
+			if item.PostType != "attachment" {
+				skipped[item.PostType]++
+			}
+			continue
+		}
+		if item.Status == "trash" || item.Status == "auto-draft" || item.Status == "inherit" {
+			skipped[item.Status]++
+			continue
+		}
+
+		post := &Post{
+			Source:  item.Link,
+			Title:   strings.TrimSpace(item.Title),
+			Slug:    unescapeSlug(item.PostName),
+			Draft:   item.Status == "draft" || item.Status == "pending",
+			Private: item.Status == "private",
+		}
+		if post.Source == "" {
+			post.Source = "post#" + item.PostId
+		}
+		if post.Date = parseWXRTime(item.PostDateGmt, true); post.Date.IsZero() {
+			if post.Date = parseWXRTime(item.PostDate, false); post.Date.IsZero() {
+				post.Date = parseTime(item.PubDate)
+			}
+		}
+		if post.Updated = parseWXRTime(item.ModifiedGmt, true); post.Updated.IsZero() {
+			post.Updated = parseWXRTime(item.Modified, false)
+		}
+		for _, enc := range item.Encoded {
+			switch {
+			case strings.Contains(enc.XMLName.Space, "/excerpt/"):
+				post.Summary = strings.TrimSpace(enc.Value)
+			case strings.Contains(enc.XMLName.Space, "/content/"):
+				post.Content = strings.TrimSpace(srcsetPattern.ReplaceAllString(enc.Value, ""))
+			}
+		}
+		var tags []interface{}
+		for _, term := range item.Terms {
+			switch term.Domain {
+			case "post_tag":
+				tags = append(tags, term.Name)
+			case "category":
+				if len(post.Category) == 0 {
+					post.Category, post.CategorySlugs = wxrCategoryPath(categories, term)
+				}
+			}
+		}
+		post.Tags = stringList(tags)
+		for _, m := range item.Meta {
+			if m.Key == "_thumbnail_id" {
+				post.Cover = attachments[strings.TrimSpace(m.Value)]
+			}
+		}
+		if post.Title == "" {
+			post.Title = post.Slug
+		}
+		posts = append(posts, post)
+	}
+	for kind, n := range skipped {
+		warnings = append(warnings, "跳过 "+kind+" 条目 "+strconv.Itoa(n)+" 个")
+	}
+	return posts, warnings, nil
+}
+
+// wxrCategoryPath 根据频道分类表还原分类的完整层级
+func wxrCategoryPath(categories map[string]wxrCategory, term wxrTerm) ([]string, []string) {
+	names := []string{strings.TrimSpace(term.Name)}
+	slugs := []string{unescapeSlug(term.Nicename)}
+	seen := map[string]bool{term.Nicename: true}
+	parent := categories[term.Nicename].Parent
+	for parent != "" && !seen[parent] {
+		seen[parent] = true
+		c, ok := categories[parent]
+		if !ok {
+			break
+		}
+		names = append([]string{strings.TrimSpace(c.Name)}, names...)
+		slugs = append([]string{unescapeSlug(c.Nicename)}, slugs...)
+		parent = c.Parent
+	}
+	return names, slugs
+}
+
+// unescapeSlug WordPress 以百分号编码保存非 ASCII 的 slug
+func unescapeSlug(s string) string {
+	s = strings.TrimSpace(s)
+	if u, err := url.PathUnescape(s); err == nil {
+		return strings.ToLower(u)
+	}
+	return strings.ToLower(s)
+}
+
+func parseWXRTime(s string, utc bool) time.Time {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.HasPrefix(s, "0000-00-00") {
+		return time.Time{}
+	}
+	loc := time.Local
+	if utc {
+		loc = time.UTC
+	}
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", s, loc)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
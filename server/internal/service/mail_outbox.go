@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/encoding/gjson"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/os/gview"
+	"github.com/gogf/gf/v2/text/gstr"
+
+	"server/internal/dao"
+	"server/internal/model/do"
+	"server/internal/model/entity"
+	"server/internal/service/antispam"
+	"server/internal/service/configcache"
+	"server/internal/service/mailer"
+)
+
+// 发件箱邮件状态
+const (
+	MailStatusPending = "pending"
+	MailStatusSending = "sending"
+	MailStatusSent    = "sent"
+	MailStatusFailed  = "failed"
+	MailStatusBounced = "bounced"
+	MailStatusSkipped = "skipped"
+)
+
+const (
+	// mailTemplateDir 邮件模板目录（位于 resource/template 下），每种邮件包含 .html 与 .txt 两个模板
+	mailTemplateDir = "mail"
+	// mailClaimTimeout 认领后未完成的邮件（进程中断等）超过该时间重新进入待发送
+	mailClaimTimeout = 10 * time.Minute
+	// mailMaxBackoff 重试间隔上限
+	mailMaxBackoff = 6 * time.Hour
+	// mailTestKind 测试邮件类型
+	mailTestKind = "test"
+)
+
+// MailRenderer 按发件箱记录渲染邮件；返回 errMailSkipped 表示无需再发送（如订阅者已退订）
+type MailRenderer func(ctx context.Context, item *entity.MailOutbox, payload *gjson.Json) (*mailer.Message, error)
+
+var (
+	errMailSkipped = errors.New("无需发送")
+	mailRenderers  = map[string]MailRenderer{}
+	// mailLimiter 进程内的发送频率限制
+	mailLimiter = antispam.NewLimiter()
+)
+
+// registerMailRenderer 注册邮件类型的渲染函数，在各功能模块的 init 中调用
+func registerMailRenderer(kind string, renderer MailRenderer) {
+	mailRenderers[kind] = renderer
+}
+
+func init() {
+	registerMailRenderer(mailTestKind, func(ctx context.Context, item *entity.MailOutbox, payload *gjson.Json) (*mailer.Message, error) {
+		return renderMail(ctx, item.Recipient, "test", g.Map{"title": "SMTP 测试邮件"})
+	})
+}
+
+// IMailOutbox 邮件发件箱服务接口
+type IMailOutbox interface {
+	// Enqueue 写入一封待发送邮件，由后台任务渲染并发送
+	Enqueue(ctx context.Context, kind, recipient string, payload g.Map) error
+	// ProcessDue 发送到期的邮件，返回本次成功发送的数量；SMTP 未配置时邮件保留在发件箱中
+	ProcessDue(ctx context.Context) (sent int, err error)
+	// SendTest 立即发送一封测试邮件并记录到发件箱，用于检查 SMTP 配置
+	SendTest(ctx context.Context, recipient string) error
+	// List 发件记录，按创建时间倒序
+	List(ctx context.Context, status, kind string, page, size int) ([]*entity.MailOutbox, int, error)
+	// Retry 将失败或退信的邮件重新放回待发送
+	Retry(ctx context.Context, id int64) error
+}
+
+type sMailOutbox struct{}
+
+// MailOutbox 邮件发件箱服务实例
+func MailOutbox() IMailOutbox {
+	return &sMailOutbox{}
+}
+
+// LoadMailConfig 从动态配置读取 SMTP 设置
+func LoadMailConfig(ctx context.Context) *mailer.Config {
+	get := func(key, def string) string {
+		return strings.TrimSpace(configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, key, def))
+	}
+	return &mailer.Config{
+		Host:     get("smtp_host", ""),
+		Port:     configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "smtp_port", 587),
+		Username: get("smtp_username", ""),
+		Password: configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "smtp_password", ""),
+		From:     get("smtp_from", ""),
+		FromName: firstNonEmpty(get("smtp_from_name", ""), LoadSiteInfo(ctx).Title),
+		TLS:      get("smtp_tls", mailer.TLSStartTLS),
+		Timeout:  time.Duration(configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "smtp_timeout_seconds", 30)) * time.Second,
+	}
+}
+
+// Enqueue 写入发件箱
+func (s *sMailOutbox) Enqueue(ctx context.Context, kind, recipient string, payload g.Map) error {
+	return enqueueMailAt(ctx, kind, recipient, payload, time.Now())
+}
+
+// enqueueMailAt 写入一封在指定时间之后发送的邮件
+func enqueueMailAt(ctx context.Context, kind, recipient string, payload g.Map, at time.Time) error {
+	if _, ok := mailRenderers[kind]; !ok {
+		return gerror.Newf("未知的邮件类型: %s", kind)
+	}
+	if payload == nil {
+		payload = g.Map{}
+	}
+	_, err := dao.MailOutbox.Ctx(ctx).Data(do.MailOutbox{
+		Kind:          kind,
+		Recipient:     recipient,
+		Payload:       gjson.MustEncodeString(payload),
+		Status:        MailStatusPending,
+		NextAttemptAt: gtime.New(at),
+	}).Insert()
+	if err != nil {
+		return gerror.Wrap(err, "写入发件箱失败")
+	}
+	return nil
+}
+
+// ProcessDue 发送到期邮件
+//
+// 通过 FOR UPDATE SKIP LOCKED 认领邮件并同时推迟 next_attempt_at，多实例运行时每封邮件只会被一个实例发送；
+// 进程在发送途中退出时，邮件在 mailClaimTimeout 后重新被认领。
+func (s *sMailOutbox) ProcessDue(ctx context.Context) (sent int, err error) {
+	cfg := LoadMailConfig(ctx)
+	if cfg.Host == "" || cfg.From == "" {
+		return 0, nil
+	}
+	rate := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "smtp_rate_per_minute", 30)
+	batch := rate
+	if batch <= 0 || batch > 100 {
+		batch = 100
+	}
+
+	var items []*entity.MailOutbox
+	err = g.DB().Ctx(ctx).GetScan(ctx, &items, `UPDATE mail_outbox
+		SET status = ?, attempts = attempts + 1, next_attempt_at = NOW() + make_interval(secs => ?)
+		WHERE id IN (
+			SELECT id FROM mail_outbox
+			WHERE status IN (?, ?) AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, MailStatusSending, int(mailClaimTimeout.Seconds()), MailStatusPending, MailStatusSending, batch)
+	if err != nil {
+		return 0, gerror.Wrap(err, "认领待发送邮件失败")
+	}
+
+	maxAttempts := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "smtp_max_attempts", 5)
+	for i, item := range items {
+		if !mailLimiter.Allow("smtp", rate, time.Minute) {
+			// 超出频率限制：剩余邮件放回待发送，不计入尝试次数
+			s.release(ctx, items[i:])
+			break
+		}
+		if s.deliver(ctx, cfg, item, maxAttempts) {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// deliver 渲染并发送一封已认领的邮件，记录结果
+func (s *sMailOutbox) deliver(ctx context.Context, cfg *mailer.Config, item *entity.MailOutbox, maxAttempts int) bool {
+	msg, err := s.render(ctx, item)
+	if err == nil {
+		err = mailer.Send(ctx, cfg, msg)
+	}
+	switch {
+	case err == nil:
+		s.finish(ctx, item.Id, g.Map{"status": MailStatusSent, "subject": gstr.SubStrRune(msg.Subject, 0, 255), "sent_at": time.Now(), "last_error": ""})
+		trackSubscriberDelivery(ctx, item)
+		return true
+	case errors.Is(err, errMailSkipped):
+		s.finish(ctx, item.Id, g.Map{"status": MailStatusSkipped, "last_error": ""})
+	case mailer.IsPermanent(err):
+		g.Log().Warningf(ctx, "邮件被拒收: id=%d to=%s err=%v", item.Id, item.Recipient, err)
+		s.finish(ctx, item.Id, g.Map{"status": MailStatusBounced, "last_error": err.Error()})
+		trackSubscriberBounce(ctx, item)
+	case item.Attempts >= maxAttempts:
+		g.Log().Errorf(ctx, "邮件重试次数耗尽: id=%d to=%s err=%v", item.Id, item.Recipient, err)
+		s.finish(ctx, item.Id, g.Map{"status": MailStatusFailed, "last_error": err.Error()})
+	default:
+		// 指数退避：1、2、4…分钟，上限 mailMaxBackoff
+		backoff := time.Minute << uint(item.Attempts-1)
+		if backoff <= 0 || backoff > mailMaxBackoff {
+			backoff = mailMaxBackoff
+		}
+		s.finish(ctx, item.Id, g.Map{"status": MailStatusPending, "next_attempt_at": time.Now().Add(backoff), "last_error": err.Error()})
+	}
+	return false
+}
+
+// render 按邮件类型渲染
+func (s *sMailOutbox) render(ctx context.Context, item *entity.MailOutbox) (*mailer.Message, error) {
+	renderer, ok := mailRenderers[item.Kind]
+	if !ok {
+		return nil, errMailSkipped
+	}
+	payload, err := gjson.DecodeToJson(item.Payload)
+	if err != nil {
+		return nil, gerror.Wrap(err, "解析邮件数据失败")
+	}
+	return renderer(ctx, item, payload)
+}
+
+func (s *sMailOutbox) finish(ctx context.Context, id int64, data g.Map) {
+	if _, err := dao.MailOutbox.Ctx(ctx).Where("id", id).Data(data).Update(); err != nil {
+		g.Log().Errorf(ctx, "更新邮件状态失败: id=%d err=%v", id, err)
+	}
+}
+
+// release 将已认领但未发送的邮件放回待发送
+func (s *sMailOutbox) release(ctx context.Context, items []*entity.MailOutbox) {
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+	_, err := g.DB().Ctx(ctx).Exec(ctx, `UPDATE mail_outbox SET status = ?, attempts = attempts - 1, next_attempt_at = NOW()
+		WHERE id IN (?)`, MailStatusPending, ids)
+	if err != nil {
+		g.Log().Errorf(ctx, "释放待发送邮件失败: %v", err)
+	}
+}
+
+// SendTest 发送测试邮件
+func (s *sMailOutbox) SendTest(ctx context.Context, recipient string) error {
+	if !mailer.ValidAddress(recipient) {
+		return gerror.NewCode(gcode.CodeInvalidParameter, "邮箱地址无效")
+	}
+	cfg := LoadMailConfig(ctx)
+	item := &entity.MailOutbox{Kind: mailTestKind, Recipient: recipient, Payload: "{}"}
+	msg, err := s.render(ctx, item)
+	if err != nil {
+		return err
+	}
+	sendErr := mailer.Send(ctx, cfg, msg)
+
+	data := do.MailOutbox{
+		Kind:      mailTestKind,
+		Recipient: recipient,
+		Payload:   "{}",
+		Subject:   msg.Subject,
+		Status:    MailStatusSent,
+		Attempts:  1,
+		SentAt:    gtime.Now(),
+	}
+	if sendErr != nil {
+		data.Status, data.SentAt, data.LastError = MailStatusFailed, nil, sendErr.Error()
+	}
+	if _, err = dao.MailOutbox.Ctx(ctx).Data(data).Insert(); err != nil {
+		g.Log().Warningf(ctx, "记录测试邮件失败: %v", err)
+	}
+	if sendErr != nil {
+		return gerror.WrapCode(gcode.CodeOperationFailed, sendErr, "发送测试邮件失败")
+	}
+	return nil
+}
+
+// List 发件记录
+func (s *sMailOutbox) List(ctx context.Context, status, kind string, page, size int) ([]*entity.MailOutbox, int, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+	m := dao.MailOutbox.Ctx(ctx)
+	if status != "" {
+		m = m.Where("status", status)
+	}
+	if kind != "" {
+		m = m.Where("kind", kind)
+	}
+	total, err := m.Count()
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询发件记录总数失败")
+	}
+	var items []*entity.MailOutbox
+	if err = m.Order("created_at DESC, id DESC").Limit((page-1)*size, size).Scan(&items); err != nil {
+		return nil, 0, gerror.Wrap(err, "查询发件记录失败")
+	}
+	return items, total, nil
+}
+
+// Retry 重新发送
+func (s *sMailOutbox) Retry(ctx context.Context, id int64) error {
+	result, err := dao.MailOutbox.Ctx(ctx).
+		Where("id", id).
+		WhereIn("status", g.Slice{MailStatusFailed, MailStatusBounced}).
+		Data(g.Map{"status": MailStatusPending, "attempts": 0, "next_attempt_at": time.Now()}).
+		Update()
+	if err != nil {
+		return gerror.Wrap(err, "重新发送邮件失败")
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return gerror.NewCode(gcode.CodeNotFound, "邮件不存在或不需要重新发送")
+	}
+	return nil
+}
+
+// renderMail 使用 resource/template/mail 下的 <name>.html 与 <name>.txt 渲染邮件，
+// 邮件主题取自 HTML 模板的 <title>
+func renderMail(ctx context.Context, to, name string, params g.Map) (*mailer.Message, error) {
+	params["site"] = LoadSiteInfo(ctx)
+	htmlView := gview.New()
+	htmlView.SetAutoEncode(true)
+	htmlBody, err := htmlView.Parse(ctx, mailTemplateDir+"/"+name+".html", params)
+	if err != nil {
+		return nil, gerror.Wrapf(err, "渲染邮件模板 %s 失败", name)
+	}
+	text, err := gview.New().Parse(ctx, mailTemplateDir+"/"+name+".txt", params)
+	if err != nil {
+		return nil, gerror.Wrapf(err, "渲染邮件模板 %s 失败", name)
+	}
+	return &mailer.Message{
+		To:      to,
+		Subject: htmlTitle(htmlBody),
+		HTML:    htmlBody,
+		Text:    strings.TrimSpace(text) + "\n",
+	}, nil
+}
+
+// htmlTitle 提取 HTML 中 <title> 的文本作为邮件主题
+func htmlTitle(body string) string {
+	start := strings.Index(body, "<title>")
+	end := strings.Index(body, "</title>")
+	if start < 0 || end <= start {
+		return ""
+	}
+	return html.UnescapeString(strings.TrimSpace(body[start+len("<title>") : end]))
+}
+
+// StartMailWorker 启动发件箱发送任务，间隔由 blog/default mail_worker_interval_seconds 配置
+func StartMailWorker(ctx context.Context) {
+	go func() {
+		for {
+			interval := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "mail_worker_interval_seconds", 15)
+			if interval < 1 {
+				interval = 1
+			}
+			select {
+			case <-ctx.Done():
+				g.Log().Info(ctx, "邮件发送任务已停止")
+				return
+			case <-time.After(time.Duration(interval) * time.Second):
+				if _, err := MailOutbox().ProcessDue(ctx); err != nil {
+					g.Log().Errorf(ctx, "发送邮件失败: %v", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,256 @@
+// Package mailer 通过 SMTP 发送 HTML 邮件（附带纯文本备选正文）。
+//
+// 支持明文、STARTTLS 与隐式 TLS 三种连接方式；未配置用户名时不进行认证，
+// 因此可以直接指向本地 SMTP 测试服务（如 MailHog、Mailpit 的 1025 端口）。
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 连接方式
+const (
+	TLSNone     = "none"     // 明文（本地测试服务）
+	TLSStartTLS = "starttls" // 明文连接后升级（通常为 587 端口）
+	TLSImplicit = "tls"      // 直接建立 TLS 连接（通常为 465 端口）
+)
+
+// Config SMTP 连接配置
+type Config struct {
+	Host     string
+	Port     int
+	Username string // 为空时不认证
+	Password string
+	From     string // 发件地址
+	FromName string // 发件人显示名称
+	TLS      string // none/starttls/tls
+	Timeout  time.Duration
+}
+
+// Message 待发送的邮件
+type Message struct {
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+	Headers map[string]string // 额外邮件头，如 List-Unsubscribe
+}
+
+// SendError 发送失败；Permanent 表示收件人被拒收（收件地址无效、邮箱不存在等），属于退信，重试没有意义。
+// 认证、发件人被拒等错误是发信配置问题，不视为永久失败
+type SendError struct {
+	Permanent bool
+	Err       error
+}
+
+func (e *SendError) Error() string { return e.Err.Error() }
+
+func (e *SendError) Unwrap() error { return e.Err }
+
+// IsPermanent 错误是否为永久失败（退信）
+func IsPermanent(err error) bool {
+	var se *SendError
+	return errors.As(err, &se) && se.Permanent
+}
+
+// Send 发送一封邮件
+func Send(ctx context.Context, cfg *Config, msg *Message) error {
+	if cfg.Host == "" || cfg.From == "" {
+		return errors.New("SMTP 未配置")
+	}
+	body, err := Build(cfg, msg)
+	if err != nil {
+		return &SendError{Permanent: true, Err: err}
+	}
+	return classify(deliver(ctx, cfg, msg.To, body))
+}
+
+// deliver 建立连接并完成一次 SMTP 事务
+func deliver(ctx context.Context, cfg *Config, to string, body []byte) error {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+	dialer := &net.Dialer{Timeout: timeout}
+	tlsConfig := &tls.Config{ServerName: cfg.Host}
+
+	var conn net.Conn
+	var err error
+	if cfg.TLS == TLSImplicit {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+
+	c, err := smtp.NewClient(conn, cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if cfg.TLS == TLSStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return errors.New("SMTP 服务器不支持 STARTTLS")
+		}
+		if err = c.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+	if cfg.Username != "" {
+		if err = c.Auth(smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)); err != nil {
+			return &stageError{stage: stageAuth, err: err}
+		}
+	}
+	if err = c.Mail(cfg.From); err != nil {
+		return &stageError{stage: stageMail, err: err}
+	}
+	if err = c.Rcpt(to); err != nil {
+		return &stageError{stage: stageRcpt, err: err}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return &stageError{stage: stageData, err: err}
+	}
+	if _, err = w.Write(body); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return &stageError{stage: stageData, err: err}
+	}
+	return c.Quit()
+}
+
+// SMTP 事务阶段
+const (
+	stageAuth = "AUTH"
+	stageMail = "MAIL FROM"
+	stageRcpt = "RCPT TO"
+	stageData = "DATA"
+)
+
+// stageError 记录出错的 SMTP 命令，用于区分收件人退信与发信配置错误
+type stageError struct {
+	stage string
+	err   error
+}
+
+func (e *stageError) Error() string { return e.stage + ": " + e.err.Error() }
+
+func (e *stageError) Unwrap() error { return e.err }
+
+// classify 区分永久失败与可重试的临时失败：只有 RCPT TO 的 5xx 应答，
+// 以及 DATA 阶段带 5.1.x 增强状态码（收件地址相关）的 5xx 应答视为退信；
+// 认证失败、发件人被拒、4xx 应答与网络错误均可重试
+func classify(err error) error {
+	if err == nil {
+		return nil
+	}
+	var se *stageError
+	var tpErr *textproto.Error
+	if errors.As(err, &se) && errors.As(err, &tpErr) && tpErr.Code >= 500 && tpErr.Code < 600 {
+		switch se.stage {
+		case stageRcpt:
+			return &SendError{Permanent: true, Err: err}
+		case stageData:
+			if strings.HasPrefix(tpErr.Msg, "5.1.") {
+				return &SendError{Permanent: true, Err: err}
+			}
+		}
+	}
+	return &SendError{Err: err}
+}
+
+// Build 生成 MIME 邮件正文（multipart/alternative：纯文本 + HTML）
+func Build(cfg *Config, msg *Message) ([]byte, error) {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return nil, fmt.Errorf("收件地址无效: %w", err)
+	}
+	from := mail.Address{Name: cfg.FromName, Address: cfg.From}
+	boundary := randomToken(12)
+	domain := cfg.From[strings.LastIndex(cfg.From, "@")+1:]
+
+	headers := map[string]string{
+		"From":         from.String(),
+		"To":           to.String(),
+		"Subject":      mime.BEncoding.Encode("utf-8", msg.Subject),
+		"Date":         time.Now().Format(time.RFC1123Z),
+		"Message-ID":   "<" + randomToken(16) + "@" + domain + ">",
+		"MIME-Version": "1.0",
+		"Content-Type": `multipart/alternative; boundary="` + boundary + `"`,
+	}
+	for k, v := range msg.Headers {
+		headers[textproto.CanonicalMIMEHeaderKey(k)] = v
+	}
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, k := range keys {
+		// 去除换行，防止邮件头注入
+		v := strings.NewReplacer("\r", "", "\n", "").Replace(headers[k])
+		buf.WriteString(k + ": " + v + "\r\n")
+	}
+	buf.WriteString("\r\n")
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain", msg.Text},
+		{"text/html", msg.HTML},
+	} {
+		if part.body == "" {
+			continue
+		}
+		buf.WriteString("--" + boundary + "\r\n")
+		buf.WriteString("Content-Type: " + part.contentType + "; charset=utf-8\r\n")
+		buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+		qp := quotedprintable.NewWriter(&buf)
+		if _, err = qp.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+		if err = qp.Close(); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\r\n")
+	}
+	buf.WriteString("--" + boundary + "--\r\n")
+	return buf.Bytes(), nil
+}
+
+// ValidAddress 邮箱地址格式是否有效（只接受不带显示名称的纯地址）
+func ValidAddress(addr string) bool {
+	a, err := mail.ParseAddress(addr)
+	return err == nil && a.Address == addr && a.Name == ""
+}
+
+func randomToken(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return base64.RawURLEncoding.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,211 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuild(t *testing.T) {
+	cfg := &Config{From: "blog@example.com", FromName: "博客"}
+	body, err := Build(cfg, &Message{
+		To:      "reader@example.com",
+		Subject: "新文章：你好",
+		HTML:    "<p>你好</p>",
+		Text:    "你好",
+		Headers: map[string]string{"list-unsubscribe": "<https://blog.example/u>\r\nBcc: x@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := mail.ReadMessage(strings.NewReader(string(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subject, _ := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject")); subject != "新文章：你好" {
+		t.Errorf("subject: got %q", subject)
+	}
+	if got := msg.Header.Get("List-Unsubscribe"); got != "<https://blog.example/u>Bcc: x@example.com" {
+		t.Errorf("header injection not stripped: %q", got)
+	}
+	if msg.Header.Get("Bcc") != "" {
+		t.Error("injected Bcc header")
+	}
+	if !strings.HasSuffix(msg.Header.Get("Message-Id"), "@example.com>") {
+		t.Errorf("message id: %q", msg.Header.Get("Message-Id"))
+	}
+
+	_, params, _ := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	var parts []string
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := io.ReadAll(p)
+		parts = append(parts, p.Header.Get("Content-Type")+" "+string(b))
+	}
+	want := []string{"text/plain; charset=utf-8 你好", "text/html; charset=utf-8 <p>你好</p>"}
+	if strings.Join(parts, "|") != strings.Join(want, "|") {
+		t.Errorf("parts: got %q", parts)
+	}
+
+	if _, err = Build(cfg, &Message{To: "not an address"}); err == nil {
+		t.Error("invalid recipient: expected error")
+	}
+}
+
+func TestClassify(t *testing.T) {
+	reply := func(stage string, code int, msg string) error {
+		return &stageError{stage: stage, err: &textproto.Error{Code: code, Msg: msg}}
+	}
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"rcpt unknown user", reply(stageRcpt, 550, "5.1.1 no such user"), true},
+		{"rcpt without enhanced code", reply(stageRcpt, 553, "mailbox name not allowed"), true},
+		{"rcpt greylisted", reply(stageRcpt, 451, "4.7.1 try again later"), false},
+		{"data bad mailbox", reply(stageData, 550, "5.1.1 mailbox unavailable"), true},
+		{"data rejected as spam", reply(stageData, 554, "5.7.1 message rejected"), false},
+		{"auth failed", reply(stageAuth, 535, "5.7.8 authentication failed"), false},
+		{"sender rejected", reply(stageMail, 550, "5.1.0 sender rejected"), false},
+		{"network", errors.New("connection reset"), false},
+	}
+	for _, tc := range cases {
+		err := classify(tc.err)
+		if got := IsPermanent(err); got != tc.want {
+			t.Errorf("%s: permanent = %v, want %v", tc.name, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: original error not wrapped", tc.name)
+		}
+	}
+	if classify(nil) != nil {
+		t.Error("nil error classified")
+	}
+}
+
+// smtpSink 本地 SMTP 测试服务：按命令返回预设应答，未设置的命令返回成功
+type smtpSink struct {
+	addr    *net.TCPAddr
+	replies map[string]string // 命令（AUTH、MAIL、RCPT、DATA、.）到应答
+	data    chan string
+}
+
+func newSMTPSink(t *testing.T, replies map[string]string) *smtpSink {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	s := &smtpSink{addr: ln.Addr().(*net.TCPAddr), replies: replies, data: make(chan string, 1)}
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s.serve(textproto.NewConn(conn))
+	}()
+	return s
+}
+
+func (s *smtpSink) reply(cmd, ok string) string {
+	if r, found := s.replies[cmd]; found {
+		return r
+	}
+	return ok
+}
+
+func (s *smtpSink) serve(c *textproto.Conn) {
+	_ = c.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.Fields(line + " ")[0])
+		switch cmd {
+		case "EHLO":
+			_ = c.PrintfLine("250-localhost")
+			_ = c.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			_ = c.PrintfLine("%s", s.reply(cmd, "235 2.7.0 ok"))
+		case "MAIL", "RCPT":
+			_ = c.PrintfLine("%s", s.reply(cmd, "250 2.1.0 ok"))
+		case "DATA":
+			r := s.reply(cmd, "354 go ahead")
+			_ = c.PrintfLine("%s", r)
+			if !strings.HasPrefix(r, "354") {
+				continue
+			}
+			b, err := io.ReadAll(c.DotReader())
+			if err != nil {
+				return
+			}
+			s.data <- string(b)
+			_ = c.PrintfLine("%s", s.reply(".", "250 2.0.0 queued"))
+		case "QUIT":
+			_ = c.PrintfLine("221 bye")
+			return
+		default:
+			_ = c.PrintfLine("250 ok")
+		}
+	}
+}
+
+func TestSend(t *testing.T) {
+	msg := &Message{To: "reader@example.com", Subject: "hello", Text: "body"}
+	cases := []struct {
+		name      string
+		username  string
+		replies   map[string]string
+		wantErr   bool
+		permanent bool
+	}{
+		{"delivered", "", nil, false, false},
+		{"delivered with auth", "user", nil, false, false},
+		{"auth failed", "user", map[string]string{"AUTH": "535 5.7.8 authentication failed"}, true, false},
+		{"sender rejected", "", map[string]string{"MAIL": "550 5.7.1 sender not allowed"}, true, false},
+		{"unknown recipient", "", map[string]string{"RCPT": "550 5.1.1 no such user"}, true, true},
+		{"mailbox full", "", map[string]string{"RCPT": "452 4.2.2 mailbox full"}, true, false},
+		{"recipient rejected after data", "", map[string]string{".": "550 5.1.1 mailbox unavailable"}, true, true},
+		{"content rejected", "", map[string]string{".": "554 5.7.1 message rejected as spam"}, true, false},
+	}
+	for _, tc := range cases {
+		sink := newSMTPSink(t, tc.replies)
+		cfg := &Config{
+			Host: "127.0.0.1", Port: sink.addr.Port, TLS: TLSNone, Timeout: 5 * time.Second,
+			From: "blog@example.com", Username: tc.username, Password: "secret",
+		}
+		err := Send(context.Background(), cfg, msg)
+		if (err != nil) != tc.wantErr || IsPermanent(err) != tc.permanent {
+			t.Errorf("%s: got %v (permanent %v)", tc.name, err, IsPermanent(err))
+			continue
+		}
+		if !tc.wantErr {
+			if body := <-sink.data; !strings.Contains(body, "Subject: hello") {
+				t.Errorf("%s: unexpected message %q", tc.name, body)
+			}
+		}
+	}
+
+	if err := Send(context.Background(), &Config{}, msg); err == nil || IsPermanent(err) {
+		t.Errorf("missing config: got %v", err)
+	}
+}
@@ -0,0 +1,228 @@
+// Package ogimage 生成 1200x630 的 Open Graph 分享卡片（PNG），
+// 字体嵌入二进制：拉丁字符使用 Go 字体，中日韩字符使用 fonts 目录或配置中的字体，逐字选择包含字形的字体
+package ogimage
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+//go:embed fonts
+var embeddedFonts embed.FS
+
+// fontSet 按优先级排列的字体，正文与标题各一组
+type fontSet struct {
+	regular []*sfnt.Font
+	bold    []*sfnt.Font
+}
+
+var (
+	fontsMu    sync.Mutex
+	fontsCache = map[string]*fontSet{}
+)
+
+// loadFonts 解析内置字体与 extraPaths 中的字体文件，按 extraPaths 缓存
+func loadFonts(extraPaths []string) (*fontSet, error) {
+	key := strings.Join(extraPaths, ",")
+	fontsMu.Lock()
+	defer fontsMu.Unlock()
+	if set, ok := fontsCache[key]; ok {
+		return set, nil
+	}
+
+	set := &fontSet{}
+	for _, src := range [][]byte{goregular.TTF, gobold.TTF} {
+		f, err := sfnt.Parse(src)
+		if err != nil {
+			return nil, fmt.Errorf("解析内置字体失败: %w", err)
+		}
+		if len(set.regular) == 0 {
+			set.regular = append(set.regular, f)
+		} else {
+			set.bold = append(set.bold, f)
+		}
+	}
+
+	type namedFont struct {
+		name string
+		data []byte
+	}
+	var files []namedFont
+	err := fs.WalkDir(embeddedFonts, "fonts", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !isFontFile(p) {
+			return err
+		}
+		data, err := embeddedFonts.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		files = append(files, namedFont{name: path.Base(p), data: data})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("读取内置字体失败: %w", err)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].name < files[j].name })
+	for _, p := range extraPaths {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return nil, fmt.Errorf("读取字体文件失败: %w", err)
+		}
+		files = append(files, namedFont{name: path.Base(p), data: data})
+	}
+
+	var regular, bold []*sfnt.Font
+	for _, file := range files {
+		fonts, err := parseFontFile(file.data)
+		if err != nil {
+			return nil, fmt.Errorf("解析字体 %s 失败: %w", file.name, err)
+		}
+		if strings.Contains(strings.ToLower(file.name), "bold") {
+			bold = append(bold, fonts...)
+		} else {
+			regular = append(regular, fonts...)
+		}
+	}
+	// 标题优先使用粗体，缺字时回退到常规字体；正文缺字时也可以使用粗体
+	set.regular = append(append(set.regular, regular...), bold...)
+	set.bold = append(append(set.bold, bold...), regular...)
+	fontsCache[key] = set
+	return set, nil
+}
+
+func isFontFile(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".ttf", ".otf", ".ttc", ".otc":
+		return true
+	}
+	return false
+}
+
+// parseFontFile 解析单个字体或字体集合（.ttc）
+func parseFontFile(data []byte) ([]*sfnt.Font, error) {
+	c, err := sfnt.ParseCollection(data)
+	if err != nil {
+		return nil, err
+	}
+	fonts := make([]*sfnt.Font, 0, c.NumFonts())
+	for i := 0; i < c.NumFonts(); i++ {
+		f, err := c.Font(i)
+		if err != nil {
+			return nil, err
+		}
+		fonts = append(fonts, f)
+	}
+	if len(fonts) == 0 {
+		return nil, errors.New("字体文件为空")
+	}
+	return fonts, nil
+}
+
+// faceChain 同一字号的一组字体，逐字选择第一个包含字形的字体
+type faceChain struct {
+	fonts []*sfnt.Font
+	faces []font.Face
+	buf   sfnt.Buffer
+}
+
+func newFaceChain(fonts []*sfnt.Font, size float64) (*faceChain, error) {
+	c := &faceChain{fonts: fonts}
+	for _, f := range fonts {
+		face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+		if err != nil {
+			return nil, err
+		}
+		c.faces = append(c.faces, face)
+	}
+	return c, nil
+}
+
+// faceFor 包含字形 r 的字体，全部缺字时使用第一个字体（显示缺字方框）
+func (c *faceChain) faceFor(r rune) font.Face {
+	for i, f := range c.fonts {
+		if idx, err := f.GlyphIndex(&c.buf, r); err == nil && idx != 0 {
+			return c.faces[i]
+		}
+	}
+	return c.faces[0]
+}
+
+// advance 文本宽度
+func (c *faceChain) advance(s string) fixed.Int26_6 {
+	var w fixed.Int26_6
+	for _, r := range s {
+		a, _ := c.faceFor(r).GlyphAdvance(r)
+		w += a
+	}
+	return w
+}
+
+// metrics 所有字体中最大的上伸与下伸，保证混排时行高一致
+func (c *faceChain) metrics() (ascent, descent fixed.Int26_6) {
+	for _, face := range c.faces {
+		m := face.Metrics()
+		if m.Ascent > ascent {
+			ascent = m.Ascent
+		}
+		if m.Descent > descent {
+			descent = m.Descent
+		}
+	}
+	return ascent, descent
+}
+
+func (c *faceChain) Close() {
+	for _, face := range c.faces {
+		_ = face.Close()
+	}
+}
+
+// MissingGlyphs 返回 s 中所有字体都不包含的字符（去重），用于提示缺少中日韩字体
+func MissingGlyphs(s string, opts *Options) ([]rune, error) {
+	var paths []string
+	if opts != nil {
+		paths = opts.FontPaths
+	}
+	set, err := loadFonts(paths)
+	if err != nil {
+		return nil, err
+	}
+	var buf sfnt.Buffer
+	seen := map[rune]bool{}
+	var missing []rune
+	for _, r := range s {
+		if seen[r] || r == ' ' || r < 0x20 {
+			continue
+		}
+		seen[r] = true
+		found := false
+		for _, f := range set.regular {
+			if idx, err := f.GlyphIndex(&buf, r); err == nil && idx != 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, r)
+		}
+	}
+	return missing, nil
+}
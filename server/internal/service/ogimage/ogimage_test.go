@@ -0,0 +1,161 @@
+package ogimage
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/sfnt"
+)
+
+// monoChain 等宽字体的字体链：每个字符（含缺字方框）宽度相同，便于按字符数断言折行
+func monoChain(t *testing.T) (*faceChain, int) {
+	t.Helper()
+	f, err := sfnt.Parse(gomono.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain, err := newFaceChain([]*sfnt.Font{f}, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(chain.Close)
+	unit := chain.advance("a").Ceil()
+	if chain.advance("中").Ceil() != unit || chain.advance("abc").Ceil() != 3*unit {
+		t.Fatalf("mono font is not fixed width")
+	}
+	return chain, unit
+}
+
+func TestTokenize(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"Hello world", []string{"Hello", " ", "world"}},
+		{"你好世界", []string{"你", "好", "世", "界"}},
+		{"Go语言", []string{"Go", "语", "言"}},
+		{"好，世界。", []string{"好，", "世", "界。"}},
+		{"Hi, there!", []string{"Hi,", " ", "there!"}},
+		{"，开头", []string{"，", "开", "头"}},
+		{"a ，b", []string{"a", " ", "，", "b"}},
+		{"「引号」", []string{"「", "引", "号」"}},
+		{"", nil},
+	}
+	for _, tc := range cases {
+		if got := tokenize(tc.in); strings.Join(got, "|") != strings.Join(tc.want, "|") || len(got) != len(tc.want) {
+			t.Errorf("tokenize(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	chain, unit := monoChain(t)
+	cases := []struct {
+		in    string
+		chars int // 每行最多字符数
+		want  []string
+	}{
+		{"hello world", 20, []string{"hello world"}},
+		{"hello world", 8, []string{"hello", "world"}},
+		{"hello  world", 5, []string{"hello", "world"}},
+		{"你好世界和平", 4, []string{"你好世界", "和平"}},
+		{"你好，世界", 2, []string{"你", "好，", "世界"}},
+		{"abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"Go 语言很好", 4, []string{"Go 语", "言很好"}},
+		{"   ", 4, nil},
+	}
+	for _, tc := range cases {
+		got := wrap(chain, tc.in, tc.chars*unit)
+		if strings.Join(got, "|") != strings.Join(tc.want, "|") {
+			t.Errorf("wrap(%q, %d) = %q, want %q", tc.in, tc.chars, got, tc.want)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	chain, unit := monoChain(t)
+	cases := []struct {
+		in    string
+		chars int
+		want  string
+	}{
+		{"short", 10, "short"},
+		{"hello world", 7, "hello…"},
+		{"你好世界", 3, "你好…"},
+		{"已截断…", 3, "已截…"},
+		{"abc", 0, "…"},
+	}
+	for _, tc := range cases {
+		if got := truncate(chain, tc.in, tc.chars*unit); got != tc.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tc.in, tc.chars, got, tc.want)
+		}
+	}
+}
+
+func TestJoinLines(t *testing.T) {
+	cases := map[string][]string{
+		"hello world":   {"hello", "world"},
+		"你好世界":          {"你好", "世界"},
+		"Go语言":          {"Go", "语言"},
+		"好，world again": {"好，world", "again"},
+	}
+	for want, lines := range cases {
+		if got := joinLines(lines); got != want {
+			t.Errorf("joinLines(%q) = %q, want %q", lines, got, want)
+		}
+	}
+}
+
+func TestParseHexColor(t *testing.T) {
+	cases := []struct {
+		in   string
+		want color.RGBA
+		ok   bool
+	}{
+		{"", color.RGBA{0x38, 0xbd, 0xf8, 0xff}, true},
+		{"#ff8000", color.RGBA{0xff, 0x80, 0x00, 0xff}, true},
+		{" 0a0B0c ", color.RGBA{0x0a, 0x0b, 0x0c, 0xff}, true},
+		{"#fff", color.RGBA{}, false},
+		{"#gggggg", color.RGBA{}, false},
+	}
+	for _, tc := range cases {
+		got, err := parseHexColor(tc.in)
+		if (err == nil) != tc.ok || got != tc.want {
+			t.Errorf("parseHexColor(%q) = %v, %v", tc.in, got, err)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	missing, err := MissingGlyphs("Hello, Go 1.24!", nil)
+	if err != nil || len(missing) != 0 {
+		t.Fatalf("latin text: missing %q, %v", missing, err)
+	}
+
+	card := &Card{
+		Title:    strings.Repeat("A very long title that needs wrapping ", 6),
+		Category: "Notes",
+		Date:     "2026-10-19",
+		SiteName: "Blog",
+		SiteHost: "blog.example",
+	}
+	data, err := Render(card, &Options{Accent: "#ff8000"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != Width || b.Dy() != Height {
+		t.Errorf("size: got %v", b)
+	}
+
+	if _, err = Render(card, &Options{Accent: "red"}); err == nil {
+		t.Error("invalid accent: expected error")
+	}
+}
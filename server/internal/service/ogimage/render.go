@@ -0,0 +1,188 @@
+package ogimage
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// 卡片尺寸（Open Graph 推荐的 1.91:1）
+const (
+	Width  = 1200
+	Height = 630
+)
+
+// 版式
+const (
+	marginX      = 88
+	accentWidth  = 14
+	titleTop     = 200
+	titleMaxLine = 3
+	footerY      = 560
+)
+
+// titleSizes 标题字号，放不下时逐级缩小
+var titleSizes = []float64{68, 58, 50}
+
+// Card 卡片内容
+type Card struct {
+	Title    string // 文章标题或微博摘录
+	Category string // 分类名，显示在左上角
+	Date     string // 显示在右下角，如 2026-10-19
+	SiteName string // 站点名称，显示在左下角
+	SiteHost string // 站点域名，显示在日期前
+}
+
+// Options 渲染选项
+type Options struct {
+	// Accent 强调色（#RRGGBB），为空时使用默认蓝色
+	Accent string
+	// FontPaths 额外的字体文件，排在内置字体之后
+	FontPaths []string
+}
+
+// Render 渲染卡片并编码为 PNG
+func Render(card *Card, opts *Options) ([]byte, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	fonts, err := loadFonts(opts.FontPaths)
+	if err != nil {
+		return nil, err
+	}
+	accent, err := parseHexColor(opts.Accent)
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, Width, Height))
+	paintBackground(img, accent)
+
+	white := color.RGBA{0xf8, 0xfa, 0xfc, 0xff}
+	muted := color.RGBA{0x94, 0xa3, 0xb8, 0xff}
+
+	// 分类
+	if c := strings.TrimSpace(card.Category); c != "" {
+		chain, err := newFaceChain(fonts.regular, 30)
+		if err != nil {
+			return nil, err
+		}
+		drawText(img, chain, truncate(chain, c, Width-2*marginX), marginX, 120, accent)
+		chain.Close()
+	}
+
+	// 标题：依次尝试较小的字号，仍放不下时截断最后一行
+	title := strings.Join(strings.Fields(card.Title), " ")
+	for i, size := range titleSizes {
+		chain, err := newFaceChain(fonts.bold, size)
+		if err != nil {
+			return nil, err
+		}
+		lines := wrap(chain, title, Width-2*marginX)
+		if len(lines) > titleMaxLine && i < len(titleSizes)-1 {
+			chain.Close()
+			continue
+		}
+		if len(lines) > titleMaxLine {
+			rest := joinLines(lines[titleMaxLine-1:])
+			lines = append(lines[:titleMaxLine-1], truncate(chain, rest+"…", Width-2*marginX))
+		}
+		ascent, descent := chain.metrics()
+		lineHeight := (ascent + descent).Ceil() + int(size*0.2)
+		y := titleTop + ascent.Ceil()
+		for _, line := range lines {
+			drawText(img, chain, line, marginX, y, white)
+			y += lineHeight
+		}
+		chain.Close()
+		break
+	}
+
+	// 页脚：左侧站点名称，右侧域名与日期
+	siteChain, err := newFaceChain(fonts.bold, 32)
+	if err != nil {
+		return nil, err
+	}
+	drawText(img, siteChain, truncate(siteChain, card.SiteName, (Width-2*marginX)/2), marginX, footerY, white)
+	siteChain.Close()
+
+	metaChain, err := newFaceChain(fonts.regular, 26)
+	if err != nil {
+		return nil, err
+	}
+	meta := strings.Join(nonEmpty(card.SiteHost, card.Date), "  ·  ")
+	if meta != "" {
+		meta = truncate(metaChain, meta, (Width-2*marginX)/2)
+		drawText(img, metaChain, meta, Width-marginX-metaChain.advance(meta).Ceil(), footerY, muted)
+	}
+	metaChain.Close()
+
+	var buf bytes.Buffer
+	if err = png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("编码 PNG 失败: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// paintBackground 深色纵向渐变背景、左侧强调色竖条与页脚分隔线
+func paintBackground(img *image.RGBA, accent color.RGBA) {
+	top := color.RGBA{0x0f, 0x17, 0x2a, 0xff}
+	bottom := color.RGBA{0x1e, 0x29, 0x3b, 0xff}
+	for y := 0; y < Height; y++ {
+		t := float64(y) / float64(Height-1)
+		c := color.RGBA{
+			R: lerp(top.R, bottom.R, t),
+			G: lerp(top.G, bottom.G, t),
+			B: lerp(top.B, bottom.B, t),
+			A: 0xff,
+		}
+		draw.Draw(img, image.Rect(0, y, Width, y+1), image.NewUniform(c), image.Point{}, draw.Src)
+	}
+	draw.Draw(img, image.Rect(0, 0, accentWidth, Height), image.NewUniform(accent), image.Point{}, draw.Src)
+	line := color.RGBA{0x33, 0x41, 0x55, 0xff}
+	draw.Draw(img, image.Rect(marginX, footerY-62, Width-marginX, footerY-60), image.NewUniform(line), image.Point{}, draw.Src)
+}
+
+func lerp(a, b uint8, t float64) uint8 {
+	return uint8(float64(a) + (float64(b)-float64(a))*t + 0.5)
+}
+
+// drawText 以 (x, baseline) 为起点逐字绘制
+func drawText(img *image.RGBA, chain *faceChain, s string, x, baseline int, c color.Color) {
+	d := &font.Drawer{Dst: img, Src: image.NewUniform(c), Dot: fixed.P(x, baseline)}
+	for _, r := range s {
+		d.Face = chain.faceFor(r)
+		d.DrawString(string(r))
+	}
+}
+
+// parseHexColor 解析 #RRGGBB，为空时返回默认强调色
+func parseHexColor(s string) (color.RGBA, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if s == "" {
+		return color.RGBA{0x38, 0xbd, 0xf8, 0xff}, nil
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil || len(s) != 6 {
+		return color.RGBA{}, fmt.Errorf("无效的颜色值: #%s", s)
+	}
+	return color.RGBA{uint8(v >> 16), uint8(v >> 8), uint8(v), 0xff}, nil
+}
+
+func nonEmpty(values ...string) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
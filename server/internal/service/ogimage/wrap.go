@@ -0,0 +1,130 @@
+package ogimage
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// noLineStart 不能出现在行首的标点，换行时跟随前一个字
+const noLineStart = "，。、；：！？）》」』】〕…,.;:!?)]}%"
+
+// tokenize 拆分为换行单位：连续的拉丁字母、数字作为一个单词，中日韩字符逐字拆分，
+// 行首禁用标点并入前一个单位；空格单独成为一个单位
+func tokenize(s string) []string {
+	var tokens []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range s {
+		switch {
+		case unicode.IsSpace(r):
+			flush()
+			tokens = append(tokens, " ")
+		case strings.ContainsRune(noLineStart, r):
+			if word.Len() > 0 {
+				word.WriteRune(r)
+			} else if n := len(tokens); n > 0 && tokens[n-1] != " " {
+				tokens[n-1] += string(r)
+			} else {
+				tokens = append(tokens, string(r))
+			}
+		case isWide(r):
+			flush()
+			tokens = append(tokens, string(r))
+		default:
+			word.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+// isWide 中日韩文字与全角符号，可以在任意两个字之间换行
+func isWide(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) ||
+		(r >= 0x3000 && r <= 0x303f) || (r >= 0xff00 && r <= 0xffef)
+}
+
+// wrap 按最大宽度折行，超长的单词按字符拆分
+func wrap(chain *faceChain, s string, maxWidth int) []string {
+	var lines []string
+	var line strings.Builder
+	width := 0
+	push := func() {
+		if text := strings.TrimSpace(line.String()); text != "" {
+			lines = append(lines, text)
+		}
+		line.Reset()
+		width = 0
+	}
+	for _, token := range tokenize(s) {
+		if token == " " && width == 0 {
+			continue
+		}
+		w := chain.advance(token).Ceil()
+		if width+w <= maxWidth {
+			line.WriteString(token)
+			width += w
+			continue
+		}
+		if token == " " {
+			push()
+			continue
+		}
+		if width > 0 {
+			push()
+		}
+		if w <= maxWidth {
+			line.WriteString(token)
+			width = w
+			continue
+		}
+		for _, r := range token {
+			rw := chain.advance(string(r)).Ceil()
+			if width+rw > maxWidth && width > 0 {
+				push()
+			}
+			line.WriteRune(r)
+			width += rw
+		}
+	}
+	push()
+	return lines
+}
+
+// truncate 超出宽度时截断并以省略号结尾
+func truncate(chain *faceChain, s string, maxWidth int) string {
+	if chain.advance(s).Ceil() <= maxWidth {
+		return s
+	}
+	runes := []rune(strings.TrimSuffix(s, "…"))
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := strings.TrimRight(string(runes), " ") + "…"
+		if chain.advance(candidate).Ceil() <= maxWidth {
+			return candidate
+		}
+	}
+	return "…"
+}
+
+// joinLines 重新拼接折行后的文本，拉丁单词之间补回空格
+func joinLines(lines []string) string {
+	var b strings.Builder
+	for i, line := range lines {
+		if i > 0 {
+			prev, _ := utf8.DecodeLastRuneInString(lines[i-1])
+			next, _ := utf8.DecodeRuneInString(line)
+			if !isWide(prev) && !isWide(next) {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString(line)
+	}
+	return b.String()
+}
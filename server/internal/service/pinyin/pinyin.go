@@ -0,0 +1,99 @@
+// Package pinyin 提供不依赖外部词库的汉字转拼音（不带声调），用于生成 URL 标识。
+//
+// GB2312 一级汉字（3755 个常用字）按拼音排序，因此只需记录每个音节首字的区位码，
+// 即可通过二分查找得到任意一级汉字的读音。二级汉字按部首排序，无法用此方法转换，
+// 这部分汉字以及繁体字、扩展区汉字查 extendedChars 表；表中也没有的汉字无法转换。
+// 多音字取 GB2312 或 CLDR 排序所依据的读音。
+package pinyin
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// GB2312 一级汉字范围（区位码按 hi<<8|lo 计）
+const (
+	level1First = 0xB0A1
+	level1Last  = 0xD7F9
+)
+
+// Convert 将字符串中的汉字转换为拼音，音节之间以及音节与相邻字母、数字之间插入 sep；
+// 其他字符原样保留
+func Convert(s, sep string) string {
+	var (
+		b         strings.Builder
+		prevAlnum bool // 上一个输出的是字母、数字或拼音
+		prevPy    bool // 上一个输出的是拼音
+	)
+	for _, r := range s {
+		if py := Syllable(r); py != "" {
+			if prevAlnum {
+				b.WriteString(sep)
+			}
+			b.WriteString(py)
+			prevAlnum, prevPy = true, true
+			continue
+		}
+		alnum := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if prevPy && alnum {
+			b.WriteString(sep)
+		}
+		b.WriteRune(r)
+		prevAlnum, prevPy = alnum, false
+	}
+	return b.String()
+}
+
+// Romanize 与 Convert 相同，但无法转换的汉字视为分隔，结果中不再包含汉字，用于生成 ASCII 的 URL 标识
+func Romanize(s, sep string) string {
+	return Convert(strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Han, r) && Syllable(r) == "" {
+			return ' '
+		}
+		return r
+	}, s), sep)
+}
+
+var (
+	extendedOnce sync.Once
+	extended     map[rune]string
+)
+
+// Syllable 返回单个汉字的拼音，无法转换的字符返回空串
+func Syllable(r rune) string {
+	if !unicode.Is(unicode.Han, r) {
+		return ""
+	}
+	encoded, err := simplifiedchinese.GBK.NewEncoder().String(string(r))
+	if err != nil || len(encoded) != 2 {
+		return extendedSyllable(r)
+	}
+	// GBK 扩展的繁体字等低字节小于 0xA1，不属于 GB2312 区位
+	code := int(encoded[0])<<8 | int(encoded[1])
+	if code < level1First || code > level1Last || encoded[1] < 0xA1 {
+		return extendedSyllable(r)
+	}
+	// 找到最后一个首字区位码不大于 code 的音节
+	i := sort.Search(len(syllables), func(i int) bool { return syllables[i].code > code }) - 1
+	if i < 0 {
+		return ""
+	}
+	return syllables[i].py
+}
+
+// extendedSyllable 查一级汉字以外的汉字读音
+func extendedSyllable(r rune) string {
+	extendedOnce.Do(func() {
+		extended = make(map[rune]string, 16500)
+		for py, chars := range extendedChars {
+			for _, c := range chars {
+				extended[c] = py
+			}
+		}
+	})
+	return extended[r]
+}
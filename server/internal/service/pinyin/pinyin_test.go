@@ -0,0 +1,52 @@
+package pinyin
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestSyllable(t *testing.T) {
+	cases := map[rune]string{
+		'啊': "a", '中': "zhong", '国': "guo", '座': "zuo", // 一级汉字，含首尾字
+		'鑫': "xin", '喆': "zhe", '淼': "miao", // 二级汉字
+		'學': "xue", '國': "guo", '們': "men", '龍': "long", '愛': "ai", // 繁体字
+		'a': "", '1': "", '。': "",
+	}
+	for r, want := range cases {
+		if got := Syllable(r); got != want {
+			t.Errorf("Syllable(%q) = %q, want %q", r, got, want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	cases := []struct{ in, want string }{
+		{"你好世界", "ni-hao-shi-jie"},
+		{"Go语言2024", "Go-yu-yan-2024"},
+		{"學習 Go", "xue-xi Go"},
+		{"hello, 世界!", "hello, shi-jie!"},
+		{"", ""},
+	}
+	for _, tc := range cases {
+		if got := Convert(tc.in, "-"); got != tc.want {
+			t.Errorf("Convert(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestRomanize(t *testing.T) {
+	// U+2A6D6 不在拼音表中，转换时视为分隔
+	if got := Romanize("鑫\U0002A6D6學", "-"); got != "xin xue" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestExtendedTableIsHan(t *testing.T) {
+	for py, chars := range extendedChars {
+		for _, c := range chars {
+			if !unicode.Is(unicode.Han, c) {
+				t.Errorf("%s: %q is not a Han character", py, c)
+			}
+		}
+	}
+}
@@ -0,0 +1,395 @@
+package pinyin
+
+// extendedChars GB2312 一级汉字以外的汉字（二级汉字、繁体字及扩展区汉字）按拼音分组，
+// 由 Unicode CLDR 拼音排序数据推导：排序中同一读音的字相邻，以组内一级汉字的读音作为整组读音，
+// 无法确定读音的组不收录。
+var extendedChars = map[string]string{
+	"a":      "嗄锕",
+	"ai":     "伌僾叆啀嗌嗳嘊噯塧壒娭娾嫒嬡愛懓懝捱敱敳昹暧曖欸毐溰溾濭瑷璦皚皧瞹砹硋礙薆藹譪譺躷銰鎄鑀锿閡霭靄靉餲馤騃鴱",
+	"an":     "侒儑唵啽垵埯堓婩媕峖庵揞晻桉洝犴玵痷盦盫罯腤荌菴萻葊蓭誝諳谙豻銨錌铵闇隌雸鞌韽馣鮟鵪鶕鹌黯",
+	"ang":    "卬岇昻枊醠骯",
+	"ao":     "厫嗷嗸坳垇墺奡奧媪媼嫯岙岰嶅嶴廒慠扷抝拗摮擙柪梎滶爊獒獓璈磝翶翺聱芺蔜螯襖謷謸軪遨鏊鏖镺隞隩驁骜鰲鳌鷔鼇",
+	"ba":     "仈叐哵坺垻墢壩夿妭岜峇巼弝抜朳柭欛灞炦犮玐癹矲粑紦罷羓胈茇菝蚆覇詙豝跁軷釛釟鈀钯颰魃魞鮊鲃鲅鲌鼥",
+	"bai":    "庍拝捭擺敗栢猈瓸粨粺絔蛽襬贁韛",
+	"ban":    "坂坢姅岅怑攽斒昄柈湴瓪瘢癍秚粄絆舨蝂螁螌褩辦辬鈑鉡钣闆阪靽頒魬鳻",
+	"bang":   "垹塝幇幚幫捠搒棓浜牓玤稖綁縍艕蒡蜯謗邫鎊鞤髈",
+	"bao":    "儤勹勽堢報媬嫑孢宲寚寳寶忁怉曓枹煲珤窇笣緥菢葆蕔虣蚫袌褓襃賲趵鉋鑤铇闁靌靤飽駂骲髱鮑鳵鴇鸔鸨齙龅",
+	"bei":    "俻偝偹備僃孛悖愂憊揹昁桮梖椑牬犕狽珼琲盃碚禆糒苝蓓藣褙誖貝軰輩邶郥鄁鉳鋇錃鐾陂鞁鞴骳鵯鹎",
+	"ben":    "倴坋坌奙捹撪栟桳楍泍渀犇獖畚翉贲輽逩錛锛",
+	"beng":   "伻傰嘣埄埲塴奟嵭琣琫甏痭祊絣綳繃菶蠯逬鏰镚閍鞛",
+	"bi":     "佊佖俾偪匕吡哔啚嗶坒堛夶奰妣妼婢嬖屄幣庳廦弻弼彃怭怶愊愎斃朼枈柀柲梐楅毴沘湢滗滭潷濞煏熚狴獘獙珌璧畀畢疕疪痺皕睤秕笓筆筚箄箅箆篦篳粃粊綼縪繴罼聛腷舭苾荜荸萆蓽薜蜌螕袐裨襞襣觱詖诐豍貏貱賁贔赑跸蹕躃躄邲鄨鄪鉍鎞鏎鐴铋閇閉閟鞸韠飶饆馝駜驆髀髲魓鮅鰏鲾鵖鷝鷩鼊",
+	"bian":   "匥匾変弁徧忭惼抃揙昪汳汴煸牑猵玣甂砭碥稨窆笾箯籩糄編緶缏艑苄萹藊蝙褊覍變貶辡辧辮辯辺邉邊釆鍽閞鯾鯿鳊鴘",
+	"biao":   "儦墂婊幖摽杓標檦淲滮瀌灬熛爂猋瘭磦穮脿臕蔈藨裱褾諘謤贆錶鏢鑣镖镳颩颮颷飆飇飈飑飙飚驃驫骉骠髟",
+	"bie":    "別咇徶癟莂虌蛂蟞襒蹩鱉鼈龞",
+	"bin":    "傧儐擯梹椕槟檳殡殯汃濱瀕玢瑸璸砏繽缤膑臏虨豩豳賓賔邠鑌镔霦顮髌髕髩鬂鬓鬢",
+	"bing":   "並仌仒併倂偋傡冫寎幷庰怲抦掤摒昞昺栤棅氷眪禀稟窉竝苪蛃誁邴鈵鉼陃靐鞞餅餠鮩",
+	"bo":     "亳仢侼僠僰剝哱啵嚗孹嶓帗愽懪挬撥檗欂浡煿牔犦犻狛猼瓝瓟癶癷盋砵碆礡礴秡箥簙簸糪紴缽肑胉艊苩萡葧蔔蘗袚袯袰袹襏襮譒豰跛踣蹳郣鈸鉑鉢鋍鎛鑮钹镈餑餺饽馎馛馞駁駮驋髆髉鮁鱍鵓鹁",
+	"bu":     "佈勏卟吥咘喸埗悑抪捗柨歨歩瓿篰荹蔀補踄郶钚钸餔餢鵏",
+	"ca":     "嚓攃",
+	"cai":    "倸偲啋埰婇寀採棌毝溨犲綵縩纔財跴",
+	"can":    "傪儏參叄叅喰嬠嬱孱慘慙慚憯摻朁殘湌澯燦爘璨穇篸粲薒蝅蠶蠺謲飡驂骖黪黲",
+	"cang":   "仺伧倉傖嵢滄獊艙蒼螥鑶鶬鸧",
+	"cao":    "嘈嶆愺懆撡曺漕艚艸蓸螬褿鏪騲",
+	"ce":     "側冊厠墄廁恻惻憡拺敇測畟笧筞筴箣簎粣萗萴蓛",
+	"ceng":   "層嶒竲驓",
+	"cha":    "侘偛嗏垞奼姹嵖扠挿揷杈槎檫汊猹疀秅紁肞臿艖衩詧詫蹅銟鍤鑔锸镲靫餷馇",
+	"chai":   "侪儕喍祡芆釵钗齜",
+	"chan":   "丳僝儃儳冁刬剗剷劖啴嘽嚵囅婵嬋嵼巉幝幨廛忏懴懺摌摲攙斺旵梴棎欃毚浐湹滻潹潺澶瀍瀺灛煘燀獑產産硟磛禅禪簅緾繟纏纒羼艬蒇蕆蟬蟾裧襜覘觇誗諂譂讇讒谄躔辴辿鄽酁鉆鋋鋓鏟鑱镡镵閳闡韂顫饞骣",
+	"chang":  "仧伥倀僘償兏厰嘗嚐場塲娼嫦廠徜怅悵惝昶晿暢椙氅淐焻玚琩瑒瑺瓺甞畼腸膓苌菖萇蟐裮誯鋹鋿錩鏛锠镸閶阊韔鬯鯧鱨鲳鲿鼚",
+	"chao":   "勦巐巣弨怊晁樔欩漅焣焯煼牊眧窲罺訬謿轈鄛鈔麨鼂鼌",
+	"che":    "伡俥偖勶唓坼屮徹撦烢爡瞮砗硨硩聅莗蛼車迠頙",
+	"chen":   "儬儭嗔嚫塵墋夦宸愖抻捵揨敐曟榇樄櫬烥煁琛疢瘎瞋硶碜磣綝縝茞莀莐蔯薼螴襯訦諃諶謓讖谌谶賝贂趂趻踸軙迧醦鈂鍖陳霃鷐麎齓齔龀",
+	"cheng":  "丞乗侱偁僜埕堘塍塖娍宬峸庱徎悜憆憕懲挰掁摚撐晟朾枨柽棖棦椉橕檉檙泟洆浾湞溗澂瀓爯牚珵珹琤畻睈瞠碀稱穪窚竀筬絾緽脀脭荿蛏蟶裎誠赪赬郕酲鋮鏳鏿铖阷靗頳饓騁騬",
+	"chi":    "侙傺勅勑卶叱叺呎哧啻喫嗤噄坻垑墀媸岻彨彲彳恜恥慗憏懘抶摛敕杘欼歭歯湁漦灻烾熾瓻痓痸瘈瘛癡眵瞝硳竾笞筂箎篪粎絺翄翤翨胣胵腟茌荎蚇蚩蚳螭袲袳裭褫訵誺謘貾赿趍趩跮踟遅遟遫遲鉓鉹銐雴飭饎饬馳魑鴟鶒鷘鸱黐齒齝",
+	"chong":  "嘃埫寵崈徸忡憃憧摏沖浺爞珫緟罿翀舂艟茺蝩蟲衝褈蹖隀",
+	"chou":   "丒侴俦偢儔吜嚋婤嬦帱幬怞惆懤搊杻杽栦椆殠燽犨犫疇瘳皗矁篘籌紬絒綢臰菗薵裯讎讐躊遚酧醜醻雔雠魗",
+	"chu":    "亍俶傗儊儲処刍嘼埱媰岀幮廚怵憷拀摴敊斶杵柷椘楮榋樗橻檚櫉櫥欪歜滀濋犓珿琡璴礎竌竐篨絀绌耡臅芻蒢蒭蓫蕏藸處蜍蟵褚觸諔豖豠貙趎踀蹰躕鄐鉏鋤閦雛鶵鸀黜齣齭齼",
+	"chuai":  "搋",
+	"chuan":  "伝傳僢剶圌巛暷歂氚汌猭玔瑏篅舛舡舩荈賗踳輲遄釧钏鶨",
+	"chuang": "傸凔刅刱剏剙創噇怆愴摐摤牀牎牕瘡磢窓窻闖",
+	"chui":   "倕埀搥棰槌箠腄菙錘鎚陲顀",
+	"chun":   "偆堾媋惷旾暙杶橁櫄浱湻滣漘犉瑃睶箺純脣膥莼萅萶蒓蓴蝽賰輴醕錞陙鯙鰆鶞",
+	"chuo":   "嚽娕娖婼惙擉歠涰磭綽繛腏趠踔輟辍辵辶逴酫鑡齪龊",
+	"ci":     "佌佽偨刾呲垐堲嬨庛朿柌栨泚濨玼珁甆皉礠祠糍紪絘縒茈茦莿薋蛓螆蠀詞賜赼趀跐辝辤辭飺餈骴髊鮆鴜鶿鷀鹚齹",
+	"cong":   "叢囪婃孮従徖從忩怱悤悰慒暰枞棇樅樬樷欉淙漎漗潀潨灇焧熜燪爜琮瑽璁瞛篵緫繱聡聦聰苁蓯蔥藂蟌誴賨賩鍯鏦騘驄骢",
+	"cou":    "湊腠輳辏",
+	"cu":     "噈徂憱撺攛殂汆猝瘄瘯縬脨蔟觕誎趗踧蹙蹴蹵躥酢鋑鑹镩顣麁麄麤鼀",
+	"cuan":   "殩熶爨竄簒",
+	"cui":    "伜倅凗啐啛墔嶉忰悴慛榱槯毳漼濢焠獕璀疩皠磪竁粋紣綷縗缞翆脃脺膬膵臎萃襊趡鏙顇",
+	"cun":    "侟刌吋忖拵澊皴竴籿踆邨",
+	"cuo":    "剉剒厝夎嵯嵳斮棤歵瑳痤睉矬脞莝莡蒫蓌蔖虘蹉躦逪遳酂醝銼錯锉鹺鹾",
+	"da":     "剳匒呾咑哒嗒噠垯墶妲怛撘汏沓炟燵畗畣眔笚笪繨羍耷荅荙薘蟽褡詚躂迖逹達鎉鎝鐽阘靼鞑韃龖龘",
+	"dai":    "叇呔垈埭岱帒帯帶廗懛曃柋瀻獃玳瑇甙簤紿緿绐艜襶貸蹛軑軚軩轪迨霴靆骀鴏黛黱",
+	"dan":    "亶伔僤儋刐勯匰単啖啗啿單嘾噉嚪妉媅帎弾彈憚憺抌撢撣擔暺柦殚殫沊澸澹狚玬瓭甔疍疸瘅癉癚眈砃禫窞箪簞紞繵耼聃聸腅膽萏蓞蜑衴褝襌觛誕贉赕躭鄲霮頕饏馾駳髧鴠黕黮",
+	"dang":   "儅凼噹圵垱壋婸宕嵣愓擋攩檔欓氹潒澢灙珰璗璫瓽當盪瞊砀碭礑筜簜簹艡菪蕩蘯蟷裆襠譡讜谠趤逿闣雼黨",
+	"dao":    "刂叨噵壔導島嶋嶌嶹忉捯搗擣朷檤氘焘燾瓙盜禂禱稲箌纛翢翿舠菿衜衟軇釖隝隯魛鱽",
+	"de":     "徳恴惪棏淂脦鍀锝",
+	"deng":   "噔墱嬁嶝戥朩櫈燈璒磴竳簦覴豋鄧鐙镫隥",
+	"di":     "仾俤偙僀厎呧唙啇啲嘀嚁坔坘埊埞墑墬奃娣媂嶳廸弤彽怟慸拞掋摕敵旳杕柢梊梑棣樀氐渧滌焍牴玓珶甋眱睇砥碲磾祶禘篴籴糴締羝聜腣苖茋荻菂菧蔋蔐蔕藡蝃螮袛覿觌觝詆諦诋谛豴趆踶蹢軧逓遞遰邸釱鉪鍉鏑镝阺隄靮鞮頔馰骶髢鬄鸐",
+	"dian":   "傎厧嚸坫墊壂奌婝婰嵮巅巓巔扂攧敁敟槇槙橂橝澱猠玷琔瘨癜癫癲簟蒧蕇蜔跕踮蹎钿阽電顚顛驔點齻",
+	"diao":   "伄奝屌弔弴彫扚殦汈琱瘹瞗窎窵竨蓧藋虭蛁訋調貂釣銱鋽鑃铞铫雿魡鮉鯛鲷鳭鵰鼦",
+	"die":    "喋垤堞峌嵽恎惵戜挕揲昳曡殜氎牃牒瓞畳疉疊眣絰绖耋胅臷艓苵蜨褋褺詄諜趃蹀镻鰈鲽",
+	"ding":   "仃啶奵嵿帄忊椗濎玎疔矴碇碠磸耵腚薡虰蝊訂酊釘鋌錠鐤铤靪頂顁飣饤鼑",
+	"diu":    "丟銩铥",
+	"dong":   "倲働凍動咚垌埬墥姛娻嬞岽峒崠崬徚戙挏昸東棟氡氭涷湩硐笗箽絧胨胴腖苳菄蕫蝀諌迵霘駧鯟鴤鶇鸫鼕",
+	"dou":    "唞斣枓枡梪毭浢窦竇脰荳蚪郖酘鈄閗闘阧餖饾鬥鬦鬪鬬鬭",
+	"du":     "兠凟剢匵厾吺唗嘟妬嬻帾椟橷櫝殬殰涜渎瀆牍牘犢獨琽瓄皾碡秺笃篤篼簵芏荰蔸蝳螙蠧蠹裻覩読讀讟豄賭贕醏錖鍍鑟闍阇靯韇韣韥騳髑黩黷",
+	"duan":   "偳剬塅媏斷椴毈煅瑖碫簖籪緞耑腶葮褍躖鍛鍴",
+	"dui":    "兊兌垖塠対對嵟怼憝憞懟濧瀩痽碓磓祋綐薱譈鐓鐜镦陮隊頧鴭",
+	"dun":    "伅噸墪庉惇撉撴楯橔沌潡炖燉犜獤盹砘礅蜳趸踲蹾躉逇遯鈍頓驐",
+	"duo":    "亸凙刴剟剫咄哚喥嚉嚲垜埵墮墯夛奪奲尮崜嶞悳憜挅挆敓敚敠敪朶枤柁柮桗椯毲炨畓痥綞缍裰趓跢跥踱躱軃鈬鍺鐸铎陊陏飿饳鮵鵽",
+	"e":      "偔僫匎卾吪呃呝咢咹噁噩囮垩堊堮姶屵岋峉峩崿廅悪惡愕戹搤搹枙櫮歞歺涐湂珴琧皒睋砈砐砨硆磀礘腭苊莪萼蕚蚅蝁覨訛詻誐諤譌讍谔豟貖軛軶轭迗遌鈋鈪鍔鑩锇锷閼阏阨阸隲頋頞頟額顎颚餓餩騀魤魥鰐鰪鱷鳄鵝鵞鶚鹗齃齶",
+	"en":     "奀煾蒽",
+	"er":     "佴侕児兒刵厼咡唲尒尓峏弍弐栭栮樲毦洏爾珥粫聏胹荋薾衈袻誀貮貳趰輀轜迩邇鉺铒陑隭餌駬髵鮞鲕鴯鸸",
+	"fa":     "佱傠垡姂彂栰橃沷浌灋琺疺発發瞂砝罰罸茷蕟藅酦醱閥髪髮",
+	"fan":    "凢凣勫噃墦奿婏嬎嬏幡忛憣払旙旛杋柉梵棥橎氾汎渢滼瀪瀿煩燔璠畈盕礬笲笵範籓籵緐繙羳膰舧蕃薠蘩蠜襎訉販蹯軓軬轓釩鐇鐢颿飜飯飰鱕鷭",
+	"fang":   "倣匚埅堏彷旊昉昘枋汸淓牥瓬眆紡舫蚄訪趽邡鈁錺钫髣魴鰟鲂鴋鶭",
+	"fei":    "俷剕厞奜妃婓婔屝廃廢悱扉斐昲暃曊朏杮棐榧櫠淝渄濷狒猆疿痱癈篚緋绯翡胇腓芾萉蕜蜚蜰蟦裶誹費鐨镄陫霏靅靟飛飝餥馡騑騛鯡鲱鼣",
+	"fen":    "偾僨兝兺哛墳奮妢岎帉幩弅憤昐朆朌枌梤棻棼橨濆瀵炃燌燓秎糞紛羒羵翂肦膹蒶蕡蚠蚡衯訜豮豶轒鈖鐼隫雰餴饙馚馩魵鱝鲼黂黺鼖鼢",
+	"feng":   "仹俸偑僼凨凬凮唪堸夆妦寷峯崶捀摓桻楓檒沣沨浲湗漨灃焨煈犎猦甮瘋盽砜碸篈綘縫艂葑蘴蠭覂諷豐賵赗鄷酆鋒鏠闏霻靊風飌馮鳯鳳鴌麷",
+	"fou":    "妚殕缶缹缻雬鴀",
+	"fu":     "乀乶伕俌俛偩冨冹凫刜匐呋呒咈哹嘸圑坿垘垺妋姇娐婦媍嬔孚尃岪峊巿幞弣彿復怤怫懯拊捬撨撫旉枎柎柫栿桴棴椨椱榑泭洑滏澓炥烰焤玞玸琈甶畉畐痡癁盙砆砩祓祔禣秿稃稪竎笰筟箙簠粰糐紨紱紼絥綍綒緮縛绂绋罘罦翇胕膚艀艴芙芣苻茀茯荂荴莩菔萯葍蕧虙蚥蚨蚹蛗蜅蜉蝜蝠蝮衭袝複褔襆覄訃詂諨豧負賦賻赙趺跗踾輔輹輻邞郙郛鄜酜釡鈇鉘鉜鍑鍢阝陚韍韨頫颫馥駙驸髴鬴鮄鮒鮲鰒鲋鳆鳧鳬鳺鴔鵩鶝麩麬麱麸黻黼",
+	"ga":     "呷嘠尜旮錷钆",
+	"gai":    "丐乢侅匃匄垓姟峐忋戤摡晐杚槩槪漑瓂畡祴絠絯荄葢蓋該豥賅賌赅郂鈣阣陔隑",
+	"gan":    "乹亁仠倝凎凲坩尲尴尶尷幹忓扞擀攼旰桿榦橄檊汵泔淦漧澉灨玕疳皯盰矸稈笴筸簳粓紺绀芉苷衦詌贑贛趕迀酐骭魐鰔鱤鳡鳱",
+	"gang":   "冮剛堈堽岡崗掆棡牨犅疘矼綱罁罓罡釭鋼鎠",
+	"gao":    "勂叝吿夰暠杲槀槁槔槹橰檺櫜滜煰皐睾祮祰禞稾筶縞缟羙臯菒藁藳誥诰郜鋯锆餻髙鷎鷱鼛",
+	"ge":     "仡佮個匌呄哿嗝嗰圪塥愅戓戨挌搿擱敋槅滆滒牫牱犵獦硌箇纥肐膈臵舸茖虼蛒袼裓觡諽謌輵轕鎶镉閣閤鞈鞷韐韚騔骼鬲鮯鴐鴚鴿",
+	"gei":    "給",
+	"geng":   "刯哽堩峺挭搄暅浭焿畊絚綆緪縆绠羮莄菮賡赓郠骾鯁鲠鶊鹒",
+	"gong":   "匑厷唝塨宮幊廾愩拲杛栱熕玜珙碽糼羾肱莻觥觵貢躳輁鋛鞏髸龏龔",
+	"gou":    "佝冓坸夠姤媾岣彀搆撀枸構溝煹玽笱篝緱缑耇耈耉芶茩蚼袧褠覯觏訽詬诟豿購遘鈎鉤雊鞲韝",
+	"gu":     "傦僱凅呱唂唃啒嘏堌夃嫴尳峠崓崮愲扢柧梏棝榖榾橭毂汩泒淈濲瀔牯牿痼皷皼盬瞽祻稒穀笟箛篐糓縎罛罟羖脵臌苽菰蓇薣蛄蛌蠱觚詁诂軱軲轂轱逧酤鈲鈷錮钴锢顧餶馉鮕鯝鲴鴣鶻鸪鹄鹘鼔",
+	"gua":    "冎剮劀卦叧啩坬掛栝歄煱絓緺罣罫聒胍詿诖趏踻銽颳騧鴰鸹",
+	"guai":   "叏夬恠掴摑枴柺箉",
+	"guan":   "丱侊倌僙咣垙姯悹悺慣掼摜桄樌毌泴洸涫潅灮炗炛烡爟琯瓘痯瘝癏盥矔礶祼窤筦罆胱舘茪莞蒄覌観觀貫輄輨遦銧錧鏆鑵関闗關雚館鰥鱞鱹鳏鳤鸛鹳黆",
+	"guang":  "俇広廣撗犷獷珖臦臩",
+	"gui":    "亀佹刿劊劌匦匭匱厬垝妫姽媯嫢嬀宄嶡巂帰庋庪廆恑摫撌攰攱昋晷朹桧椝槶槻槼檜櫃櫷歸氿湀猤珪璝瓌癐皈瞡瞶祪禬窐筀簂簋胿膭茥蓕蛫螝蟡袿襘規觤詭貴軌邽郌閨陒鞼騩鬶鬹鮭鱖鱥鲑鳜龜",
+	"gun":    "丨惃滾璭睔睴磙緄绲蓘蔉衮袞袬謴輥鮌鯀鲧",
+	"guo":    "呙咼嘓囯囶囻圀國埚堝墎崞帼幗彉彍惈慖椁槨淉漍濄猓瘑粿綶聝腘膕菓蔮虢蜾蝈蟈輠過鈛錁鍋鐹餜馃馘",
+	"ha":     "铪",
+	"hai":    "嗐妎烸胲還酼醢頦餀饚駭",
+	"han":    "丆佄傼凾厈咁哻唅圅垾娢嫨屽岾崡嵅撖晗晘晥暵梒歛浛浫涆漢澏瀚炶焓熯猂琀甝皔睅筨肣莟菡蔊蘫虷蚶蛿蜬蜭螒譀谽豃貋邗釬銲鋎鋡閈闬阚雗韓頇頷顄顸颔馠馯駻鬫魽鶾鼾",
+	"hang":   "斻珩笐筕絎绗苀蚢貥迒頏颃魧",
+	"hao":    "傐儫哠嗥嘷噑峼恏悎昊昦晧暤暭曍椃毜淏滈澔濠灏灝獆獋獔皓皜皞皡皥秏籇聕薃號蚝蠔諕譹鄗鎬顥颢鰝",
+	"he":     "佫劾厒咊哬啝嗃嗬垎壑姀峆惒抲敆曷柇楁欱毼渮澕焃煂熆熇爀狢癋皬盇盉盍碋礉秴穒篕籺紇翮翯萂蚵螛蠚袔覈訶訸詥謞诃貈賀輅郃鉌鑉闔阖靎靏鞨頜颌饸魺鲄鶡鶮鶴鸖鹖麧齕龁龢",
+	"hei":    "潶黒",
+	"hen":    "佷拫詪鞎",
+	"heng":   "啈姮恆悙桁橫烆胻脝蘅鑅鴴鸻",
+	"hong":   "仜叿吰吽呍嚝垬妅娂宖峵彋揈汯泓浤渱渹潂灴焢玒硔硡竑竤粠紅紘紭綋纮翃翝耾苰荭葒葓蕻薨訇谹谼谾軣輷轟鈜鉷鋐鍧閎闳霐霟鞃魟鴻黉黌",
+	"hou":    "垕堠帿後洉犼瘊睺矦篌糇翭翵葔豞逅郈鄇鍭餱骺鮜鯸鱟鲎鲘",
+	"hu":     "乕乥乯俿冱冴匢匫唿喖嗀嘑嘝嚛囫垀壷壺婟媩嫭嫮寣岵帍幠弖怘怙恗惚戯戶戸戽扈抇搰摢斛昈昒曶枑楛楜槲槴歑汻沍泘浒淴滬滸滹瀫烀焀煳熩猢琥瓠瓳祜笏箶簄粐絗綔縠膴芐苸萀蔛蔰虍虖虝螜衚觳謼護軤轷鄠醐錿鍙鍸隺雐雽韄頀頶餬鬍魱鯱鰗鱯鳠鳸鵠鶘鶦鸌鹕鹱",
+	"hua":    "劃嘩夻姡婳嫿嬅崋搳摦撶杹桦槬樺澅畫畵磆繣舙芲華蒊蕐螖觟話諣譁譮釪釫鋘錵鏵铧驊骅鷨黊",
+	"huai":   "咶壊壞懐懷櫰瀤耲蘹蘾褢褱諙踝",
+	"huan":   "喚喛圜奂奐嬛寏寰峘嵈愌換擐攌梙槵歡洹浣渙漶澣澴烉煥狟瑍環瓛瘓睆瞣糫絙綄緩繯缳羦肒荁萈萑藧豲貆轘逭郇鉮鍰鐶锾镮闤阛雈鬟鯇鰀鲩鹮",
+	"huang":  "偟兤喤堭塃墴奛媓宺崲巟徨怳愰晄曂朚楻榥櫎湟滉潢炾熀熿獚瑝璜癀皝皩穔篁篊縨肓艎葟蟥衁詤諻謊趪遑鍠鎤鐄锽隍韹餭騜鰉鱑鳇鷬黃",
+	"hui":    "佪僡儶匯咴哕喙嘒噅噕噦嚖囘囬圚婎媈嬒孈寭屷幑廻廽彗彙彚徻恚恛恵憓懳拻揮撝晖暉暳會楎槥橞檓櫘殨毀毇泋洃洄浍湏滙潓澮濊瀈灳烠烣煇燬燴獩珲璤璯痐瘣睳瞺禈穢篲絵繢繪缋翙翚翬翽芔茴荟蔧蕙薈薉藱蘳虺蚘蛕蜖蟪袆褘詯詼誨諱譓譭譿诙豗賄輝迴逥鏸鐬闠阓隓隳靧頮顪颒餯鮰鰴麾",
+	"hun":    "俒倱圂堚忶惛慁掍昬梡棔殙涽渾溷焝琿睧睯繉葷觨諢诨轋閽阍餛馄鯶鼲",
+	"huo":    "佸俰剨劐吙咟嚄嚯嚿夥奯捇掝攉旤曤楇檴沎湱漷濩瀖獲癨眓矆矐砉禍秮秳穫耠耯臛艧蒦藿蠖謋貨邩鈥鍃鑊钬锪镬閄靃騞",
+	"ji":     "丌丮乩亟亼亽伋佶偈偮僟兾刉刏剞剤劑勣卙卽叽咭哜唧喞嗘嘰嚌坖垍塈塉墼妀姞姫屐岌峜嵆嵇嵴嶯幾庴廭彐彑彶徛忣惎愱懻戟戢掎揤撃撠擊擠擮敧旡旣暨暩曁朞枅梞楫極槉槣樭機橶檕檝檵櫅殛毄泲洎済湒漃漈潗濈濟瀱焏犄犱狤玑璣畿痵瘠癠癪皀皍矶磯禝禨稘稩稷穄穊積穖穧笄笈筓箿簊紀紒級継緝績繋繼罽羁羇羈耤耭膌臮艥芨芰茍茤荠葪蒺蔇蕀蕺薊薺蘎蘮蘻虀虮螏蟣裚褀襀襋覉覊覬觊觙觭計記誋諅譏譤诘谻賫賷赍趌跡跻跽踖蹐蹟躋躸輯轚郆鄿鈘銈銡錤鍓鏶鐖鑇鑙钑際隮雞雦雧霁霵霽鞿韲飢饑驥骥髻鬾魕魢鯚鰶鰿鱀鱭鱾鲚鲫鳮鵋鶏鶺鷄鷑鸄鹡麂齌齎齏齑",
+	"jia":    "乫仮伽傢價叚唊圿埉夾婽岬幏徦忦恝戛戞扴抸拁斚斝梜椵榎榢槚檟毠泇浃浹犌猳玾珈痂瘕笳糘耞胛腵莢葭蛱蛺袈袷裌豭貑賈跏跲迦郏郟鉀鉫鉿鋏鎵铗镓鞂頬頰餄駕鴶鵊麚",
+	"jian":   "俴倹偂僭儉冿剣剱劍劎劒劔劗囏囝堅堿姦姧寋幵弿徤惤戋戔戩戬挸揀揃搛撿擶旔暕枧栫梘検椷椾楗榗樫檢櫼殲毽洊減湔湕漸澗濺瀐瀳瀸瀽熞熸牋牮犍猏玪珔瑊瑐監睑睷瞷瞼碊磵礆礛笕筧箋篯簡籛糋絸緘縑繝繭缣翦腱臶艦艱菅菺葌葥蒹蔪蕑蕳薦藆虃螹蠒袸裥襇襉襺見覵覸詃諓諫謇謭譼譾谏谫豜豣賎賤趝趼踐踺蹇轞釼鋻鍳鍵鏩鐗鐧鐱鑑鑒鑬鑯鑳锏間鞬鞯韀韉餞餰馢鬋鰎鰹鲣鳒鳽鵳鶼鹣鹸鹻鹼麉",
+	"jiang":  "傋勥匞壃夅奨奬將嵹弜弶彊摪摾杢槳橿櫤殭洚滰漿犟獎畕畺疅礓糡糨絳繮绛缰翞耩膙茳葁蔣薑螀螿袶講謽豇醤醬韁顜鱂鳉",
+	"jiao":   "佼僥僬儌劋呌嘂嘄嘦噍噭姣嬌嬓孂峤峧嶕嶠嶣徺徼恔憍憿挍挢捁摷撟撹攪敎敫敽敿斠晈暞曒湫湬滘漖潐澆灚烄煍燋燞獥珓璬皎皦皭矯穚窌簥絞繳腳膠膲臫艽芁茭茮藠虠蛟蟜蟭訆譑譥賋趭跤踋較轇轎醮釂鉸鐎隦餃驕鮫鱎鲛鵁鷍鷦鷮鹪",
+	"jie":    "丯倢偼傑刦刧刼劼卩卪吤喈喼嗟堦堺婕媎媘嫅孑尐屆岊岕崨嵥巀幯庎徣悈拮掲擑昅桀桝椄楐楬楶榤檞櫭毑湝滐潔煯犗玠琾畍疌疖痎癤砎碣稭節結絜羯脻莭菨蓵蚧蛶蜐蝍蝔蠘蠞蠽衱衸袺褯觧訐詰誡誱謯讦踕躤迼鉣鍻鎅階鞊颉飷骱魝魪鮚鲒鶛",
+	"jin":    "伒侭僅僸儘兓凚劤勁卺厪唫噤嚍埐堇堻墐妗嫤嬧寖嶜巹廑惍搢晉暜枃槿歏殣浕溍漌濅濜燼珒琎瑨瑾璡璶盡矜祲紟緊縉缙荕荩菫蓳藎衿覲觐觔謹賮贐赆進釿錦钅饉馑鹶黅齽",
+	"jing":   "丼亰俓倞傹儆凈刭剄坓坕坙妌婙婛婧宑巠幜弪弳徑憬憼旌旍暻曔桱梷橸汫汬泾浄涇淨濪瀞燛燝猄獍璟璥痙秔稉穽竧竫競竸経經聙肼胫脛腈荊莖菁葏蟼誩踁迳逕鏡阱靓靚靜頚頸驚鯨鵛鶁鶄麖麠鼱",
+	"jiong":  "侰僒冂冋冏囧坰埛扃泂浻澃炅烱煚煛熲絅綗蘏蘔褧迥逈颎駉駫",
+	"jiu":    "丩乆乣倃僦勼匓匛匶啾奺媨廄廏廐慦捄揂揫摎朻柩柾桕樛殧牞糺糾紤舊舏萛赳镹阄韮鬏鬮鯦鳩鷲鸠鹫麔齨",
+	"ju":     "乬侷倨倶僪冣凥刟劇勮匊啹埧埾壉姖娵婅婮寠屦屨岠崌巈巪弆怇怐怚愳懅懼抅拠挙挶掬據擧昛梮椇椈椐榉榘橘檋櫸欅歫毩毱泃泦洰涺淗湨澽焗爠犋犑狊琚痀眗砠秬窭窶筥簴粔粷罝耟聥腒舉艍苣苴莒菹蒟蘜虡蚷蜛袓裾襷詎諊讵豦貗趄趜跔跙跼踘踙踽蹫躆躹輂遽邭郹醵鉅鋦鋸鐻钜锔閰陱雎鞫颶飓駏駒駶驧鮈鮔鴡鵙鵴鶋鶪鼳齟龃",
+	"juan":   "劵勌勬呟埍奆姢巻帣慻捲桊涓淃焆狷獧瓹睊睠絭絹縳罥羂脧臇菤蔨蠲裐鄄錈鎸鐫锩镌隽雋飬餋鵑",
+	"jue":    "亅傕刔劂勪匷厥噘噱孒孓屩屫崛嶥弡彏憠憰戄挗捔撧斍桷橛橜欔欮殌氒決泬焳熦爑爝爴獗玃玦玨珏瑴疦瘚矍矡砄絕絶臄芵蕝蕨虳蚗蟨蟩覐覚覺觖觼訣譎谲貜赽趉趹蹶蹷蹻躩逫鈌鐍鐝钁镢駃鴂鴃鶌鷢龣",
+	"jun":    "儁呁埈姰寯懏捃攈攟晙桾棞汮濬焌燇珺畯皲皸皹碅箘箟莙蚐蜠袀覠軍鈞銁銞鍕陖餕馂駿鮶鲪鵔鵘麇麏麕",
+	"ka":     "佧咔擖胩衉鉲",
+	"kai":    "凱剀剴嘅垲塏奒嵦恺愷暟蒈輆鍇鎧鐦铠锎锴開闓闿颽",
+	"kan":    "侃偘冚埳塪墈崁嵁惂戡栞檻欿歁瞰矙磡竷莰衎輡轗闞顑龕龛",
+	"kang":   "伉匟囥嫝嵻忼摃槺漮犺砊穅粇躿邟鈧鏮钪閌闶鱇",
+	"kao":    "丂攷栲洘燺犒稁銬铐鮳鯌鲓",
+	"ke":     "剋勀勊匼咍嗑嗨堁娔尅岢嵑嶱恪愙揢搕敤榼樖殼氪渇溘炣牁犐珂疴瞌砢碦礊礍礚稞窠緙缂翗胢艐萪薖蝌課趷軻轲醘鈳錒锞顆颏騍骒髁",
+	"ken":    "墾懇肎肻豤錹齦龈",
+	"keng":   "劥妔挳摼牼硁硜硻誙銵鍞鏗铿阬",
+	"kong":   "倥埪崆悾涳硿箜錓鞚鵼",
+	"kou":    "冦剾劶叩宼彄摳敂滱眍瞉瞘窛筘簆芤蔲蔻釦鷇",
+	"ku":     "俈刳喾嚳圐堀崫庫廤扝桍焅狜瘔矻秙絝绔胐袴褲趶跍郀骷鮬",
+	"kua":    "侉咵姱誇銙骻",
+	"kuai":   "儈凷哙噲塊墤巜廥旝狯獪糩脍膾郐鄶鱠鲙",
+	"kuan":   "寛寬欵歀窾臗髋髖",
+	"kuang":  "儣劻匩卝哐圹壙夼岲忹恇懬懭抂昿曠況洭爌眖矌硄礦穬絖纊纩誆誑诓诳貺贶躀軖軦軭邝邼鄺鉱鑛鵟黋",
+	"kui":    "刲匮喟喹嘳夔媿嬇尯巋巙悝愦憒戣揆晆暌楏楑樻櫆欳潰煃犪睽瞆窺篑簣籄聧聩聭聵腃蒉蕢藈蘬蘷虁虧蝰謉跬蹞躨逵鄈鍨鍷鐀鑎闚隗頄頍頯顝餽饋馗騤骙",
+	"kun":    "堃壸壼婫崐崑悃晜梱涃焜猑琨瑻睏硱祵稇稛綑菎蜫裈裍裩褌貇醌錕锟閫閸阃騉髠髡髨鯤鲲鵾鶤鹍齫",
+	"kuo":    "懖拡挄擴桰濶筈萿葀蛞闊霩鞟鞹頢髺鬠",
+	"la":     "剌嚹揦揧搚攋旯柆楋溂爉瓎瘌砬磖翋臈臘菈藞蝋蝲蠟辢邋鑞镴鞡鬎鯻",
+	"lai":    "來俫倈婡崃崍庲徕徠梾棶涞淶猍琜筙箂萊逨郲錸铼騋鯠鶆麳",
+	"lan":    "儖厱唻嚂囒囕壈嬾孄孏岚嵐幱惏懢懶擥攔攬斓斕榄櫴欄欖欗浨漤濑濫瀨瀬瀾灆灠灡燗燣燷爁爛爤璼瓓癞癩睐睞礷籁籃籟籣糷繿纜罱葻藍藾蘭褴襕襤襰襴覧覽譋讕賚賴赉躝醂鑭钄镧闌韊頼顂顲鵣",
+	"lang":   "勆埌塱嫏崀斏朖朤桹樃欴烺瑯硠稂筤艆莨蒗蓈蓢蜋螂誏躴郞鋃鎯锒閬阆駺",
+	"lao":    "僗労勞咾哰唠嘮嫪崂嶗恅憥憦撈栳橑橯浶澇狫痨癆磱窂簩耂耢耮荖蟧躼軂轑醪銠鐒铑铹顟髝",
+	"le":     "仂叻忇扐楽樂氻泐玏砳竻簕艻阞韷鰳鳓",
+	"lei":    "傫儽厽壘壨嫘攂樏檑櫐櫑欙洡涙淚灅瓃畾癗磥礌礧礨禷絫縲纇纍纝缧罍羸耒腂蔂蕌藟蘱蘲蘽虆蠝誄讄诔轠酹銇錑鐳鑘鑸靁頛頪類颣鸓鼺",
+	"leng":   "塄崚碐稜薐輘",
+	"li":     "俚俪儮儷兣凓刕剓剺劙勵厤厯厲呖唎唳喱嚟嚦囄囇坜塛壢娌娳婯嫠孋孷屴岦峛峢峲巁廲悡悧慄戾搮攊攡攦攭斄暦曆曞朸杝枥栃栎栛梩梸棃棙樆檪櫔櫟櫪欐欚歴歷沴浬涖溧澧濿瀝灕爄爏犂犡猁珕琍瑮瓅瓈瓑瓥疠疬癘癧皪盠盭睝矋砅砺磿礪礫礰禮禲秝穲笠筣篥籬粚粝粴糎糲綟縭纚缡罹脷艃苈苙茘荲莅菞蒚蒞蓠蔾藜藶蘺蚸蛎蛠蜊蜧蝷蟍蟸蠇蠡蠣蠫裏裡褵觻詈謧讈豊貍赲跞躒轢轣轹逦邌邐郦酈醨醴釐鉝鋫鋰錅鎘鏫鑗锂隷隸離雳靂靋騹驪骊鬁鯉鯏鯬鱧鱱鱳鱺鲡鳢鳨鴗鵹鷅鸝鹂麗麜黧",
+	"lia":    "倆",
+	"lian":   "亷僆劆匲匳嗹噒堜奁奩媡嫾嬚慩憐戀摙斂梿楝槤櫣殓殮浰湅溓漣潋澰濂濓瀲煉熑燫琏瑓璉磏簾籢籨練縺纞羷翴聫聮聯臁臉萰蓮蔹薕蘝蘞螊蠊裢裣褳襝覝謰蹥連鄻錬鍊鎌鏈鐮鬑鰊鰱鲢",
+	"liang":  "両俍兩哴唡啢喨墚悢掚椋樑涼湸糧綡緉脼蜽裲諒踉輌輛輬辌鍄魉魎",
+	"liao":   "叾嘹嫽寮尞尥尦屪嵺嶚嶛廫憀憭敹暸曢漻炓爒獠璙療瞭窷簝繚缭膋膫蓼藔蟟豂賿蹘蹽遼鄝釕鐐钌镽飉餎饹髎鷯鹩",
+	"lie":    "儠冽劽哷埒埓姴巤挒捩擸栵洌浖煭犣獵睙聗脟茢蛚趔躐迾颲鬛鬣鮤鱲鴷",
+	"lin":    "亃僯冧凜厸啉壣崊嶙廩廪恡悋懍懔撛斴晽暽橉檁檩潾澟瀶焛燐獜璘甐疄痳癛癝瞵矝碄箖粦粼繗翷膦臨菻蔺藺賃蹸躏躙躪轔轥辚遴鄰鏻閵隣驎鱗麐麟",
+	"ling":   "〇刢呤囹坽夌姈婈孁岺嶺彾掕昤朎柃棂櫺欞泠淩澪瀮炩燯爧狑琌瓴皊砱祾秢竛笭紷綾绫翎聆舲苓蓤蔆蕶蘦蛉衑袊裬詅跉軨酃醽鈴錂閝阾霊霗霛霝靈領駖魿鯪鲮鴒鸰鹷麢齡齢龗",
+	"liu":    "劉嚠塯媹嬼嵧廇懰旈旒栁桺橊橮沠浏澑瀏熘熮珋瑠瑬璢畂畄畱疁癅磂磟綹绺罶羀翏蒥蓅藰蟉裗蹓遛鉚鋶鎏鎦鏐鐂锍镏镠雡霤飀飂飅飗飹餾駠駵騮驑骝鬸鰡鶹鷚鹠鹨麍",
+	"long":   "儱哢嚨垅壟壠屸嶐巃巄徿挵攏昽曨朧栊梇槞櫳泷湰滝漋瀧爖珑瓏癃眬矓砻礱礲竉竜篢篭簼籠聾胧茏蕯蘢蠪蠬襱豅贚躘鏧鑨隴霳靇驡鸗龍龒龓",
+	"lou":    "偻僂剅塿婁屚嵝嶁廔慺摟樓溇漊熡甊瘘瘺瘻簍耧耬艛蒌蔞蝼螻謱軁遱鏤镂鞻髅髏",
+	"lu":     "侓僇剹勎勠嚕嚧圥坴垆塶塷壚娽峍廘廬彔摝擄擼攎曥栌椂樐樚橹櫓櫚櫨氌泸淕淥渌滷漉瀂瀘熝爐獹玈琭璐璷瓐甪盝盧睩矑硉硵磠祿稑穋箓簏簬簶籙籚粶纑罏胪膔臚舻艣艪艫菉蓾蔍蕗蘆虂虜螰蠦觮賂趢踛蹗轆轤轳辂辘逯醁錄録錴鏀鏕鏴鐪鑥鑪镥陸顱騄騼髗魯魲鯥鱸鲈鵦鵱鷺鸕鸬鹭鹵黸",
+	"luan":   "亂圝圞奱娈孌孿巒攣曫栾欒灓灤癴癵羉脔臠虊釠銮鑾鵉鸞鸾",
+	"lun":    "侖倫囵圇埨婨崘崙惀掄棆淪溣碖稐綸耣腀菕蜦論踚輪錀陯鯩",
+	"luo":    "倮儸剆啰嗠囉峈摞攞曪椤欏泺洜漯濼犖猡玀珞瘰癳硦笿籮絡纙罖羅脶腡臝荦蓏蘿蠃覙覶覼躶邏鉻鏍鑼镙雒頱饠駱騾驘鮥鴼鵅鸁",
+	"lv":     "侶儢勴呂垏寽屢嵂慮挔捋捛梠榈櫖氀濾爈祣稆穞穭箻絽綠緑縷繂膂膐膟膢葎藘褛褸郘鋁鑢閭闾馿驢鷜",
+	"ma":     "亇傌唛嗎嘜媽嫲嬤嬷孖杩榪溤犘犸獁瑪痲睰碼礣祃禡罵蔴螞蟆蟇遤鎷閁馬駡鬕鰢鷌",
+	"mai":    "佅劢勱嘪売脈荬蕒薶衇買賣邁霡霢霾鷶麥",
+	"man":    "僈墁姏屘幔悗慲摱槾樠満滿澷熳獌睌瞞矕縵缦蔄螨蟎蠻襔謾鄤鏋鏝镘鞔顢饅鬗鬘鰻鳗",
+	"mang":   "吂哤壾娏尨庬恾杗杧汒浝漭牻狵痝硥硭笀茻莾蛖蟒蠎邙釯鋩铓駹",
+	"mao":    "乮兞冃冇冐堥夘媢嫹峁愗懋戼旄昴暓枆柕楙毷氂泖渵牦犛瑁皃眊瞀笷罞耄芼茆萺蓩蝐蝥蟊袤覒貓貿軞鄚鄮酕錨髦髳鶜",
+	"me":     "嚒嚜濹癦麼",
+	"mei":    "凂呅坆堳塺娒媄媺嬍嵄嵋徾抺挴攗旀栂楣楳槑毎沒沬浼渼湄湈煝燘猸珻瑂痗眛睂睸矀祙禖穈篃脄脢腜苺莓葿蘪蝞袂跊郿鋂鎂鎇镅韎鬽魅鶥鹛黣黴",
+	"men":    "亹們悶懑懣扪捫暪椚焖燜玧璊菛虋鍆钔門閅",
+	"meng":   "儚冡勐夢夣幪懜懞懵曚朦橗氋溕濛獴瓾甍甿瞢矇矒礞艋艨莔萠蕄蘉虻蜢蝱蠓鄳鄸錳霥霿靀顭饛鯍鯭鸏鹲鼆",
+	"mi":     "侎冖冞冪咪嘧塓孊宓宻峚幎幦弭彌戂擟攠敉榓樒櫁汨沕沵洣淧淿渳滵漞濔濗瀰灖熐爢猕獼瓕眫瞇祕祢禰簚糸縻罙羃羋脒芈葞蒾蔝蔤藌蘼覓覔覛詸謎謐谧醾醿釄銤镾鸍麊麋麛鼏",
+	"mian":   "丏偭勔喕婂媔嬵宀愐檰櫋汅沔渑湎澠眄矈矊矏糆絻綿緜緬腼臱芇葂蝒靣鮸麪麫麵麺黽黾",
+	"miao":   "媌庿廟杪淼玅眇竗篎緢緲缈邈鱙鶓鹋",
+	"mie":    "幭懱搣櫗滅烕篾薎蠛衊覕鑖鱴鴓",
+	"min":    "僶冺刡勄姄岷崏忞怋惽愍慜憫捪敃敯旻旼暋泯湣潣珉琘瑉痻盿砇碈笢簢緍緡缗罠苠蠠鈱錉鍲閔閩闵鰵鳘鴖",
+	"ming":   "佲冥凕姳嫇慏暝朙椧榠洺溟猽眀眳瞑茗蓂覭詺鄍酩銘鳴",
+	"miu":    "謬",
+	"mo":     "劘劰唜嗼嚤嚩嚰圽塻妺嫫嫼帓帞懡擵昩暯枺橅歾歿殁湐瀎爅獏瘼皌眜眽眿瞐瞙砞礳秣粖糢絈纆耱茉莈蓦藦蛨蟔謨謩谟貃貊貘銆鏌镆靺饃饝馍驀髍魩麽黙",
+	"mou":    "侔劺恈洠眸瞴繆缪蛑謀踎鉾鍪鴾麰",
+	"mu":     "仫凩坶峔幙慔朰楘毣沐炑牳狇畆畒畝畞畮砪縸胟艒苜莯蚞踇鉧鉬钼雮霂鞪",
+	"na":     "乸吶嗱妠拏挐捺笝納肭蒳衲袦豽貀軜鈉鎿镎雫靹魶",
+	"nai":    "倷囡妳嬭廼柰渿疓耏艿萘螚褦迺釢錼鼐",
+	"nan":    "侽喃娚暔枏枬柟楠畘莮諵難",
+	"nang":   "乪嚢欜蠰譨饢馕鬞",
+	"nao":    "匘呶垴堖夒婥嫐峱嶩巎怓悩惱憹撓猱獶獿瑙硇碙碯腦臑蛲蟯詉譊鐃铙閙鬧",
+	"nei":    "內娞氝脮腇錗餒鮾鯘",
+	"nen":    "嫰恁",
+	"ni":     "伱伲儗儞坭埿堄婗嫟嬺孴屔屰怩惄愵抳擬旎昵晲暱柅棿檷氼淣狔猊眤睨秜籾縌聣聻胒腝膩臡苨薿蚭蜺觬誽貎跜輗迡郳鈮铌隬馜鯓鯢鲵麑齯",
+	"nian":   "卄唸埝姩廿撚攆涊淰焾秊秥簐艌跈蹍蹨躎輦辇鮎鯰鲇鲶黏鼰",
+	"niang":  "醸釀",
+	"niao":   "嫋嬝嬲樢脲茑蔦袅裊褭鳥",
+	"nie":    "喦嗫噛嚙囁囓圼孼嵲嶭帇惗揑摰敜枿槷櫱湼痆篞籋糱糵聶臬臲苶菍蘖蠥讘踂踗蹑躡錜鎳鑈鑷钀闑陧隉顳颞齧",
+	"nin":    "囜",
+	"ning":   "佞侫儜咛嚀嬣寍寕寗寜寧擰橣檸濘獰甯矃聍聹苧薴鑏鬡鸋",
+	"niu":    "忸汼炄狃紐莥衂鈕靵",
+	"nong":   "侬儂哝噥挊檂欁濃燶癑禯秾穠繷膿蕽襛農辳醲齈",
+	"nu":     "伮傉孥弩搙砮笯胬駑驽",
+	"nuan":   "渜煖煗餪",
+	"nue":    "瘧硸",
+	"nuo":    "傩儺喏愞懧掿搦搻梛榒橠稬穤糑糥諾蹃逽郍锘",
+	"nv":     "籹釹钕",
+	"ou":     "吘嘔塸櫙歐毆漚熰瓯甌耦腢膒蕅謳讴鏂鴎鷗齵",
+	"pa":     "妑帊掱杷潖皅筢舥葩袙",
+	"pai":    "俳哌廹棑犤猅簰簲蒎輫鎃",
+	"pan":    "冸媻幋拚搫槃沜泮洀溿瀊炍爿牉畨盤眅砙磻縏聁蒰蟠袢襻詊跘蹒蹣鋬鎜鑻鞶頖",
+	"pang":   "厐厖嗙嫎徬沗滂炐肨胮膖舽螃覫逄雱霶鳑龎龐",
+	"pao":    "匏垉奅庖拋炰爮狍疱皰砲礟礮脬軳鞄麃麅麭",
+	"pei":    "伂俖姵嶏帔怌斾旆柸毰浿珮笩肧衃裵賠轡辔醅锫阫霈馷駍",
+	"pen":    "噴歕湓瓫葐",
+	"peng":   "倗剻匉嘭堋塳弸怦恲憉挷掽梈椖椪槰樥淎漰熢皏硑磞稝竼篣纄芃莑蟚蟛踫軯輣錋鑝閛韸韼騯髼鬅鬔鵬",
+	"pi":     "丕仳伓伾噼噽嚊嚭圮埤壀媲嫓岯崥庀悂憵抷揊擗旇朇枇榌毘毞淠渒潎澼炋焷狉狓甓疈疋癖睥磇礔礕秛秠稫篺紕纰罴羆翍耚肶脴腗膍芘苉蚍蚽蚾蜱螷諀豼豾貔邳郫釽鈈鈚鈹鉟銔銢錍铍闢阰陴駓髬魮魾鮍鲏鴄鵧鷿鸊鼙",
+	"pian":   "囨媥楄楩犏翩胼腁覑諚諞谝貵賆跰蹁鍂駢騈騗騙骈骿鶣",
+	"piao":   "僄剽勡嘌嫖彯徱慓旚殍犥皫瞟竂篻縹缥翲薸螵醥闝顠飃飄魒",
+	"pie":    "撆暼氕",
+	"pin":    "嚬姘娦嫔嬪榀汖牝獱玭琕矉礗穦薲蠙貧頻顰颦馪驞",
+	"ping":   "俜凴呯娉屛帡帲幈慿憑枰檘泙洴涄淜焩玶甁甹砯竮箳簈缾聠胓艵荓蓱蘋蚲蛢評軿輧郱頩鮃鲆",
+	"po":     "叵嘙娝尀岥岶敀昢桲櫇洦溌潑炇烞珀皤砶笸蒪蔢謈鄱醗釙鉕鏺钋钷頗駊",
+	"pu":     "僕匍噗圤墣撲擈攴樸檏氆溥潽濮烳獛璞瞨穙纀舖舗菐蒱襥諩譜蹼酺鋪鏷鐠镤镨陠鯆",
+	"qi":     "亓亝俟倛僛剘呇呮咠唘唭啓啔啟嘁噐圻埼夡娸婍屺岐岓帺忔忯悽愭慼慽憇憩懠掑摖攲斉斊旂晵暣杞栔桤桼棄棊棨棲榿槭檱櫀欫気氣汔淇淒渏湆湇濝炁猉玂玘琦琪璂甈畁疧盀盵矵碁碕碛碶磜磧磩祇祺禥竒簯簱籏粸綥綦綨綮綺緀緕纃绮缼罊耆肵臍艩芑芞芪萁萋萕葺蕲藄蘄蚑蚔蚚蛣蛴蜝蜞螧蟿蠐褄訖諆諬諿豈跂踑蹊軝迉邔郪釮錡鏚锜闙霋頎颀騎騏骐鬐鬿魌鯕鰭鲯鳍鵸鶀鶈麒麡鼜齊",
+	"qia":    "冾圶帢愘拤殎硈葜跒酠髂",
+	"qian":   "仱佥俔倩傔僉儙兛凵刋嗛圱圲塹墘壍奷婜媊孅孯岍岒嵰忴悓悭愆慊慳扲拑拪掔掮揵搴撁攐攑攓杄棈椠榩槏槧橬檶櫏欦歬汘汧淺潛濳灊牽瓩皘箝箞篏篟簽籤粁綪縴繾缱羬肷脥膁臤芊芡茜茾蒨蔳蕁虔蚈蜸褰諐謙譴谸軡輤遷釺鈆鈐鉗鉛銭錢钤阡雃靬韆顅騚騝騫骞鬜鬝鰜鰬鵮鹐黚",
+	"qiang":  "丬嗆墏墻嫱嬙嶈廧強戕戗戧搶斨椌槍樯檣溬漒牄牆猐玱瑲篬繈繦羗羟羥羫艢蔃薔蘠蜣襁謒跄蹌蹡錆鎗鏘鏹锖锵镪",
+	"qiao":   "僑僺劁喬嘺墝墽嫶嵪帩幧愀憔撽樵橋殻毃燆癄硗硚磽礄竅繑缲翹荍荞菬蕎藮誚譙诮谯趫趬跷踍蹺躈郻鄡鄥釥鍫鍬鐈鐰陗鞒鞽韒頝顦骹髚髜",
+	"qie":    "匧妾悏惬愜挈朅洯淁穕竊笡箧篋緁藒蛪踥郄鍥鐑锲鯜",
+	"qin":    "吢吣唚嗪噙坅埁媇嫀寑寢寴嵚嶔庈慬懃懄抋捦揿搇撳斳昑梫檎欽溱澿瀙珡琹瘽笉綅耹芩菣菦菳藽蚙螓螼蠄衾親誛赾鈙鋟锓雂靲顉駸骎鬵鮼鳹鵭",
+	"qing":   "傾凊剠勍圊埥夝寈庼廎慶掅擏暒棾樈檠檾殑殸氫淸漀狅甠碃磘磬箐罄苘葝蜻請謦輕郬鑋靑靘頃鲭黥",
+	"qiong":  "儝卭宆惸憌桏橩焪焭煢璚瓊瓗睘瞏穹窮竆笻筇舼茕藑藭蛩蛬赹跫邛銎",
+	"qiu":    "丠俅叴唒坵媝崷巯巰恘扏梂楸殏毬汓浗渞湭煪犰玌璆皳盚秌穐篍紌絿緧肍莍萩蓲虬虯蚯蛷蝤蝵蟗蠤裘觓觩訄訅賕赇趥逎逑遒醔釓釚銶鞦鞧鮂鯄鰌鰍鰽鳅鶖鹙鼽龝",
+	"qu":     "伹佉佢刞劬匤區厺呿唟坥岖岨岴嶇忂憈戵抾敺斪朐欋氍浀淭灈璖璩癯瞿磲祛竘竬筁籧粬紶絇翑耝胊胠臞菃葋蕖蘧蛐蝺螶蟝蠷蠼衢袪覰覷覻觑詓詘誳诎趨躣軀軥鑺镼閴闃阒阹駆駈驅髷魼鰸鱋鴝鸜鸲麮麯麴麹黢鼁鼩齲",
+	"quan":   "佺勧勸啳圏埢姾婘孉峑巏弮恮悛惓搼棬権權汱洤湶烇牶牷犈瑔畎硂筌絟綣縓绻荃葲虇蜷蠸觠詮诠跧踡輇辁銓鐉铨韏顴駩騡鬈鰁鳈齤",
+	"que":    "卻埆塙墧寉崅悫愨慤搉灍燩琷皵硞碏確碻礐礭蒛趞闋闕阕阙鵲",
+	"qun":    "宭帬羣裠",
+	"ran":    "冄呥嘫姌媣橪珃繎肰苒蚦蚺衻袇袡髥髯",
+	"rang":   "儴勷壌懹瀼爙獽禳穣穰纕蘘譲讓躟鬤",
+	"rao":    "娆嬈擾桡橈繞荛蕘襓遶隢饒",
+	"re":     "熱",
+	"ren":    "亻仞仭刄姙屻岃忈忎扨朲杒栠栣梕棯牣祍秂秹稔紉紝絍纴肕腍芢荏荵葚衽袵訒認讱軔軠轫鈓銋靭靱韌飪餁饪魜鵀",
+	"reng":   "礽辸陾",
+	"ri":     "囸釰鈤馹驲",
+	"rong":   "傇坈媶嫆嬫宂嵘嵤嶸巆搈搑曧栄榕榮榵毧氄瀜烿爃狨瑢穁絨縙羢肜茙蝾螎蠑褣軵鎔镕駥髶",
+	"rou":    "厹媃宍楺渘煣瑈瓇禸粈糅腬葇蝚蹂輮鍒鞣韖騥鰇鶔",
+	"ru":     "侞嗕嚅媷嬬帤擩曘桇洳渪溽濡燸筎縟缛肗蒘蓐蕠薷蝡袽襦邚鄏醹銣铷顬颥鱬鴑鴽",
+	"ruan":   "偄媆朊瑌瓀碝礝緛耎軟輭",
+	"rui":    "叡壡枘橤汭睿繠芮蕋蘂蘃蚋蜹銳鋭",
+	"run":    "橍潤膶閏閠",
+	"ruo":    "偌叒楉渃焫爇箬篛蒻鄀鰙鰯鶸",
+	"sa":     "仨卅挱挲摋櫒泧潵灑脎薩虄訯躠鈒靸颯飒馺",
+	"sai":    "僿嗮噻愢揌毢毸簺賽顋鰓",
+	"san":    "仐俕傘帴弎悷毵毿犙糁糂糝糣糤繖鏒鏾閐霰饊馓鬖",
+	"sang":   "喪搡桒磉褬鎟顙颡",
+	"sao":    "慅掃掻溞繅缫臊騒騷鰠鱢鳋",
+	"se":     "啬嗇懎擌栜歮歰洓澀澁濇瀒琗璱瘷穑穡繬譅轖銫鏼铯雭飋",
+	"sen":    "椮槮襂",
+	"seng":   "鬙",
+	"sha":    "乷倽儍剎唦唼啑喢帹廈桬榝樧歃殺毮猀痧硰箑粆紗翜翣萐蔱裟鎩铩閯霎魦鯊鯋鲨",
+	"shai":   "曬篩簁簛繺酾釃閷",
+	"shan":   "傓僐刪剡剼嘇埏墠墡姍姗嬗幓彡挻掞搧晱柵樿檆歚潬潸澘灗煔熌狦疝痁睒磰笘縿繕羴羶脠膻舢芟蟮蟺覢訕謆譱讪贍赸跚軕邖鄯釤銏鐥钐閃陝饍騸骟鯅鱓鱔鳝",
+	"shang":  "丄仩傷垧尙恦慯扄殇殤滳漡熵緔绱蔏螪觞觴謪賞贘鑜鞝鬺",
+	"shao":   "劭卲娋弰旓柖潲焼燒玿睄竰筲紹綤艄苕莦蛸袑輎颵髾鮹",
+	"she":    "佘厍厙弽慴懾捨摂摵攝檨欇歙涻渉滠灄猞畬畲蔎虵蛥蠂設賒賖輋韘騇麝",
+	"shen":   "侁侺兟哂堔妽姺嬸宷審屾峷弞愼扟敒昚曋曑柛棽椹榊氠涁渖滲瀋燊珅甡甧瘆瘮眒眘瞫矤矧祳穼籶籸紳罧胂脤腎莘葠蓡蔘薓蜃蜄裑覾訠訷詵諗讅诜谂谉邥鋠頣頥駪魫鯵鰰鰺鲹鵢",
+	"sheng":  "偗剰勝呏墭嵊憴斘昇晠栍榺殅泩渻湦焺狌珄琞眚笙繩聖聲苼蕂譝貹賸鉎阩陞陹鵿鼪",
+	"shi":    "丗乨乭亊佦兘冟勢卋叓呞呩埘塒奭姼媞嬕実宩寔實屍峕崼嵵師弑弒徥忕恀戺揓旹昰時枾柹栻榁榯浉湜湤溡溮溼澨濕炻烒煶獅瑡眂眎眡睗礻祏竍笶筮篒簭籂絁舐舓莳葹蒒蒔蓍蝕蝨螫褷襫襹視觢試詩諟諡謚識谥豉豕貰贳軾轼辻遈適遾邿釈釋釶鈟鈰鉂鉃鉇鉈鉐鉽銴鍦铈飠飾餙餝饣駛鮖鯴鰘鰣鰤鲥鲺鳲鳾鶳鸤鼫鼭",
+	"shou":   "収垨壽夀涭狩獸痩綬绶艏鏉",
+	"shu":    "侸倏倐儵凁咰塾姝婌尌尗屬庻怷捒掓摅攄數暏書朮杸樞樹橾殳毹沭潄潻澍濖焂瑹璹疎癙秫竪紓絉綀纾腧荗菽蒁薥藷虪術裋襡襩豎贖跾踈軗輸鄃鉥錰鏣钃陎隃鮛鵨鶐鼡",
+	"shua":   "唰",
+	"shuai":  "卛帥蟀",
+	"shuan":  "閂闩",
+	"shuang": "塽孀孇慡樉欆漺礵縔艭雙騻驦骦鷞鸘鹴",
+	"shui":   "帨涗涚瞓祱稅脽裞誰",
+	"shun":   "橓瞚蕣順鬊",
+	"shuo":   "哾妁搠槊欶爍獡矟碩箾蒴說説鎙鑠铄",
+	"si":     "亖佀価儩兕凘厮厶咝噝姒娰媤孠廝杫柶楒榹汜泀泗泤洍涘澌瀃燍牭磃祀禗禠禩竢笥籭糹絲緦纟缌罳耜肂蕬蕼虒蛳蜤螄蟖蟴覗貄釲鈶鈻鉰鋖鐁锶颸飔飤飼駟騦驷鷥鸶鼶",
+	"song":   "倯傱凇娀崧嵩嵷庺忪悚愯慫憽枀柗梥楤檧淞濍硹竦聳菘蜙訟誦鍶頌餸駷鬆",
+	"sou":    "傁叜叟嗖嗾廀廋捜摉摗擻櫢溲獀瞍籔蒐蓃薮藪螋鄋醙鎪锼颼颾飕餿馊騪",
+	"su":     "傃嗉囌塐夙嫊愫愬憟梀榡樎樕橚櫯殐泝洬涑溸潚潥玊珟璛甦碿稣穌窣簌粛縤肅膆莤蔌藗蘇蘓觫訴謖谡趚蹜遡遬鋉餗驌骕鯂鱐鷫鹔",
+	"suan":   "匴狻痠祘笇筭",
+	"sui":    "亗倠哸埣夊嬘嵗攵旞檅檖歲歳浽滖澻濉瀡煫熣燧璲瓍眭睟睢砕禭穂穟綏繀繐繸膸芕荽荾葰襚誶譢谇賥遀邃鐆鐩隨雖鞖韢髄",
+	"sun":    "孫搎槂狲猻荪蓀蕵薞飧飱",
+	"suo":    "乺傞唢嗍嗩娑惢損摍暛桫榫溑瑣璅睃筍箰簑簔簨縮羧莏褨趖鎈鎍鎖鎨鎻鏁隼髿鮻鶽",
+	"ta":     "亣侤咜嚃嚺墖崉搨撻榙榻橽毾涾溚溻澾濌牠狧獺祂禢褟誻譶趿跶蹹躢遝遢錔铊闒闥闧闼鞜鞳鮙鰨鳎",
+	"tai":    "儓冭囼坮夳嬯孡忲態擡旲枱檯溙炱炲燤箈籉肽臺舦菭薹跆邰鈦钛颱駘鮐鲐",
+	"tan":    "倓傝僋嗿嘆埮墰墵壇壜婒忐怹惔憛憳憻抩擹攤昙曇榃歎湠灘燂璮痑癱磹罈罎舑舕菼藫襢覃談譚譠貚貪賧郯醈醓醰鉭錟钽锬顃餤",
+	"tang":   "伖偒傏傥儻劏啺嘡坣帑戃摥曭榶樘橖湯溏漟煻燙爣瑭矘磄禟篖糃糛羰耥膅蓎薚蝪螗螳赯踼蹚鄌醣鎕鎲鏜鐋钂铴镋镗闛隚鞺餳餹饄饧鶶鼞",
+	"tao":    "匋咷啕夲嫍幍弢慆搯梼槄檮洮濤瑫祹絛綯縚縧绹蜪裪討詜謟轁迯醄鋾錭鞀鞉鞱韜韬飸饀饕駣騊鼗",
+	"te":     "忑忒慝熥膯蚮螣蟘貣鋱铽鼟",
+	"teng":   "儯幐滕漛痋籐籘縢謄邆駦騰驣鰧",
+	"ti":     "倜偍厗嗁嚔屜崹嵜徲悌悐惖惿戻挮掦揥擿朑楴歒殢洟漽瑅瓋碮禵稊笹籊綈緹绨缇罤苐荑蕛薙蝭裼褅褆謕趧趯蹏躰軆迏逖逷遆醍銻鍗題騠骵體髰鬀鮧鮷鯷鳀鴺鵜鶗鶙鷈鷉鷤鹈",
+	"tian":   "倎兲唺塡婖屇忝悿搷晪殄沺淟湉琠璳甛畋畑畠痶盷睓磌窴緂胋菾覥觍賟酟鈿錪鍩闐阗靔靝靦餂鷆鷏黇",
+	"tiao":   "佻嬥宨岧岹庣恌斢旫晀朓條樤祒祧窕窱笤粜糶絩聎脁芀萔蓚蓨蜩覜誂趒鋚鎥鞗髫鯈鰷鲦齠龆",
+	"tie":    "僣怗聑萜蛈貼銕鋨鐡鐵驖鴩",
+	"ting":   "侹厛圢娗婷嵉庁廰廳桯梃楟榳涏渟烴烶珽町甼筳綎耓聤聴聼聽脡艼莛葶蜓蝏誔諪邒閮霆鞓頲颋鼮",
+	"tong":   "仝佟僮勭哃嗵囲峂峝庝恸慟憅晍曈朣樋橦氃浵潼炵烔燑犝狪獞痌眮砼秱筩粡統綂膧茼蓪蚒衕詷赨鉖鉵銅餇鮦鲖",
+	"tou":    "亠偸妵婾媮敨紏綉緰蘣鋀鍮钭頭飳骰黈",
+	"tu":     "兎凃唋図圕圖圗圡堍堗塗宊峹嵞嶀庩廜怢悇捈捸揬梌汢涋湥潳痜瘏禿稌筡腯荼莵菟葖蒤跿迌酴釷鈯鋵鍎钍馟駼鵌鵚鵵鶟鷋鷵鼵",
+	"tuan":   "剸団團慱抟摶槫檲漙煓猯篿糰貒鏄鷒鷻",
+	"tui":    "侻俀僓娧尵弚煺穨蓷藬蘈蛻蹆蹪隤頹頺頽駾骽魋",
+	"tun":    "呑啍噋坉忳暾朜涒焞臋芚豘豚軘霕飩饨魨鲀黗",
+	"tuo":    "乇仛佗侂咃坨堶媠嫷岮庹彵扡拕挩捝杔柝楕槖橐橢毤毻汑沰沱沲涶狏砣砤碢箨籜紽脫莌萚蘀袉袥託讬跅跎迱酡陁飥饦馱駄駝駞騨驒驝鬌魠鮀鰖鴕鵎鼉鼍鼧",
+	"wa":     "佤劸咓嗗嗢娲媧屲搲攨溛漥瓲畖穵窊窪聉腽膃襪邷韈韤鼃",
+	"wai":    "喎夞崴竵顡",
+	"wan":    "倇刓剜卍卐唍埦塆壪妧婠岏帵彎忨抏捖捥晩晼梚椀汍潫澫灣琓琬畹盌睕紈綩綰纨绾翫脕脘芄菀萖萬薍蜿蟃貦贃贎踠輐輓鋄鋔錽鎫頑",
+	"wang":   "亾仼兦尣尩尪尫彺徃徍惘暀朢棢瀇焹盳網罒罔莣菵蚟蛧蝄誷輞辋迋魍",
+	"wei":    "偉偎偽僞儰厃叞喡喴囗圍圩壝娓媁媙媦寪屗峗峞崣嵔嵬帏帷幃徫愄愇懀揋揻撱斖暐梶椲椳楲欈沩洈洧浘涠渨湋溈溦潙潿濰濻瀢炜為烓煒煟煨熭燰爲犚犩猥玮琟瑋璏痏痿癓硊硙碨磈磑維緭緯罻腲艉芛苿荱菋葦葨葳蒍蓶蔿薇薳藯蘤蘶蜲蜼蝛螱衛衞褽覣覹詴諉謂讆讏诿踓躗躛軎轊逶違鄬醀鍏鍡鏏闈闱隇隈霨霺韋韑韙韡韪頠颹餧餵饖骩骪骫鮇鮠鮪鰃鰄鲔鳂鳚",
+	"wen":    "刎匁呚呡問塭妏彣忟抆揾搵昷桽榅殟汶渂溫炆玟珳琝瑥璺瘒穏穩紋聞肳脗芠莬蕰蚉螡蟁豱輼轀辒鈫鎾閺閿闅闦阌雯鞰顐馼魰鰛鰮鳁鳼鴍鼤",
+	"weng":   "勜塕奣嵡暡滃甕瞈罋聬蓊蕹螉鎓鶲鹟齆",
+	"wo":     "仴倭偓唩婐媉幄捰捾撾擭枂楃涴涹渥渦濣焥猧瓁瞃硪窩肟腛臒臥莴萵蝸踒雘齷龌",
+	"wu":     "乄仵伆俉倵儛兀剭務卼吳呉唔啎嗚圬塢奦妩娪娬婺嫵寤屼岉嵍嵨庑廡弙忢忤怃悞悮憮扤摀敄旿杇杌橆歍汙汚洖洿浯溩潕烏焐無熃熓牾玝珷珸瑦璑甒痦矹碔祦禑窏窹箼粅芴茣莁蕪蘁蜈螐蟱誈誣誤譕躌迕逜遻邬郚鄔鋈錻鎢铻阢隖雺霚霧靰騖骛鯃鰞鴮鵐鵡鶩鷡鹀鹉鹜鼯鼿齀",
+	"xi":     "係俙傒僖兮凞匸卌卥厀呬咥唏唽喺噏嚱囍墍壐奚嬆嬉屃屖屣屭嵠嶍嶲巇徆徙徯忚忥怬怸恄恓悕惁慀憘憙戱戲扱扸捿晞晳暿曦枲桸椞椺榽槢樨橀橲欯欷歖氥浠淅渓滊漇漝潝潟澙焁焈焟焬煕熂熈熹熺熻燨爔犔犠犧狶玺琋璽瘜皙盻睎瞦矖磎磶礂禊禧稧穸窸粞糦細綌緆縘縰繥繫绤羲習翕翖肸肹舃舄舾莃菥葈葸蒠蒵蓆蓰蕮薂虩蜥螅螇蟋蟢蠵衋襲覀覡覤觋觹觽觿諰謑謵譆谿豀豨豯貕赥赩趇趘蹝躧郋郗郤鄎酅醯釳釸鈢鉨鉩錫鎴鏭鑴闟阋隟隰隵雟霫霼飁餏餼饩饻騱騽驨鬩鯑鰼鱚鳛鵗鸂黖鼷",
+	"xia":    "丅乤俠傄嚇夓峽懗敮柙炠烚煆煵狎狹珨瑕疜疨睱硖硤碬磍祫筪縀縖罅翈舝舺蕸虲蝦谺赮轄遐鍜鎋鎼鏬閕閜陜陿颬騢魻鰕鶷黠",
+	"xian":   "仚伣伭佡僊僩僲僴冼咞哯唌啣嘕垷壏奾妶姭娊娨娴娹婱嫺嫻嬐尟尠屳岘峴崄嶮幰廯忺憪憲憸挦搟撊撏攇攕晛暹杴枮橌櫶毨氙涀澖瀗灦烍燅燹狝猃獫獮獻玁珗現甉痫癇癎県睍瞯硍礥祆禒秈稴筅箲籼粯糮絃絤綫線縣繊纎纖缐羨胘臔臽苋苮莧莶薟藓藖蘚蚬蚿蛝蜆衘褼襳訮誢誸諴譣豏賢贒赻跣跹蹮躚輱酰醎銑銛銜鋧錎鍁鍌鑦铦閑閒陥険險韅韯韱顕顯餡馦鮮鱻鶱鷳鷴鷼鹇鹹麙麲鼸",
+	"xiang":  "亯佭勨啌嚮塂姠嶑庠廂忀晑曏栙欀珦瓖瓨稥絴緗缃缿膷芗萫葙薌蚃蟓蠁衖襐詳跭郷鄉鄊鄕銄銗鐌鑲闀響項飨餉饗饟饷驤骧鮝鯗鱌鱶鲞麘",
+	"xiao":   "侾俲傚効呺咲哓嘋嘐嘨嘯嘵嚻囂婋宯崤庨彇憢揱敩斅斆暁曉枭枵梟櫹歊歗殽毊洨涍潇瀟灱灲焇熽猇獢痚痟皛皢硣穘窙笅筊筱筿箫篠簘簫綃绡翛膮萷蕭藃虈虓蟂蟏蟰蠨訤詨誟誵謏踃逍郩銷鞩驍骁髇髐魈鴞鴵鸮",
+	"xie":    "亵伳偕偞偰僁冩劦勰協卨嗋噧垥塮奊娎媟寫屓屟屧峫嶰廨徢恊愶拹挾揳撷擕擷攜旪暬榍榭洩渫澥瀉瀣灺炧烲焎熁燮燲爕猲獬瑎祄禼糏紲絏絬綊緤緳繲纈绁缬缷翓脅脇膎薢薤藛蝢蠍蠏衺褉褻襭諧謝讗躞邂鞢鞵韰頡駴齂齘齛齥龤",
+	"xin":    "伈伩俽囟妡嬜孞廞惞昕杺枔歆炘焮煡盺脪舋襑訢訫軐邤釁鈊鋅鐔鑫阠顖馨馫馸",
+	"xing":   "侀倖垶娙婞嬹悻擤曐洐涬滎煋瑆皨睲硎箵篂緈臖興荇荥莕蛵觪觲郉鈃鉶銒鋞鍟钘铏陉陘騂骍鮏鯹",
+	"xiong":  "兇哅忷恟洶胷訩詾讻賯",
+	"xiu":    "俢咻岫峀庥樇溴滫烋烌珛琇璓糔綇繍繡脙脩臹苬螑褎褏貅銝銹鎀鏅鏥鏽飍饈馐髤髹鮴鱃鵂鸺齅",
+	"xu":     "伵侐俆偦冔勖勗卹呴喣噓垿壻姁媭嬃幁怴慉揟敍敘旴昫晇暊朂栩楈槒欨欰歔殈汿沀洫湑溆漵潊烅烼煦獝珝珬疞盢盨盱瞁瞲稰稸窢糈緒緖縃繻續聟胥芧蒣蕦藇藚虗虛蝑裇訏許訹詡諝譃诩谞賉鄦醑銊鑐須頊顼驉鬚魆魖魣鱮",
+	"xuan":   "儇吅咺塇媗嫙弲怰愃愋懁懸揎昍昡晅暄暶梋楥楦檈泫渲漩炫烜煊玹琁琄瑄璇璿痃癬眴睻矎碹禤箮絢縇縼繏翧翾萱萲蓒蔙蕿藼蘐蜁蝖蠉衒袨諠諼譞讂谖贙軒選鉉鋗鍹鏇铉镟鞙顈颴駽",
+	"xue":    "乴吷坹壆學岤峃嶨斈桖泶澩瀥燢狘疶茓蒆袕觷謔谑趐踅辥辪雤鞾鱈鳕鷽鸴",
+	"xun":    "伨侚偱勛勲勳卂噀噚嚑坃埙塤壎壦奞尋峋巺巽廵徇恂愻揗攳曛杊栒桪槆樳殾毥洵浔潃潠潯灥焄燖燻爋狥獯珣璕畃矄稄窨紃纁臐荀荨蔒蕈薫薰蘍蟳訊訓訙詢賐迿遜鄩醺鑂顨馴駨鱏鱘鲟鵕",
+	"ya":     "乛亜亞伢俹劜厊厑厓吖唖啞圔圠圧垭埡堐壓娅婭孲岈崕庌庘挜掗揠枒桠椏氩氬漄犽猚猰玡琊瑘痖瘂睚砑稏窫笌聐蕥襾訝軋迓錏鐚铔鴉鴨鵶齖齾",
+	"yan":    "乵俨偃偐偣傿儼兖兗剦匽厣厭厳厴啱喭噞嚥嚴塩墕壛壧夵妍妟姲姸娫娮嫣嬊嬮嬿孍崦嵃嵒嵓嶖巌巖巗巘巚弇彥恹愝懕懨戭扊抁揅揜敥昖晏暥曕曣曮棪椻椼楌檐檿櫩沇淊渰渷湮湺溎滟漹灎灔灧灩烻焑焔焱煙燄爓牪狿猒珚琂琰甗硏硯硽碞礹筵篶簷綖縯罨胭腌臙艶艷芫莚菸萒葕蔅虤蝘裺褗覎觃觾詽諺讌讞讠谳豓豔贋贗赝躽遃郔郾鄢酀酓酽醃醶醼釅閆閹閻闫隁隒顏顔顩餍饜騐験騴驗驠鬳魇魘鰋鳫鴈鴳鶠鷃鷰鹽麣黡黤黫黬黭黶鼴鼹齞齴龑",
+	"yang":   "佒傟劷咉坱垟姎岟崵崸徉怏恙慃懩抰揚攁敭旸昜暘柍楊楧様樣氜氱泱瀁炀炴烊煬珜瘍癢眏眻禓紻羏羕胦蛘蝆詇諹軮輰鉠鍚鐊钖阦陽雵霷鞅颺飏養駚鰑鴦鴹鸉",
+	"yao":    "仸倄偠傜吆喓嗂垚堯夭婹媱宎尭岆峣崾嶢嶤幺徭愮抭揺搖暚曜杳枖柼楆榚榣殀溔烑熎燿爻狕猺獟珧瑤眑矅祅穾窅窈窔窯窰筄繇纅肴艞苭葯葽蓔薬藥蘨袎覞訞詏謠謡讑軺轺遙邎銚鎐鑰闄靿顤颻飖餆餚騕鰩鳐鴁鴢鷂鷕鹞鼼齩",
+	"ye":     "亪亱倻僷吔啘嘢嚈埜堨墷壄嶪嶫抴捓揶擛擨擪擫晔暍曄曅曗曵枼枽楪業歋殗漜潱澲烨燁爗爺皣瞱瞸礏葉蠮謁谒邺鄓鄴釾鋣鍱鎁鎑鐷铘靥靨頁餣饁馌驜鵺鸈",
+	"yi":     "乁乂乊亄伇伿佁佚佾侇俋偯儀億兿冝凒刈劓劮勚勩匇匜吚呓呭呹咦咿唈噫囈圛圯坄垼埶埸墿壱夁奕媐嫕嫛嬄嬑嬟宐宧寱寲峄峓崺嶧嶬嶷巸帟帠幆庡廙弈弋弌弬彛彜彞怈怡怿恞悒悘悥憶懌懿扅扆拸挹捙掜撎攺敡敼斁旑旖晹暆曀曎杙枍枻柂栘栧栺桋棭椬椸榏槸檍檥檹欥欭欹歝殔殪殹毉沶泆洂洢浂浥浳湙漪潩澺瀷炈焲熠熤熪熼燚燡燱狋猗獈玴珆瑿瓵畩異痍痬瘗瘞瘱癔眙睪瞖硛礒祎禕秇稦穓竩笖箷簃籎縊繄繶繹缢羛羠義羿翊翳耛耴肊肔膉舣艗艤芅苅苡苢萓萟蓺薏藙藝蘙虉蛜蛡蛦蜴螔螘螠蟻衤衪衵袘袣裛裿褹襼觺訑訲訳詍詑詒詣誃誼謻譩譯議讉讛诒豙豛豷貤貽賹贀贻跇跠踦軼輢轙轶辷迆迤迻逘遺郼酏醫醳醷釔釴鈠鉯銥鎰鏔鐿钇镒镱陭隿霬靾頉頤顊顗飴饐饴駅驛驿骮鮨鯣鳦鶂鶃鶍鷁鷊鷖鷧鷾鸃鹝鹢鹥黓黟黳齮齸",
+	"yin":    "乑乚侌冘凐吲喑噖噾嚚囙圁垔垠垽堙堷夤婣婬峾崟崯嶾廕廴愔慇慭憖憗懚摿斦朄栶檃檭檼櫽歅殥氤泿洇洕淾湚溵滛濥濦烎犾狺猌珢璌瘖瘾癊癮碒磤禋秵筃絪緸胤苂茚荶蒑蔩蔭蘟蚓螾蟫裀訔訚訡誾諲讔赺趛輑鄞酳鈏鈝銀銦铟闉阥陰陻隂隠隱霒霠霪靷鞇韾飮飲駰骃鮣鷣齗龂",
+	"ying":   "偀僌啨営嘤噟嚶塋媖媵嫈嬰嬴孆孾巊廮応愥應摬撄攍攖攚暎朠桜梬楹櫻櫿浧渶溁溋滢潁潆濙濚濴瀅瀛瀠瀯瀴灐灜煐熒營珱瑛瑩璄璎瓔甇甖瘿癭盁矨碤礯穎籝籯緓縄縈纓绬罂罃罌膡膺茔莺萦萾蓥藀蘡蛍蝧蝿螢蠅蠳褮覮謍譍譻賏贏軈郢鍈鎣鐛鑍锳霙鞕韺頴颍颕鱦鴬鶑鶧鶯鷪鷹鸎鸚鹦",
+	"yo":     "唷喲",
+	"yong":   "俑傛傭勈喁嗈噰埇塎墉壅嫞嵱廱彮悀惥愑愹慂慵揘擁柡栐槦湧滽澭灉牅甬癕癰硧禜苚詠踴邕郺鄘醟鏞镛雝顒颙饔鯒鰫鱅鲬鳙鷛",
+	"you":    "丣亴侑偤優卣呦哊唀唹嚘囿姷孧宥尢峟峳庮怣怮憂懮扜攸斿柚栯梄楢槱櫌櫾毺沋泑浟湵滺瀀牖牗牰狖猶猷疣瘀盓祐禉秞穻箊糿紆纋纡羐羑耰聈肬脜苃莜莠莸蒏蕕虶蚰蚴蜏蝣訧誘貁輏輶迃迶逌逰遊郵鄾酭鈾銪铕陓駀魷鮋鱿鲉麀黝鼬",
+	"yu":     "乻亐伃伛俁俣俼偊傴儥兪匬喅喐喩噊噳圄圉圫堉堣堬妤妪娛娯媀嫗嬩寙峿崳嵎嵛嶎嶼庽庾彧忬悆惐慾懙戫扵揄敔斔斞於旕旟昱杅桙棛棜棫楀楡楰櫲欎欝欤歈歟歶毓淢淯湡滪漁潏澞澦灪焴煜燏燠爩牏狳獄玗玙琙瑀瑜璵畭瘉瘐癒睮矞砡硢硲礇礖礜祤禦禺秗稢稶穥窬窳竽篽籅籞籲緎繘罭羭聿肀腴臾舁與艅艈芌茟茰萭萮萸蒮蓣蓹蕍蕷薁蘌蘛蜟蜮蝓螸衧褕覦觎語諛諭謣譽谀谕貐踰軉輍輿轝逳遹邘鄅酑醧鈺銉鋊鋙錥鍝鐭钰閾阈雓雩霱預頨飫餘饇饫馀馭騟驈骬髃鬰鬱鬻魊魚鮽鰅鱊鳿鴥鴪鵒鷠鷸鸆鸒鹆鹬麌齬龉龥",
+	"yuan":   "傆円剈厡厵員噮囦圎園圓垸塬夗妴媛媴嫄嬽寃悁惌掾杬棩榞榬橼櫞沅淵渁渆渕湲溒灁爰猨獂瑗盶眢禐笎箢緣縁羱肙茒葾蒝蒬薗蚖蜎蜵蝝蝯螈衏裫裷褑褤謜貟贠轅逺遠邍邧鋺鎱願駌騵魭鳶鴛鵷鶢鶰鸢鹓黿鼋鼘鼝",
+	"yue":    "刖妜嬳岄嶽彟彠恱悅戉抈捳曱樾瀹爚玥矱礿禴箹篗籆籥籰粵約蘥蚎蚏跀躍軏鈅鉞钺閱閲鸑鸙黦龠",
+	"yun":    "傊勻喗囩夽奫妘恽惲愠愪慍抎昀暈枟榲橒殒殞氲氳沄涢溳澐煴熅熉熨狁畇眃磒秐筠筼篔紜緷緼縕縜纭缊耺腪芸荺蒀蒕蒷蕓薀藴蘊蝹褞賱贇赟運郓鄆鄖醖醞鈗鋆阭隕雲霣韗韞韫韻頵餫饂馧馻齳",
+	"za":     "偺咂喒囋囐嶻帀拶沞磼紥紮臜臢襍迊鉔雑雜雥韴魳",
+	"zai":    "侢傤儎崽扗洅渽災烖甾睵縡菑賳載酨",
+	"zan":    "儧儹匨噆寁揝撍攅攢昝暫桚沯濽灒牂瓉瓒瓚礸禶羘臧蔵襸讃讚賍賘賛贊贓贜趱趲蹔鄼酇錾鏨饡髒",
+	"zang":   "塟奘弉臓臟銺",
+	"zao":    "傮唕唣喿慥梍棗璪皁竃竈簉繰艁薻譟趮蹧醩鑿",
+	"ze":     "則唶啧嘖嫧帻幘択擇樍沢泎溭澤皟瞔矠礋笮箦簀舴荝蠌襗諎謮責賾赜迮鸅齚齰",
+	"zei":    "戝蠈賊鯽鰂鱡鲗",
+	"zeng":   "増橧熷璔甑矰磳繒缯罾譄贈鄫鋥锃",
+	"zha":    "偧劄厏吒咤哳奓宱抯拃挓揸搩搾摣柤査楂樝溠灹煠牐甴痄皶皻砟箚耫苲蚱蚻觰詐譇譗踷醡鍘閘霅鮓鮺鲊鲝齄齇",
+	"zhai":   "債捚斎榸檡瘵砦鉙齋",
+	"zhan":   "佔偡噡嫸嶃嶄嶘嶦惉戦戰搌斬旃旜栴桟棧椫榐橏氈氊琖盞綻菚薝虥虦蛅覱詀譧譫讝谵趈輚輾轏邅醆閚霑颭飐飦饘驏驙魙鱣鳣鸇鹯黵",
+	"zhang":  "仉傽墇嫜嶂帳幛張慞扙暲涱漲獐璋痮瘬瞕礃粻脹蔁蟑賬遧鄣長餦騿鱆麞",
+	"zhao":   "佋啁垗妱巶旐曌枛棹櫂炤燳狣瑵皽盄瞾窼笊羄肁肈詔诏趙釗鉊鍣钊駋鮡",
+	"zhe":    "乽厇啠啫喆嗻嚞埑嫬悊摺晢晣柘樜歽淛潪矺砓磔禇籷粍虴蜇蟄蟅袩褶襵詟謫謺讁讋谪赭輒輙轍辄這銸馲鮿鷓鹧",
+	"zhen":   "侲偵圳塦嫃寊屒帪弫抮挋揕搸敶昣朕栕栚桢桭楨榛樼殝浈潧澵獉珎瑧瑱甽畛眕眞眹碪祯禎禛稹箴籈紖紾絼縥纼缜聄胗葴蒖蓁薽袗裖診誫貞賑赈軫轃轸遉酖酙針鉁錱鍼鎭鎮陣靕駗鬒鱵鴆鸩黰",
+	"zheng":  "佂埩塣姃媜峥崝崢幀徰徴徵愸抍掙掟揁撜晸氶炡烝爭猙癥眐睜筝箏篜糽聇証諍證诤踭鄭鉦錚钲铮鬇鯖鴊",
+	"zhi":    "乿俧倁値偫傂儨凪劕劧卮厔咫嗭坁坧垁埴執墆墌夂妷姪娡嬂寘崻巵帋帙幟庢庤廌彘徏徔徝忮怾恉慹憄懥懫戠扺扻抧挃搘搱摭摯擲擳旘晊枳柣栀栉桎梔梽椥楖榰樴櫍櫛汥汦沚泜洔洷淔淽滍滯漐潌瀄熫犆狾猘瓆瓡畤疐疷疻痣砋礩祉祑祗祬禃禔秓秖秪秲秷稙稺穉筫紙紩絷綕緻縶織翐聀職胑胝膣膱臸芖芷藢蘵蛭螲蟙衹衼袟袠製褁襧覟觗觯觶訨誌謢豑豒豸貭質贄贽跖跱踬踯蹠躑躓軄軹軽輊轵轾迣郅酯釞鉄銍鋕鑕铚锧阤阯陟隻雉馶馽駤騭騺驇骘鯯鳷鴙鴲鵄鷙鸷黹鼅",
+	"zhong":  "伀偅冢刣喠堹塚塜妐妕媑尰幒彸柊歱汷泈炂煄狆瘇眾祌種穜筗籦終腫舯茽蔠蚛螤螽衆衳衶諥踵蹱鈡銿鍾鐘锺鼨",
+	"zhou":   "伷侜僽冑呪咮喌噣妯徟掫晝晭淍炿烐珘甃疛皺盩睭矪箒籀籒籕粙紂縐纣绉胄荮菷葤詋詶謅譸诪賙赒軸輈輖辀週郮酎銂霌駎駲騆驟鯞鵃鸼",
+	"zhu":    "丶伫佇侏劚劯囑坾壴孎宔嵀斸曯杼槠樦橥櫧櫫欘殶泏洙渚潴濐瀦灟炢炷煑燭爥疰瘃眝矚砫硃祩秼窋竚竺笁笜筯箸築篫紵紸絑纻罜羜翥舳苎茱茿莇蝫蠋蠩蠾袾註詝誅諸豬貯跓跦躅軴迬邾鉒銖鋳鑄铢陼霔馵駐駯鮢鯺鱁鴸麆麈鼄",
+	"zhua":   "檛簻膼髽",
+	"zhuan":  "僎叀啭囀堟塼嫥孨専專灷瑑瑼甎磗磚竱篹籑腞膞蒃蟤襈諯譔賺転轉鄟顓颛饌馔鱄",
+	"zhuang": "壯壵妝娤戇梉樁湷漴焋狀粧糚荘莊裝",
+	"zhui":   "墜娷惴桘沝甀畷硾礈笍綴縋缒膇諈譵贅轛醊錐錣鑆隹餟騅骓鵻",
+	"zhun":   "埻宒準窀綧肫衠諄迍",
+	"zhuo":   "丵倬叕啅圴妰娺彴撯擆擢斀斫斱斲斵晫梲棁棳椓槕櫡汋浞涿濁濯灂炪烵犳琸硺禚穛穱窡篧籗籱罬蠗蠿諁諑謶诼鋜鐯鐲镯鵫鷟",
+	"zi":     "乲倳剚吇呰啙嗞姉姊姕孳孶崰嵫恣杍栥梓椔榟橴湽漬澬牸玆璾眥眦矷禌秄秭秶稵笫粢紎緇缁耔胏胔胾芓茊茡茲葘蓻虸觜訾訿諮谘貲資赀趑趦輜輺辎鄑釨鈭錙鍿鎡锱镃頾頿髭鯔鰦鲻鶅鼒齍龇",
+	"zong":   "倊倧偬傯堫嵏嵕嵸惣惾愡捴揔搃摠昮朡椶熧猔猣疭瘲碂磫稯粽糉糭綜緃総緵縂縦縱總翪腙葼蓗蝬豵踨蹤錝鍐鏓鑁騌騣骔鬉鬷鯮鯼",
+	"zou":    "棷棸楱箃緅菆諏诹赱郰鄒鄹陬騶驺鯫鲰黀齱齺",
+	"zu":     "俎傶卆哫崒崪爼珇箤組葅蒩詛踤踿鎺鏃镞靻",
+	"zuan":   "籫繤纉纘缵躜鑽",
+	"zui":    "噿嶊嶵晬栬槜檇檌璻祽稡絊蕞辠酔酻鋷錊",
+	"zun":    "墫壿嶟樽繜罇鐏鱒鳟鷷",
+	"zuo":    "侳唑唨岝岞怍捽椊祚秨稓筰糳繓胙莋葃葄袏鈼阼飵",
+}
@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/text/gstr"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+	"server/internal/service/configcache"
+	"server/internal/service/ogimage"
+	"server/utility"
+)
+
+// 分享卡片对象类型
+const (
+	ShareSubjectArticle = "article"
+	ShareSubjectWeibo   = "weibo"
+)
+
+const (
+	// shareImageLayoutVersion 版式版本，修改卡片版式后递增以重新生成全部卡片
+	shareImageLayoutVersion = 1
+	// shareImageBatchSize 每轮最多生成的卡片数
+	shareImageBatchSize = 10
+	// shareImageWeiboExcerpt 微博卡片摘录的最大字数
+	shareImageWeiboExcerpt = 80
+	// shareImageRefusedTTL 因缺少字形未生成的卡片在此时间内不再重试，避免阻塞后续对象
+	shareImageRefusedTTL = time.Hour
+)
+
+var (
+	shareImageRefusedMu sync.Mutex
+	// shareImageRefused 因缺少字形未生成卡片的对象（类型:ID）到下次重试时间
+	shareImageRefused = map[string]time.Time{}
+)
+
+// refuseShareImage 记录未生成卡片的对象
+func refuseShareImage(subjectType string, subjectId int64) {
+	shareImageRefusedMu.Lock()
+	defer shareImageRefusedMu.Unlock()
+	shareImageRefused[fmt.Sprintf("%s:%d", subjectType, subjectId)] = time.Now().Add(shareImageRefusedTTL)
+}
+
+// refusedShareSubjects 指定类型中暂不重试的对象ID
+func refusedShareSubjects(subjectType string) []int64 {
+	shareImageRefusedMu.Lock()
+	defer shareImageRefusedMu.Unlock()
+	now := time.Now()
+	var ids []int64
+	for key, retryAt := range shareImageRefused {
+		if now.After(retryAt) {
+			delete(shareImageRefused, key)
+			continue
+		}
+		typ, id, _ := strings.Cut(key, ":")
+		if typ == subjectType {
+			if v, err := strconv.ParseInt(id, 10, 64); err == nil {
+				ids = append(ids, v)
+			}
+		}
+	}
+	return ids
+}
+
+// IShareImage 分享卡片服务接口
+type IShareImage interface {
+	// URL 对象的分享卡片地址（/file/download/<uuid>），没有卡片时返回空字符串
+	URL(ctx context.Context, subjectType string, subjectId int64) (string, error)
+	// Generate 生成并保存卡片；内容未变化且 force 为 false 时复用已有卡片
+	Generate(ctx context.Context, subjectType string, subjectId int64, force bool) (*entity.ShareImages, error)
+	// RefreshDue 为新发布或更新过的文章（及开启时的微博）生成卡片，返回本轮处理数量
+	RefreshDue(ctx context.Context) (int, error)
+}
+
+type sShareImage struct{}
+
+// ShareImage 分享卡片服务实例
+func ShareImage() IShareImage {
+	return &sShareImage{}
+}
+
+func shareImageEnabled(ctx context.Context) bool {
+	return configcache.GetBool(ctx, blogConfigNamespace, blogConfigEnv, "og_image_enabled", true)
+}
+
+// shareImageOptions 渲染选项，来自动态配置
+func shareImageOptions(ctx context.Context) *ogimage.Options {
+	opts := &ogimage.Options{
+		Accent: configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "og_image_accent", ""),
+	}
+	for _, p := range strings.Split(configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "og_image_font_paths", ""), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			opts.FontPaths = append(opts.FontPaths, p)
+		}
+	}
+	return opts
+}
+
+// URL 卡片地址
+func (s *sShareImage) URL(ctx context.Context, subjectType string, subjectId int64) (string, error) {
+	if !shareImageEnabled(ctx) {
+		return "", nil
+	}
+	v, err := dao.ShareImages.Ctx(ctx).
+		Where("subject_type", subjectType).
+		Where("subject_id", subjectId).
+		Value("url")
+	if err != nil {
+		return "", gerror.Wrap(err, "查询分享卡片失败")
+	}
+	return v.String(), nil
+}
+
+// shareCard 组装卡片内容
+func (s *sShareImage) shareCard(ctx context.Context, subjectType string, subjectId int64) (*ogimage.Card, error) {
+	site := LoadSiteInfo(ctx)
+	card := &ogimage.Card{SiteName: site.Title}
+	if u, err := url.Parse(site.URL); err == nil {
+		card.SiteHost = u.Host
+	}
+
+	switch subjectType {
+	case ShareSubjectArticle:
+		var article *entity.BlogArticles
+		if err := publishedArticles(ctx).Where("id", subjectId).Scan(&article); err != nil {
+			return nil, gerror.Wrap(err, "查询文章失败")
+		}
+		if article == nil {
+			return nil, gerror.NewCode(gcode.CodeNotFound, "文章不存在或未发布")
+		}
+		card.Title = article.Title
+		if article.CategoryId > 0 {
+			v, err := dao.BlogCategories.Ctx(ctx).Where("id", article.CategoryId).Value("name")
+			if err != nil {
+				return nil, gerror.Wrap(err, "查询分类失败")
+			}
+			card.Category = v.String()
+		}
+		if article.PublishAt != nil {
+			card.Date = article.PublishAt.Format("Y-m-d")
+		} else if article.CreatedAt != nil {
+			card.Date = article.CreatedAt.Format("Y-m-d")
+		}
+	case ShareSubjectWeibo:
+		var post *entity.WeiboPosts
+		err := dao.WeiboPosts.Ctx(ctx).
+			Where("id", subjectId).
+			Where("is_deleted", false).
+			Where("visibility", "public").
+			Scan(&post)
+		if err != nil {
+			return nil, gerror.Wrap(err, "查询微博失败")
+		}
+		if post == nil {
+			return nil, gerror.NewCode(gcode.CodeNotFound, "微博不存在或不公开")
+		}
+		content := strings.Join(strings.Fields(post.Content), " ")
+		if gstr.LenRune(content) > shareImageWeiboExcerpt {
+			content = gstr.SubStrRune(content, 0, shareImageWeiboExcerpt) + "…"
+		}
+		card.Title = content
+		card.Category = "微博"
+		if post.CreatedAt != nil {
+			card.Date = post.CreatedAt.Format("Y-m-d")
+		}
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "不支持的对象类型: %s", subjectType)
+	}
+	return card, nil
+}
+
+// shareFingerprint 卡片内容与渲染选项的摘要
+func shareFingerprint(card *ogimage.Card, opts *ogimage.Options) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		fmt.Sprint(shareImageLayoutVersion),
+		card.Title, card.Category, card.Date, card.SiteName, card.SiteHost,
+		opts.Accent, strings.Join(opts.FontPaths, ","),
+	}, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// Generate 生成卡片
+func (s *sShareImage) Generate(ctx context.Context, subjectType string, subjectId int64, force bool) (*entity.ShareImages, error) {
+	card, err := s.shareCard(ctx, subjectType, subjectId)
+	if err != nil {
+		return nil, err
+	}
+	opts := shareImageOptions(ctx)
+	fingerprint := shareFingerprint(card, opts)
+
+	var existing *entity.ShareImages
+	err = dao.ShareImages.Ctx(ctx).
+		Where("subject_type", subjectType).
+		Where("subject_id", subjectId).
+		Scan(&existing)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询分享卡片失败")
+	}
+	if existing != nil && existing.Fingerprint == fingerprint && !force {
+		_, err = dao.ShareImages.Ctx(ctx).
+			Where("subject_type", subjectType).
+			Where("subject_id", subjectId).
+			Data(g.Map{"checked_at": gtime.Now()}).
+			Update()
+		if err != nil {
+			return nil, gerror.Wrap(err, "更新分享卡片失败")
+		}
+		return existing, nil
+	}
+
+	// 缺少字形时渲染结果会出现方框，不生成也不保存卡片
+	missing, err := ogimage.MissingGlyphs(card.Title+card.Category+card.Date+card.SiteName+card.SiteHost, opts)
+	if err != nil {
+		return nil, gerror.Wrap(err, "加载分享卡片字体失败")
+	}
+	if len(missing) > 0 {
+		refuseShareImage(subjectType, subjectId)
+		return nil, gerror.NewCodef(gcode.CodeNotSupported,
+			"分享卡片缺少字形 %q，请在 og_image_font_paths 中配置或在 ogimage/fonts 中嵌入中日韩字体", string(missing))
+	}
+	content, err := ogimage.Render(card, opts)
+	if err != nil {
+		return nil, gerror.Wrap(err, "渲染分享卡片失败")
+	}
+	file, err := File().SaveContent(ctx, fmt.Sprintf("og-%s-%d.png", subjectType, subjectId), content, "image/png",
+		utility.DetectFileCategory("image/png", "png"), 0, "", "", "blog")
+	if err != nil {
+		return nil, gerror.Wrap(err, "保存分享卡片失败")
+	}
+
+	item := &entity.ShareImages{
+		SubjectType: subjectType,
+		SubjectId:   subjectId,
+		FileUuid:    file.FileUuid,
+		Url:         fmt.Sprintf("/file/download/%s", file.FileUuid),
+		Fingerprint: fingerprint,
+	}
+	_, err = g.DB().Ctx(ctx).Exec(ctx, `INSERT INTO share_images (subject_type, subject_id, file_uuid, url, fingerprint)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (subject_type, subject_id) DO UPDATE SET
+			file_uuid = EXCLUDED.file_uuid,
+			url = EXCLUDED.url,
+			fingerprint = EXCLUDED.fingerprint,
+			checked_at = NOW(),
+			updated_at = NOW()`,
+		item.SubjectType, item.SubjectId, item.FileUuid, item.Url, item.Fingerprint)
+	if err != nil {
+		return nil, gerror.Wrap(err, "保存分享卡片失败")
+	}
+	// 旧卡片不再被引用时交由文件服务回收
+	if existing != nil && existing.FileUuid != item.FileUuid {
+		if err := releaseUnreferencedFile(ctx, existing.FileUuid); err != nil {
+			g.Log().Warningf(ctx, "释放旧分享卡片失败: uuid=%s err=%v", existing.FileUuid, err)
+		}
+	}
+	InvalidateContentCaches()
+	return item, nil
+}
+
+// RefreshDue 生成到期的卡片
+//
+// 只处理没有设置 og_image 与特色图片的文章；文章在上次检查后有更新（如修改标题）时重新计算摘要，
+// 内容变化才重新渲染。微博卡片由 og_image_weibo_enabled 控制，已带图片的微博不生成。
+// 因缺少字形未生成的对象在 shareImageRefusedTTL 内不再重试。
+func (s *sShareImage) RefreshDue(ctx context.Context) (int, error) {
+	if !shareImageEnabled(ctx) {
+		return 0, nil
+	}
+	type subject struct {
+		Type string
+		Id   int64
+	}
+	var due []subject
+
+	var articleIds []int64
+	err := g.DB().Ctx(ctx).GetScan(ctx, &articleIds, `SELECT a.id
+		FROM blog_articles a
+		LEFT JOIN blog_seo_data d ON d.article_id = a.id
+		LEFT JOIN share_images s ON s.subject_type = ? AND s.subject_id = a.id
+		WHERE a.status = ? AND a.is_private = false AND a.deleted_at IS NULL
+		  AND COALESCE(a.featured_image, '') = '' AND COALESCE(d.og_image, '') = ''
+		  AND (s.subject_id IS NULL OR COALESCE(a.updated_at, a.created_at) > s.checked_at)`+
+		refusedClause("a.id", ShareSubjectArticle)+`
+		ORDER BY a.id
+		LIMIT ?`, ShareSubjectArticle, ArticleStatusPublished, shareImageBatchSize)
+	if err != nil {
+		return 0, gerror.Wrap(err, "查询待生成卡片的文章失败")
+	}
+	for _, id := range articleIds {
+		due = append(due, subject{ShareSubjectArticle, id})
+	}
+
+	if configcache.GetBool(ctx, blogConfigNamespace, blogConfigEnv, "og_image_weibo_enabled", false) {
+		var postIds []int64
+		err = g.DB().Ctx(ctx).GetScan(ctx, &postIds, `SELECT p.id
+			FROM weibo_posts p
+			LEFT JOIN share_images s ON s.subject_type = ? AND s.subject_id = p.id
+			WHERE p.is_deleted = false AND p.visibility = 'public'
+			  AND NOT EXISTS (SELECT 1 FROM weibo_assets w WHERE w.post_id = p.id AND w.kind = 'image')
+			  AND (s.subject_id IS NULL OR COALESCE(p.updated_at, p.created_at) > s.checked_at)`+
+			refusedClause("p.id", ShareSubjectWeibo)+`
+			ORDER BY p.id
+			LIMIT ?`, ShareSubjectWeibo, shareImageBatchSize)
+		if err != nil {
+			return 0, gerror.Wrap(err, "查询待生成卡片的微博失败")
+		}
+		for _, id := range postIds {
+			due = append(due, subject{ShareSubjectWeibo, id})
+		}
+	}
+
+	for _, sub := range due {
+		if _, err := s.Generate(ctx, sub.Type, sub.Id, false); err != nil {
+			if gerror.Code(err) == gcode.CodeNotSupported {
+				g.Log().Warningf(ctx, "跳过分享卡片: %s#%d %v", sub.Type, sub.Id, err)
+				continue
+			}
+			g.Log().Errorf(ctx, "生成分享卡片失败: %s#%d err=%v", sub.Type, sub.Id, err)
+		}
+	}
+	return len(due), nil
+}
+
+// refusedClause 排除暂不重试的对象；ID 为整数，直接拼入语句
+func refusedClause(column, subjectType string) string {
+	ids := refusedShareSubjects(subjectType)
+	if len(ids) == 0 {
+		return ""
+	}
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+	return "\n\t\t  AND " + column + " NOT IN (" + strings.Join(parts, ",") + ")"
+}
+
+// StartShareImageWorker 启动分享卡片生成任务，间隔由 blog/default og_image_worker_interval_seconds 配置
+func StartShareImageWorker(ctx context.Context) {
+	go func() {
+		for {
+			interval := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "og_image_worker_interval_seconds", 60)
+			if interval < 10 {
+				interval = 10
+			}
+			select {
+			case <-ctx.Done():
+				g.Log().Info(ctx, "分享卡片任务已停止")
+				return
+			case <-time.After(time.Duration(interval) * time.Second):
+				if _, err := ShareImage().RefreshDue(ctx); err != nil {
+					g.Log().Errorf(ctx, "生成分享卡片失败: %v", err)
+				}
+			}
+		}
+	}()
+}
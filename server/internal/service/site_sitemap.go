@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+
+	"server/internal/dao"
+	"server/internal/service/configcache"
+	"server/internal/service/sitemap"
+)
+
+// 站点地图分区
+const (
+	SitemapSectionPages        = "pages"
+	SitemapSectionArticles     = "articles"
+	SitemapSectionTranslations = "translations"
+	SitemapSectionCategories   = "categories"
+	SitemapSectionTags         = "tags"
+	SitemapSectionWeibo        = "weibo"
+)
+
+var sitemapSections = []string{
+	SitemapSectionPages,
+	SitemapSectionArticles,
+	SitemapSectionTranslations,
+	SitemapSectionCategories,
+	SitemapSectionTags,
+	SitemapSectionWeibo,
+}
+
+const (
+	sitemapContentType = "application/xml; charset=utf-8"
+	robotsContentType  = "text/plain; charset=utf-8"
+)
+
+// ISitemap 站点地图与 robots.txt 服务接口
+type ISitemap interface {
+	// Root 根站点地图：URL 总数不超过单文件上限时直接输出 urlset，否则输出 sitemapindex
+	Root(ctx context.Context) (*RawDocument, error)
+	// Part 分片站点地图，name 形如 articles-1
+	Part(ctx context.Context, name string) (*RawDocument, error)
+	// Robots robots.txt 内容
+	Robots(ctx context.Context) (*RawDocument, error)
+}
+
+type sSitemap struct{}
+
+// Sitemap 站点地图服务实例
+func Sitemap() ISitemap {
+	return &sSitemap{}
+}
+
+// docCache 已生成文档的进程内缓存，内容变更时整体失效
+type docCache struct {
+	mu      sync.RWMutex
+	entries map[string]*docCacheEntry
+}
+
+type docCacheEntry struct {
+	doc       *RawDocument
+	expiresAt time.Time
+}
+
+var sitemapCache = &docCache{entries: make(map[string]*docCacheEntry)}
+
+// InvalidateSitemap 使站点地图缓存失效，内容变更时经 InvalidateContentCaches 调用
+func InvalidateSitemap() {
+	sitemapCache.mu.Lock()
+	sitemapCache.entries = make(map[string]*docCacheEntry)
+	sitemapCache.mu.Unlock()
+}
+
+func (c *docCache) get(key string) *RawDocument {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if e, ok := c.entries[key]; ok && time.Now().Before(e.expiresAt) {
+		return e.doc
+	}
+	return nil
+}
+
+func (c *docCache) set(key string, doc *RawDocument, ttl time.Duration) {
+	c.mu.Lock()
+	c.entries[key] = &docCacheEntry{doc: doc, expiresAt: time.Now().Add(ttl)}
+	c.mu.Unlock()
+}
+
+// cached 读取缓存，未命中时调用 build 生成并写入
+func (s *sSitemap) cached(ctx context.Context, key string, build func() (*RawDocument, error)) (*RawDocument, error) {
+	if doc := sitemapCache.get(key); doc != nil {
+		return doc, nil
+	}
+	doc, err := build()
+	if err != nil {
+		return nil, err
+	}
+	ttl := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "sitemap_cache_seconds", 3600)
+	if ttl > 0 {
+		sitemapCache.set(key, doc, time.Duration(ttl)*time.Second)
+	}
+	return doc, nil
+}
+
+// maxURLs 单个站点地图文件的 URL 上限
+func (s *sSitemap) maxURLs(ctx context.Context) int {
+	n := configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "sitemap_max_urls", 5000)
+	if n <= 0 || n > sitemap.MaxURLs {
+		n = sitemap.MaxURLs
+	}
+	return n
+}
+
+// Root 根站点地图
+func (s *sSitemap) Root(ctx context.Context) (*RawDocument, error) {
+	return s.cached(ctx, "root", func() (*RawDocument, error) {
+		site := LoadSiteInfo(ctx)
+		limit := s.maxURLs(ctx)
+
+		total := 0
+		stats := make(map[string]sectionStat, len(sitemapSections))
+		for _, section := range sitemapSections {
+			st, err := s.sectionStat(ctx, section)
+			if err != nil {
+				return nil, err
+			}
+			stats[section] = st
+			total += st.count
+		}
+
+		// 数量较少时直接输出全部 URL
+		if total <= limit {
+			var urls []sitemap.URL
+			for _, section := range sitemapSections {
+				part, err := s.sectionURLs(ctx, site, section, 0, stats[section].count)
+				if err != nil {
+					return nil, err
+				}
+				urls = append(urls, part...)
+			}
+			return s.urlSetDocument(urls)
+		}
+
+		var (
+			refs    []sitemap.Ref
+			lastMod time.Time
+		)
+		for _, section := range sitemapSections {
+			st := stats[section]
+			pages := (st.count + limit - 1) / limit
+			for p := 1; p <= pages; p++ {
+				refs = append(refs, sitemap.Ref{
+					Loc:     fmt.Sprintf("%s/sitemaps/%s-%d.xml", site.APIURL, section, p),
+					LastMod: st.lastMod,
+				})
+			}
+			if st.lastMod.After(lastMod) {
+				lastMod = st.lastMod
+			}
+		}
+		body, err := sitemap.EncodeIndex(refs)
+		if err != nil {
+			return nil, gerror.Wrap(err, "生成站点地图索引失败")
+		}
+		return &RawDocument{Body: body, ContentType: sitemapContentType, ETag: BodyETag(body), LastModified: lastMod}, nil
+	})
+}
+
+// Part 分片站点地图
+func (s *sSitemap) Part(ctx context.Context, name string) (*RawDocument, error) {
+	i := strings.LastIndex(name, "-")
+	if i <= 0 {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "站点地图不存在")
+	}
+	section := name[:i]
+	page, err := strconv.Atoi(name[i+1:])
+	if err != nil || page < 1 || !isSitemapSection(section) {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "站点地图不存在")
+	}
+	return s.cached(ctx, "part:"+section+":"+strconv.Itoa(page), func() (*RawDocument, error) {
+		limit := s.maxURLs(ctx)
+		urls, err := s.sectionURLs(ctx, LoadSiteInfo(ctx), section, (page-1)*limit, limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(urls) == 0 {
+			return nil, gerror.NewCode(gcode.CodeNotFound, "站点地图不存在")
+		}
+		return s.urlSetDocument(urls)
+	})
+}
+
+// Robots 生成 robots.txt：优先使用配置 robots_txt，未配置时使用默认规则；始终附带站点地图地址
+func (s *sSitemap) Robots(ctx context.Context) (*RawDocument, error) {
+	return s.cached(ctx, "robots", func() (*RawDocument, error) {
+		site := LoadSiteInfo(ctx)
+		content := strings.ReplaceAll(configcache.GetString(ctx, blogConfigNamespace, blogConfigEnv, "robots_txt", ""), `\n`, "\n")
+		if strings.TrimSpace(content) == "" {
+			var b strings.Builder
+			b.WriteString("User-agent: *\n")
+			b.WriteString("Allow: /\n")
+			for _, path := range configcache.GetStrings(ctx, blogConfigNamespace, blogConfigEnv, "robots_disallow") {
+				b.WriteString("Disallow: " + path + "\n")
+			}
+			content = b.String()
+		}
+		content = strings.TrimRight(content, "\n") + "\n"
+		if !strings.Contains(strings.ToLower(content), "sitemap:") {
+			content += "\nSitemap: " + site.APIURL + "/sitemap.xml\n"
+		}
+		body := []byte(content)
+		return &RawDocument{Body: body, ContentType: robotsContentType, ETag: BodyETag(body)}, nil
+	})
+}
+
+func (s *sSitemap) urlSetDocument(urls []sitemap.URL) (*RawDocument, error) {
+	var lastMod time.Time
+	for _, u := range urls {
+		if u.LastMod.After(lastMod) {
+			lastMod = u.LastMod
+		}
+	}
+	body, err := sitemap.EncodeURLSet(urls)
+	if err != nil {
+		return nil, gerror.Wrap(err, "生成站点地图失败")
+	}
+	return &RawDocument{Body: body, ContentType: sitemapContentType, ETag: BodyETag(body), LastModified: lastMod}, nil
+}
+
+func isSitemapSection(section string) bool {
+	for _, s := range sitemapSections {
+		if s == section {
+			return true
+		}
+	}
+	return false
+}
+
+// sectionStat 分区的 URL 数量与最近更新时间
+type sectionStat struct {
+	count   int
+	lastMod time.Time
+}
+
+// sectionModel 各分区对应的查询（pages 分区为静态页面，返回 nil）
+func (s *sSitemap) sectionModel(ctx context.Context, section string) *gdb.Model {
+	switch section {
+	case SitemapSectionArticles:
+		// 受密码保护的文章对抓取方只有摘要，不列入站点地图
+		return publishedArticles(ctx).Where("password_hash", "")
+	case SitemapSectionTranslations:
+		// 已发布翻译，所属文章同样需要已发布且未设置访问密码
+		return dao.BlogArticleTranslations.Ctx(ctx).
+			Where("status", TranslationStatusPublished).
+			Where("article_id IN (?)", publishedArticles(ctx).Where("password_hash", "").Fields("id"))
+	case SitemapSectionCategories:
+		return dao.BlogCategories.Ctx(ctx).Where("is_active", true)
+	case SitemapSectionTags:
+		return dao.BlogTags.Ctx(ctx).Where("is_active", true)
+	case SitemapSectionWeibo:
+		return dao.WeiboPosts.Ctx(ctx).Where("visibility", "public").Where("is_deleted", false)
+	}
+	return nil
+}
+
+// staticPages 固定页面路径
+var staticPages = []string{"/", "/blog", "/weibo"}
+
+func (s *sSitemap) sectionStat(ctx context.Context, section string) (sectionStat, error) {
+	m := s.sectionModel(ctx, section)
+	if m == nil {
+		return sectionStat{count: len(staticPages)}, nil
+	}
+	one, err := m.Fields("COUNT(1) AS cnt, MAX(updated_at) AS last_mod").One()
+	if err != nil {
+		return sectionStat{}, gerror.Wrapf(err, "统计站点地图分区失败: %s", section)
+	}
+	st := sectionStat{count: one["cnt"].Int()}
+	if t := one["last_mod"].GTime(); t != nil {
+		st.lastMod = t.Time
+	}
+	return st, nil
+}
+
+// sectionURLs 分页读取分区 URL
+func (s *sSitemap) sectionURLs(ctx context.Context, site *SiteInfo, section string, offset, limit int) ([]sitemap.URL, error) {
+	m := s.sectionModel(ctx, section)
+	if m == nil {
+		var urls []sitemap.URL
+		for i, path := range staticPages {
+			if i < offset || i >= offset+limit {
+				continue
+			}
+			urls = append(urls, sitemap.URL{Loc: site.URL + path, ChangeFreq: "daily", Priority: 1})
+		}
+		return urls, nil
+	}
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	fields := "id, slug, updated_at"
+	switch section {
+	case SitemapSectionTranslations:
+		fields = "id, article_id, slug, updated_at"
+	case SitemapSectionWeibo:
+		fields = "id, updated_at"
+	}
+	rows, err := m.Fields(fields).Order("id ASC").Limit(offset, limit).All()
+	if err != nil {
+		return nil, gerror.Wrapf(err, "查询站点地图分区失败: %s", section)
+	}
+
+	// 文章与翻译附带全部语言版本的 hreflang 链接
+	var alternates map[int64][]*ArticleAlternate
+	if section == SitemapSectionArticles || section == SitemapSectionTranslations {
+		ids := make([]int64, 0, len(rows))
+		for _, r := range rows {
+			if section == SitemapSectionArticles {
+				ids = append(ids, r["id"].Int64())
+			} else {
+				ids = append(ids, r["article_id"].Int64())
+			}
+		}
+		if alternates, err = BlogTranslation().Alternates(ctx, ids, false); err != nil {
+			return nil, err
+		}
+	}
+
+	urls := make([]sitemap.URL, 0, len(rows))
+	for _, r := range rows {
+		u := sitemap.URL{}
+		switch section {
+		case SitemapSectionArticles:
+			u.Loc, u.ChangeFreq, u.Priority = site.ArticleURL(r["slug"].String()), "weekly", 0.8
+			u.Alternates = sitemapAlternates(site, alternates[r["id"].Int64()])
+		case SitemapSectionTranslations:
+			u.Loc, u.ChangeFreq, u.Priority = site.ArticleURL(r["slug"].String()), "weekly", 0.8
+			u.Alternates = sitemapAlternates(site, alternates[r["article_id"].Int64()])
+		case SitemapSectionCategories:
+			u.Loc, u.ChangeFreq, u.Priority = site.CategoryURL(r["slug"].String()), "weekly", 0.6
+		case SitemapSectionTags:
+			u.Loc, u.ChangeFreq, u.Priority = site.TagURL(r["slug"].String()), "weekly", 0.4
+		case SitemapSectionWeibo:
+			u.Loc, u.ChangeFreq, u.Priority = site.URL+"/weibo/"+r["id"].String(), "monthly", 0.5
+		}
+		if t := r["updated_at"].GTime(); t != nil {
+			u.LastMod = t.Time
+		}
+		urls = append(urls, u)
+	}
+	return urls, nil
+}
+
+// sitemapAlternates 转换文章的语言版本，x-default 指向原文
+func sitemapAlternates(site *SiteInfo, versions []*ArticleAlternate) []sitemap.Alternate {
+	if len(versions) == 0 {
+		return nil
+	}
+	out := make([]sitemap.Alternate, 0, len(versions)+1)
+	for _, v := range versions {
+		out = append(out, sitemap.Alternate{Hreflang: v.Language, Href: site.ArticleURL(v.Slug)})
+	}
+	return append(out, sitemap.Alternate{Hreflang: "x-default", Href: site.ArticleURL(versions[0].Slug)})
+}
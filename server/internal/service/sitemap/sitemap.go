@@ -0,0 +1,128 @@
+// Package sitemap 按 sitemaps.org 协议编码站点地图与站点地图索引
+package sitemap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+)
+
+// MaxURLs 协议规定单个站点地图最多包含的 URL 数
+const MaxURLs = 50000
+
+const xmlns = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// xmlnsXhtml 备用语言链接 <xhtml:link> 的命名空间
+const xmlnsXhtml = "http://www.w3.org/1999/xhtml"
+
+// URL 站点地图条目
+type URL struct {
+	Loc        string
+	LastMod    time.Time
+	ChangeFreq string  // always/hourly/daily/weekly/monthly/yearly/never，可选
+	Priority   float64 // 0-1，为0时不输出
+	Alternates []Alternate
+}
+
+// Alternate 页面的其他语言版本，输出为 <xhtml:link rel="alternate" hreflang="…">
+type Alternate struct {
+	Hreflang string // 语言标签，x-default 表示默认版本
+	Href     string
+}
+
+// Ref 站点地图索引中的子站点地图
+type Ref struct {
+	Loc     string
+	LastMod time.Time
+}
+
+type urlSet struct {
+	XMLName    xml.Name `xml:"urlset"`
+	Xmlns      string   `xml:"xmlns,attr"`
+	XmlnsXhtml string   `xml:"xmlns:xhtml,attr,omitempty"`
+	URLs       []xmlURL `xml:"url"`
+}
+
+type xmlURL struct {
+	Loc        string    `xml:"loc"`
+	LastMod    string    `xml:"lastmod,omitempty"`
+	ChangeFreq string    `xml:"changefreq,omitempty"`
+	Priority   string    `xml:"priority,omitempty"`
+	Links      []xmlLink `xml:"xhtml:link"`
+}
+
+type xmlLink struct {
+	Rel      string `xml:"rel,attr"`
+	Hreflang string `xml:"hreflang,attr"`
+	Href     string `xml:"href,attr"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name `xml:"sitemapindex"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Sitemaps []xmlRef `xml:"sitemap"`
+}
+
+type xmlRef struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// EncodeURLSet 编码 urlset 文档
+func EncodeURLSet(urls []URL) ([]byte, error) {
+	set := urlSet{Xmlns: xmlns, URLs: make([]xmlURL, 0, len(urls))}
+	for _, u := range urls {
+		xu := xmlURL{Loc: u.Loc, LastMod: formatTime(u.LastMod), ChangeFreq: u.ChangeFreq}
+		if u.Priority > 0 {
+			xu.Priority = formatPriority(u.Priority)
+		}
+		for _, a := range u.Alternates {
+			xu.Links = append(xu.Links, xmlLink{Rel: "alternate", Hreflang: a.Hreflang, Href: a.Href})
+		}
+		if len(xu.Links) > 0 {
+			set.XmlnsXhtml = xmlnsXhtml
+		}
+		set.URLs = append(set.URLs, xu)
+	}
+	return marshal(set)
+}
+
+// EncodeIndex 编码 sitemapindex 文档
+func EncodeIndex(refs []Ref) ([]byte, error) {
+	idx := sitemapIndex{Xmlns: xmlns, Sitemaps: make([]xmlRef, 0, len(refs))}
+	for _, r := range refs {
+		idx.Sitemaps = append(idx.Sitemaps, xmlRef{Loc: r.Loc, LastMod: formatTime(r.LastMod)})
+	}
+	return marshal(idx)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatPriority(p float64) string {
+	if p > 1 {
+		p = 1
+	}
+	b := []byte{'0', '.', '0'}
+	n := int(p*10 + 0.5)
+	if n >= 10 {
+		return "1.0"
+	}
+	b[2] = byte('0' + n)
+	return string(b)
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,245 @@
+// Package textdiff 提供基于 Myers 算法的行级文本差异比较
+package textdiff
+
+import (
+	"strings"
+)
+
+// 差异操作类型
+const (
+	OpEqual  = "equal"
+	OpInsert = "insert"
+	OpDelete = "delete"
+)
+
+// Line 差异结果中的一行
+type Line struct {
+	Op    string `json:"op"`
+	Text  string `json:"text"`
+	OldNo int    `json:"oldNo,omitempty"` // 旧文本中的行号（从1开始），新增行为0
+	NewNo int    `json:"newNo,omitempty"` // 新文本中的行号（从1开始），删除行为0
+}
+
+// Stats 差异统计
+type Stats struct {
+	Added   int `json:"added"`
+	Removed int `json:"removed"`
+}
+
+// SplitLines 按换行切分文本，统一处理 \r\n
+func SplitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// Diff 计算两段文本的行级差异
+func Diff(oldText, newText string) []Line {
+	return DiffLines(SplitLines(oldText), SplitLines(newText))
+}
+
+// Count 仅统计新增与删除行数
+func Count(oldText, newText string) Stats {
+	return Summarize(Diff(oldText, newText))
+}
+
+// Summarize 统计差异结果中的新增与删除行数
+func Summarize(lines []Line) Stats {
+	var st Stats
+	for _, l := range lines {
+		switch l.Op {
+		case OpInsert:
+			st.Added++
+		case OpDelete:
+			st.Removed++
+		}
+	}
+	return st
+}
+
+// DiffLines 计算两组行的差异
+//
+// 使用线性空间的 Myers 算法（middle snake 分治），内存占用与行数成正比，
+// 时间复杂度为 O((N+M)·D)，D 为编辑距离；单次搜索超过 maxSnakeSteps 步时
+// 改在正向搜索最远处分割，结果仍是有效的差异但不保证最短。
+func DiffLines(a, b []string) []Line {
+	// 行内容映射为整数，比较时避免重复的字符串比较
+	ids := make(map[string]int, len(a)+len(b))
+	intern := func(lines []string) []int {
+		out := make([]int, len(lines))
+		for i, l := range lines {
+			id, ok := ids[l]
+			if !ok {
+				id = len(ids)
+				ids[l] = id
+			}
+			out[i] = id
+		}
+		return out
+	}
+	d := &differ{
+		a:        intern(a),
+		b:        intern(b),
+		deleted:  make([]bool, len(a)),
+		inserted: make([]bool, len(b)),
+	}
+	d.compare(0, len(a), 0, len(b))
+
+	// 按标记合并输出：同一处改动先输出删除行，再输出新增行
+	out := make([]Line, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && d.deleted[i]:
+			out = append(out, Line{Op: OpDelete, Text: a[i], OldNo: i + 1})
+			i++
+		case j < len(b) && d.inserted[j]:
+			out = append(out, Line{Op: OpInsert, Text: b[j], NewNo: j + 1})
+			j++
+		default:
+			out = append(out, Line{Op: OpEqual, Text: a[i], OldNo: i + 1, NewNo: j + 1})
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+// maxSnakeSteps 单次 middle snake 搜索的最大步数，限制大段改写时的计算量
+const maxSnakeSteps = 1024
+
+// differ 记录比较过程中被删除与新增的行
+type differ struct {
+	a, b     []int
+	deleted  []bool
+	inserted []bool
+}
+
+// compare 比较 a[aLo:aHi] 与 b[bLo:bHi]，在 middle snake 处分割后递归处理两侧
+func (d *differ) compare(aLo, aHi, bLo, bHi int) {
+	// 去掉公共前缀与后缀，缩小搜索范围
+	for aLo < aHi && bLo < bHi && d.a[aLo] == d.b[bLo] {
+		aLo++
+		bLo++
+	}
+	for aLo < aHi && bLo < bHi && d.a[aHi-1] == d.b[bHi-1] {
+		aHi--
+		bHi--
+	}
+	if aLo == aHi || bLo == bHi {
+		d.markAll(aLo, aHi, bLo, bHi)
+		return
+	}
+	x, y, ok := d.middleSnake(aLo, aHi, bLo, bHi)
+	if !ok || (x == aLo && y == bLo) || (x == aHi && y == bHi) {
+		d.markAll(aLo, aHi, bLo, bHi)
+		return
+	}
+	d.compare(aLo, x, bLo, y)
+	d.compare(x, aHi, y, bHi)
+}
+
+// markAll 将区间内的旧行全部标记为删除、新行全部标记为新增
+func (d *differ) markAll(aLo, aHi, bLo, bHi int) {
+	for i := aLo; i < aHi; i++ {
+		d.deleted[i] = true
+	}
+	for j := bLo; j < bHi; j++ {
+		d.inserted[j] = true
+	}
+}
+
+// middleSnake 从两端同时搜索最短编辑路径，返回两条路径相遇处的分割点；
+// 超过步数上限时返回正向搜索到达的最远点，没有可用分割点时 ok 为 false
+func (d *differ) middleSnake(aLo, aHi, bLo, bHi int) (x, y int, ok bool) {
+	n, m := aHi-aLo, bHi-bLo
+	maxD := (n + m + 1) / 2
+	steps := maxD
+	if steps > maxSnakeSteps {
+		steps = maxSnakeSteps
+	}
+	offset := maxD
+	size := 2*maxD + 2
+	// vf[k] 正向搜索在对角线 k 上到达的最远 x；vb[k] 反向搜索从末尾回退的最远距离
+	vf := make([]int, size)
+	vb := make([]int, size)
+	for i := range vf {
+		vf[i] = -1
+		vb[i] = -1
+	}
+	vf[offset+1] = 0
+	vb[offset+1] = 0
+
+	delta := n - m
+	// delta 为奇数时在正向搜索中检测重叠，否则在反向搜索中检测
+	front := delta%2 != 0
+	var fStart, fEnd, bStart, bEnd int
+	for step := 0; step < steps; step++ {
+		for k := -step + fStart; k <= step-fEnd; k += 2 {
+			ki := offset + k
+			var x1 int
+			if k == -step || (k != step && vf[ki-1] < vf[ki+1]) {
+				x1 = vf[ki+1]
+			} else {
+				x1 = vf[ki-1] + 1
+			}
+			y1 := x1 - k
+			for x1 < n && y1 < m && d.a[aLo+x1] == d.b[bLo+y1] {
+				x1++
+				y1++
+			}
+			vf[ki] = x1
+			switch {
+			case x1 > n:
+				fEnd += 2
+			case y1 > m:
+				fStart += 2
+			case front:
+				if bi := offset + delta - k; bi >= 0 && bi < size && vb[bi] != -1 && x1 >= n-vb[bi] {
+					return aLo + x1, bLo + y1, true
+				}
+			}
+		}
+		for k := -step + bStart; k <= step-bEnd; k += 2 {
+			ki := offset + k
+			var x2 int
+			if k == -step || (k != step && vb[ki-1] < vb[ki+1]) {
+				x2 = vb[ki+1]
+			} else {
+				x2 = vb[ki-1] + 1
+			}
+			y2 := x2 - k
+			for x2 < n && y2 < m && d.a[aHi-1-x2] == d.b[bHi-1-y2] {
+				x2++
+				y2++
+			}
+			vb[ki] = x2
+			switch {
+			case x2 > n:
+				bEnd += 2
+			case y2 > m:
+				bStart += 2
+			case !front:
+				if fi := offset + delta - k; fi >= 0 && fi < size && vf[fi] != -1 {
+					x1 := vf[fi]
+					y1 := x1 - (delta - k)
+					if x1 >= n-x2 {
+						return aLo + x1, bLo + y1, true
+					}
+				}
+			}
+		}
+	}
+
+	// 未在步数上限内相遇：选择正向搜索中 x+y 最大的点
+	best := -1
+	for k := -steps; k <= steps; k++ {
+		x1 := vf[offset+k]
+		if y1 := x1 - k; x1 >= 0 && x1 <= n && y1 >= 0 && y1 <= m && x1+y1 > best {
+			best, x, y = x1+y1, aLo+x1, bLo+y1
+		}
+	}
+	return x, y, best > 0
+}
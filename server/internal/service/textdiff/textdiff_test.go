@@ -0,0 +1,164 @@
+package textdiff
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	cases := []struct {
+		name     string
+		old, new string
+		want     string // 每行一个操作：" " 相同，"-" 删除，"+" 新增
+	}{
+		{"empty", "", "", ""},
+		{"new text", "", "a\nb\n", "+a +b"},
+		{"cleared", "a\nb", "", "-a -b"},
+		{"equal", "a\r\nb\r\n", "a\nb", " a  b"},
+		{"insert middle", "a\nc", "a\nb\nc", " a +b  c"},
+		{"delete middle", "a\nb\nc", "a\nc", " a -b  c"},
+		{"replace", "a\nb\nc", "a\nx\nc", " a -b +x  c"},
+		{"move", "a\nb\nc\nd", "b\nc\nd\na", "-a  b  c  d +a"},
+	}
+	for _, tc := range cases {
+		var parts []string
+		for _, l := range Diff(tc.old, tc.new) {
+			switch l.Op {
+			case OpEqual:
+				parts = append(parts, " "+l.Text)
+			case OpDelete:
+				parts = append(parts, "-"+l.Text)
+			case OpInsert:
+				parts = append(parts, "+"+l.Text)
+			}
+		}
+		if got := strings.Join(parts, " "); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestDiffLineNumbers(t *testing.T) {
+	lines := Diff("a\nb\nc", "x\na\nc\ny")
+	want := []Line{
+		{Op: OpInsert, Text: "x", NewNo: 1},
+		{Op: OpEqual, Text: "a", OldNo: 1, NewNo: 2},
+		{Op: OpDelete, Text: "b", OldNo: 2},
+		{Op: OpEqual, Text: "c", OldNo: 3, NewNo: 3},
+		{Op: OpInsert, Text: "y", NewNo: 4},
+	}
+	if fmt.Sprint(lines) != fmt.Sprint(want) {
+		t.Fatalf("got %v, want %v", lines, want)
+	}
+	if st := Summarize(lines); st != (Stats{Added: 2, Removed: 1}) {
+		t.Fatalf("stats: got %+v", st)
+	}
+}
+
+// lcsLen 动态规划计算最长公共子序列长度，用于验证差异结果是最短编辑
+func lcsLen(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			switch {
+			case a[i-1] == b[j-1]:
+				cur[j] = prev[j-1] + 1
+			case prev[j] >= cur[j-1]:
+				cur[j] = prev[j]
+			default:
+				cur[j] = cur[j-1]
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// replay 校验差异结果能还原新旧文本且行号连续，返回相同行数
+func replay(t *testing.T, a, b []string, lines []Line) int {
+	t.Helper()
+	var oldSide, newSide []string
+	equal := 0
+	for _, l := range lines {
+		if l.Op != OpInsert {
+			if l.OldNo != len(oldSide)+1 {
+				t.Fatalf("bad old line number in %+v", l)
+			}
+			oldSide = append(oldSide, l.Text)
+		}
+		if l.Op != OpDelete {
+			if l.NewNo != len(newSide)+1 {
+				t.Fatalf("bad new line number in %+v", l)
+			}
+			newSide = append(newSide, l.Text)
+		}
+		if l.Op == OpEqual {
+			equal++
+		}
+	}
+	if strings.Join(oldSide, "\n") != strings.Join(a, "\n") || strings.Join(newSide, "\n") != strings.Join(b, "\n") {
+		t.Fatalf("%v -> %v: diff does not reproduce inputs", a, b)
+	}
+	return equal
+}
+
+func randomLines(r *rand.Rand, n, alphabet int) []string {
+	lines := make([]string, r.Intn(n))
+	for i := range lines {
+		lines[i] = string(rune('a' + r.Intn(alphabet)))
+	}
+	return lines
+}
+
+func TestDiffMinimal(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 2000; iter++ {
+		a, b := randomLines(r, 30, 4), randomLines(r, 30, 4)
+		if equal, want := replay(t, a, b, DiffLines(a, b)), lcsLen(a, b); equal != want {
+			t.Fatalf("%v -> %v: %d equal lines, want %d", a, b, equal, want)
+		}
+	}
+}
+
+func TestDiffStepLimit(t *testing.T) {
+	// 编辑距离超过步数上限时结果不保证最短，但仍需能还原新旧文本
+	r := rand.New(rand.NewSource(2))
+	for iter := 0; iter < 5; iter++ {
+		a, b := randomLines(r, 8000, 26), randomLines(r, 8000, 26)
+		replay(t, a, b, DiffLines(a, b))
+	}
+}
+
+func TestCountLargeInputMemory(t *testing.T) {
+	article := func(n int, prefix string) string {
+		var sb strings.Builder
+		for i := 0; i < n; i++ {
+			fmt.Fprintf(&sb, "%s line %d\n", prefix, i)
+		}
+		return sb.String()
+	}
+	cases := []struct {
+		name     string
+		old, new string
+		want     Stats
+	}{
+		{"new 5000 lines", "", article(5000, "new"), Stats{Added: 5000}},
+		{"rewrite 3000 lines", article(3000, "old"), article(3000, "new"), Stats{Added: 3000, Removed: 3000}},
+	}
+	for _, tc := range cases {
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		st := Count(tc.old, tc.new)
+		runtime.ReadMemStats(&after)
+		if st != tc.want {
+			t.Errorf("%s: got %+v, want %+v", tc.name, st, tc.want)
+		}
+		if alloc := after.TotalAlloc - before.TotalAlloc; alloc > 16<<20 {
+			t.Errorf("%s: allocated %d bytes", tc.name, alloc)
+		}
+	}
+}
@@ -0,0 +1,488 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"server/internal/dao"
+	"server/internal/model/entity"
+	"server/internal/service/auth"
+)
+
+// usernamePattern 用户名规则，与 users 表的 CHECK 约束一致
+var usernamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,49}$`)
+
+// 作者已公开内容的统计条件：已发布且公开的文章、公开的微博
+const (
+	authorArticleCountSQL = "(SELECT COUNT(*) FROM blog_articles a WHERE a.author_id = users.id AND a.status = 'published' AND a.is_private = false AND a.deleted_at IS NULL)"
+	authorWeiboCountSQL   = "(SELECT COUNT(*) FROM weibo_posts w WHERE w.author_id = users.id AND w.visibility = 'public' AND w.is_deleted = false)"
+)
+
+// AuthorBrief 作者简要信息，随文章、微博一起返回
+type AuthorBrief struct {
+	Id          int64
+	Username    string
+	DisplayName string
+	AvatarUrl   string
+}
+
+// AuthorStat 作者资料及其公开内容数量
+type AuthorStat struct {
+	entity.Users
+	ArticleCount int `orm:"article_count"`
+	WeiboCount   int `orm:"weibo_count"`
+}
+
+// UserInput 创建或修改用户的参数，指针字段为 nil 时保持原值
+type UserInput struct {
+	Username       string
+	Password       *string
+	Role           *string
+	DisplayName    *string
+	AvatarFileUuid *string
+	Bio            *string
+	IsActive       *bool
+}
+
+// IUser 用户与作者服务接口
+type IUser interface {
+	// Authenticate 用户名密码登录，成功后记录登录时间
+	Authenticate(ctx context.Context, username, password string) (*entity.Users, error)
+	// Resolve 加载令牌对应的用户；用户不存在、已停用或令牌签发早于 token_valid_after 时返回 auth.ErrUnauthorized
+	Resolve(ctx context.Context, claims *auth.Claims) (*entity.Users, error)
+	// Get 按ID查询用户
+	Get(ctx context.Context, id int64) (*entity.Users, error)
+	// Current 当前登录用户
+	Current(ctx context.Context) (*entity.Users, error)
+	// TouchLogin 记录登录时间
+	TouchLogin(ctx context.Context, id int64)
+	// List 用户列表（管理员）
+	List(ctx context.Context, page, size int) (items []*entity.Users, total int, err error)
+	// Create 创建用户（管理员）
+	Create(ctx context.Context, in *UserInput) (*entity.Users, error)
+	// Update 修改用户资料、角色、状态或重置密码；非管理员只能修改自己的资料，不能修改角色与状态
+	Update(ctx context.Context, id int64, in *UserInput) error
+	// ChangePassword 修改当前用户的密码，之前签发的令牌全部失效
+	ChangePassword(ctx context.Context, oldPassword, newPassword string) error
+	// Delete 删除用户，其文章与微博转给 reassignTo
+	Delete(ctx context.Context, id, reassignTo int64) error
+	// Authors 有公开内容的作者列表
+	Authors(ctx context.Context) ([]*AuthorStat, error)
+	// Author 按用户名查询作者
+	Author(ctx context.Context, username string) (*AuthorStat, error)
+	// Brief 作者简要信息，用户不存在时返回 nil
+	Brief(ctx context.Context, id int64) *AuthorBrief
+}
+
+type sUser struct{}
+
+// User 用户服务实例
+func User() IUser {
+	return &sUser{}
+}
+
+// UserDisplayName 显示名称，未设置时使用用户名
+func UserDisplayName(u *entity.Users) string {
+	return firstNonEmpty(u.DisplayName, u.Username)
+}
+
+// UserAvatarURL 头像地址，未设置时返回空字符串
+func UserAvatarURL(u *entity.Users) string {
+	if u.AvatarFileUuid == "" {
+		return ""
+	}
+	return "/file/download/" + u.AvatarFileUuid
+}
+
+// CheckOwnership 当前用户能否修改 authorId 的内容：本人或编辑以上角色
+func CheckOwnership(ctx context.Context, authorId int64) error {
+	if auth.HasRole(ctx, auth.RoleEditor) {
+		return nil
+	}
+	if uid := auth.CurrentUserID(ctx); uid != 0 && uid == authorId {
+		return nil
+	}
+	return gerror.NewCode(gcode.CodeNotAuthorized, "无权修改其他作者的内容")
+}
+
+// checkArticleOwnership 检查当前用户能否修改文章（包括回收站中的文章），文章不存在时不报错，由调用方处理
+func checkArticleOwnership(ctx context.Context, articleId int64) error {
+	v, err := dao.BlogArticles.Ctx(ctx).Fields("author_id").Where("id", articleId).Value()
+	if err != nil {
+		return gerror.Wrap(err, "查询文章失败")
+	}
+	if v.IsNil() {
+		return nil
+	}
+	return CheckOwnership(ctx, v.Int64())
+}
+
+// Authenticate 用户名密码登录
+func (s *sUser) Authenticate(ctx context.Context, username, password string) (*entity.Users, error) {
+	var user *entity.Users
+	err := dao.Users.Ctx(ctx).Where("username", strings.ToLower(strings.TrimSpace(username))).Scan(&user)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询用户失败")
+	}
+	if user == nil || !user.IsActive || user.PasswordHash == "" || !verifyPassword(user.PasswordHash, password) {
+		return nil, gerror.New("invalid username or password")
+	}
+	s.TouchLogin(ctx, user.Id)
+	return user, nil
+}
+
+// Resolve 加载令牌对应的用户
+func (s *sUser) Resolve(ctx context.Context, claims *auth.Claims) (*entity.Users, error) {
+	id := auth.UserIDFromSubject(claims.Subject)
+	if id == 0 {
+		return nil, auth.ErrUnauthorized
+	}
+	var user *entity.Users
+	if err := dao.Users.Ctx(ctx).Where("id", id).Scan(&user); err != nil {
+		return nil, gerror.Wrap(err, "查询用户失败")
+	}
+	if user == nil || !user.IsActive {
+		return nil, auth.ErrUnauthorized
+	}
+	if user.TokenValidAfter != nil {
+		iat := int64(0)
+		if claims.IssuedAt != nil {
+			iat = claims.IssuedAt.Unix()
+		}
+		if iat < user.TokenValidAfter.Unix() {
+			return nil, auth.ErrUnauthorized
+		}
+	}
+	return user, nil
+}
+
+// Get 按ID查询用户
+func (s *sUser) Get(ctx context.Context, id int64) (*entity.Users, error) {
+	var user *entity.Users
+	if err := dao.Users.Ctx(ctx).Where("id", id).Scan(&user); err != nil {
+		return nil, gerror.Wrap(err, "查询用户失败")
+	}
+	if user == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "用户不存在")
+	}
+	return user, nil
+}
+
+// Current 当前登录用户
+func (s *sUser) Current(ctx context.Context) (*entity.Users, error) {
+	id := auth.CurrentUserID(ctx)
+	if id == 0 {
+		return nil, gerror.NewCode(gcode.CodeNotAuthorized, "未登录")
+	}
+	return s.Get(ctx, id)
+}
+
+// TouchLogin 记录登录时间，失败只记录日志
+func (s *sUser) TouchLogin(ctx context.Context, id int64) {
+	if _, err := dao.Users.Ctx(ctx).Where("id", id).Data(g.Map{"last_login_at": gtime.Now()}).Update(); err != nil {
+		g.Log().Warningf(ctx, "记录登录时间失败: %v", err)
+	}
+}
+
+// List 用户列表
+func (s *sUser) List(ctx context.Context, page, size int) (items []*entity.Users, total int, err error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+	m := dao.Users.Ctx(ctx)
+	if total, err = m.Count(); err != nil {
+		return nil, 0, gerror.Wrap(err, "查询用户总数失败")
+	}
+	if err = m.Order("id ASC").Limit((page-1)*size, size).Scan(&items); err != nil {
+		return nil, 0, gerror.Wrap(err, "查询用户列表失败")
+	}
+	return items, total, nil
+}
+
+// Create 创建用户
+func (s *sUser) Create(ctx context.Context, in *UserInput) (*entity.Users, error) {
+	username := strings.ToLower(strings.TrimSpace(in.Username))
+	if !usernamePattern.MatchString(username) {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "用户名只能包含小写字母、数字、下划线与连字符，长度2-50")
+	}
+	exists, err := dao.Users.Ctx(ctx).Where("username", username).Count()
+	if err != nil {
+		return nil, gerror.Wrap(err, "检查用户名失败")
+	}
+	if exists > 0 {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "用户名已存在")
+	}
+
+	data := g.Map{"username": username, "role": auth.RoleAuthor, "is_active": true}
+	if err = s.applyInput(ctx, data, in); err != nil {
+		return nil, err
+	}
+	id, err := dao.Users.Ctx(ctx).Data(data).InsertAndGetId()
+	if err != nil {
+		return nil, gerror.Wrap(err, "创建用户失败")
+	}
+	g.Log().Info(ctx, "User.Create", "id", id, "username", username)
+	return s.Get(ctx, id)
+}
+
+// Update 修改用户
+func (s *sUser) Update(ctx context.Context, id int64, in *UserInput) error {
+	user, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	isAdmin := auth.HasRole(ctx, auth.RoleAdmin)
+	if !isAdmin {
+		if id != auth.CurrentUserID(ctx) {
+			return gerror.NewCode(gcode.CodeNotAuthorized, "只能修改自己的资料")
+		}
+		if in.Role != nil || in.IsActive != nil || in.Password != nil {
+			return gerror.NewCode(gcode.CodeNotAuthorized, "无权修改角色、状态或密码")
+		}
+	}
+
+	// 至少保留一个启用的管理员
+	demoted := in.Role != nil && *in.Role != auth.RoleAdmin
+	disabled := in.IsActive != nil && !*in.IsActive
+	if user.Role == auth.RoleAdmin && user.IsActive && (demoted || disabled) {
+		if err = s.ensureOtherAdmin(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	data := g.Map{}
+	if err = s.applyInput(ctx, data, in); err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	data["updated_at"] = gtime.Now()
+	if _, err = dao.Users.Ctx(ctx).Where("id", id).Data(data).Update(); err != nil {
+		return gerror.Wrap(err, "更新用户失败")
+	}
+	InvalidateContentCaches()
+	g.Log().Info(ctx, "User.Update", "id", id)
+	return nil
+}
+
+// applyInput 校验输入并写入更新数据；修改密码或停用账号时使已签发的令牌失效
+func (s *sUser) applyInput(ctx context.Context, data g.Map, in *UserInput) error {
+	if in.Role != nil {
+		if !auth.ValidRole(*in.Role) {
+			return gerror.NewCode(gcode.CodeInvalidParameter, "未知角色: "+*in.Role)
+		}
+		data["role"] = *in.Role
+	}
+	if in.DisplayName != nil {
+		data["display_name"] = strings.TrimSpace(*in.DisplayName)
+	}
+	if in.Bio != nil {
+		data["bio"] = strings.TrimSpace(*in.Bio)
+	}
+	if in.AvatarFileUuid != nil {
+		uuid := strings.TrimSpace(*in.AvatarFileUuid)
+		if uuid == "" {
+			data["avatar_file_uuid"] = nil
+		} else {
+			n, err := dao.Files.Ctx(ctx).
+				Where("file_uuid", uuid).
+				WhereNot("file_status", "deleted").
+				WhereLike("mime_type", "image/%").
+				Count()
+			if err != nil {
+				return gerror.Wrap(err, "查询头像文件失败")
+			}
+			if n == 0 {
+				return gerror.NewCode(gcode.CodeInvalidParameter, "头像文件不存在或不是图片")
+			}
+			data["avatar_file_uuid"] = uuid
+		}
+	}
+	if in.Password != nil {
+		if len(*in.Password) < 8 {
+			return gerror.NewCode(gcode.CodeInvalidParameter, "密码至少8位")
+		}
+		hash, err := hashPassword(*in.Password)
+		if err != nil {
+			return gerror.Wrap(err, "生成密码摘要失败")
+		}
+		data["password_hash"] = hash
+		data["token_valid_after"] = gtime.Now()
+	}
+	if in.IsActive != nil {
+		data["is_active"] = *in.IsActive
+		if !*in.IsActive {
+			data["token_valid_after"] = gtime.Now()
+		}
+	}
+	return nil
+}
+
+// ensureOtherAdmin 除 id 外是否还有启用的管理员
+func (s *sUser) ensureOtherAdmin(ctx context.Context, id int64) error {
+	n, err := dao.Users.Ctx(ctx).
+		Where("role", auth.RoleAdmin).
+		Where("is_active", true).
+		WhereNot("id", id).
+		Count()
+	if err != nil {
+		return gerror.Wrap(err, "查询管理员失败")
+	}
+	if n == 0 {
+		return gerror.NewCode(gcode.CodeInvalidOperation, "至少需要保留一个启用的管理员")
+	}
+	return nil
+}
+
+// ChangePassword 修改当前用户的密码
+func (s *sUser) ChangePassword(ctx context.Context, oldPassword, newPassword string) error {
+	user, err := s.Current(ctx)
+	if err != nil {
+		return err
+	}
+	// 尚未设置密码的账号（沿用单密码登录的管理员）可以直接设置
+	if user.PasswordHash != "" && !verifyPassword(user.PasswordHash, oldPassword) {
+		return gerror.NewCode(gcode.CodeInvalidParameter, "原密码错误")
+	}
+	data := g.Map{}
+	if err = s.applyInput(ctx, data, &UserInput{Password: &newPassword}); err != nil {
+		return err
+	}
+	data["updated_at"] = gtime.Now()
+	if _, err = dao.Users.Ctx(ctx).Where("id", user.Id).Data(data).Update(); err != nil {
+		return gerror.Wrap(err, "修改密码失败")
+	}
+	g.Log().Info(ctx, "User.ChangePassword", "id", user.Id)
+	return nil
+}
+
+// Delete 删除用户
+func (s *sUser) Delete(ctx context.Context, id, reassignTo int64) error {
+	if id == auth.CurrentUserID(ctx) {
+		return gerror.NewCode(gcode.CodeInvalidOperation, "不能删除当前登录的用户")
+	}
+	user, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user.Role == auth.RoleAdmin && user.IsActive {
+		if err = s.ensureOtherAdmin(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	articles, err := dao.BlogArticles.Ctx(ctx).Where("author_id", id).Count()
+	if err != nil {
+		return gerror.Wrap(err, "查询用户文章失败")
+	}
+	posts, err := dao.WeiboPosts.Ctx(ctx).Where("author_id", id).Count()
+	if err != nil {
+		return gerror.Wrap(err, "查询用户微博失败")
+	}
+	if articles+posts > 0 {
+		if reassignTo == 0 || reassignTo == id {
+			return gerror.NewCode(gcode.CodeInvalidParameter,
+				fmt.Sprintf("该用户有 %d 篇文章与 %d 条微博，请指定接收内容的用户", articles, posts))
+		}
+		if _, err = s.Get(ctx, reassignTo); err != nil {
+			return err
+		}
+	}
+
+	err = dao.Users.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		if articles > 0 {
+			if _, err := dao.BlogArticles.Ctx(ctx).TX(tx).Where("author_id", id).Data(g.Map{"author_id": reassignTo}).Update(); err != nil {
+				return gerror.Wrap(err, "转移文章失败")
+			}
+		}
+		if posts > 0 {
+			if _, err := dao.WeiboPosts.Ctx(ctx).TX(tx).Where("author_id", id).Data(g.Map{"author_id": reassignTo}).Update(); err != nil {
+				return gerror.Wrap(err, "转移微博失败")
+			}
+		}
+		if _, err := dao.Users.Ctx(ctx).TX(tx).Where("id", id).Delete(); err != nil {
+			return gerror.Wrap(err, "删除用户失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	InvalidateContentCaches()
+	g.Log().Info(ctx, "User.Delete", "id", id, "reassignTo", reassignTo)
+	return nil
+}
+
+// authorStats 带公开内容统计的作者查询
+func (s *sUser) authorStats(ctx context.Context) *gdb.Model {
+	return dao.Users.Ctx(ctx).
+		Fields("users.*", authorArticleCountSQL+" AS article_count", authorWeiboCountSQL+" AS weibo_count").
+		Where("is_active", true)
+}
+
+// Authors 有公开内容的作者列表，按文章数倒序
+func (s *sUser) Authors(ctx context.Context) ([]*AuthorStat, error) {
+	var items []*AuthorStat
+	err := s.authorStats(ctx).
+		Where(authorArticleCountSQL + " + " + authorWeiboCountSQL + " > 0").
+		Order("article_count DESC, id ASC").
+		Scan(&items)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询作者列表失败")
+	}
+	return items, nil
+}
+
+// Author 按用户名查询作者
+func (s *sUser) Author(ctx context.Context, username string) (*AuthorStat, error) {
+	var item *AuthorStat
+	err := s.authorStats(ctx).Where("username", strings.ToLower(strings.TrimSpace(username))).Scan(&item)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询作者失败")
+	}
+	if item == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "作者不存在")
+	}
+	return item, nil
+}
+
+// Brief 作者简要信息，结果随内容缓存一起失效
+func (s *sUser) Brief(ctx context.Context, id int64) *AuthorBrief {
+	if id <= 0 {
+		return nil
+	}
+	key := "author:" + strconv.FormatInt(id, 10)
+	if v, ok := blogContentCache.get(key); ok {
+		return v.(*AuthorBrief)
+	}
+	var user *entity.Users
+	err := dao.Users.Ctx(ctx).Fields("id", "username", "display_name", "avatar_file_uuid").Where("id", id).Scan(&user)
+	if err != nil {
+		g.Log().Warningf(ctx, "查询作者失败: %v", err)
+		return nil
+	}
+	var brief *AuthorBrief
+	if user != nil {
+		brief = &AuthorBrief{
+			Id:          user.Id,
+			Username:    user.Username,
+			DisplayName: UserDisplayName(user),
+			AvatarUrl:   UserAvatarURL(user),
+		}
+	}
+	blogContentCache.set(ctx, key, brief)
+	return brief
+}
@@ -0,0 +1,252 @@
+package webmention
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// mf2 微格式（microformats2）的最小实现：只解析 Webmention 展示需要的属性，
+// 不处理隐式属性推断和 value-class 模式
+
+// mfItem 一个微格式对象，如 h-entry、h-card
+type mfItem struct {
+	types []string
+	props map[string][]mfValue
+	node  *html.Node
+}
+
+// mfValue 属性值；嵌套微格式时 item 不为空，text 为其 name 或 url
+type mfValue struct {
+	text string
+	item *mfItem
+}
+
+// has 对象是否为指定类型
+func (it *mfItem) has(typ string) bool {
+	for _, t := range it.types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// first 属性的第一个文本值
+func (it *mfItem) first(prop string) string {
+	for _, v := range it.props[prop] {
+		if v.text != "" {
+			return v.text
+		}
+	}
+	return ""
+}
+
+// urls 属性的全部地址值（嵌套对象取其 url）
+func (it *mfItem) urls(prop string) []string {
+	var out []string
+	for _, v := range it.props[prop] {
+		if v.item != nil {
+			if u := v.item.first("url"); u != "" {
+				out = append(out, u)
+				continue
+			}
+		}
+		if v.text != "" {
+			out = append(out, v.text)
+		}
+	}
+	return out
+}
+
+// classTokens 拆分 class 属性，返回 h-* 类型与 p-/u-/dt-/e- 属性
+func classTokens(n *html.Node) (types []string, props []string) {
+	for _, c := range strings.Fields(attrValue(n, "class")) {
+		switch {
+		case strings.HasPrefix(c, "h-") && len(c) > 2:
+			types = append(types, c)
+		case strings.HasPrefix(c, "p-"), strings.HasPrefix(c, "u-"), strings.HasPrefix(c, "e-"):
+			if len(c) > 2 {
+				props = append(props, c)
+			}
+		case strings.HasPrefix(c, "dt-") && len(c) > 3:
+			props = append(props, c)
+		}
+	}
+	return types, props
+}
+
+// parseItems 解析文档中的顶层微格式对象
+func parseItems(doc *html.Node, base *url.URL) []*mfItem {
+	var items []*mfItem
+	walk(doc, func(n *html.Node) bool {
+		if n.Type != html.ElementNode {
+			return true
+		}
+		if types, _ := classTokens(n); len(types) > 0 {
+			items = append(items, parseItem(n, types, base))
+			return false
+		}
+		return true
+	})
+	return items
+}
+
+// parseItem 解析以 n 为根的微格式对象
+func parseItem(n *html.Node, types []string, base *url.URL) *mfItem {
+	it := &mfItem{types: types, props: map[string][]mfValue{}, node: n}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		collectProps(c, it, base)
+	}
+	return it
+}
+
+// collectProps 收集属于 it 的属性；嵌套的微格式对象作为属性值（或子对象）处理，不再向下收集
+func collectProps(n *html.Node, it *mfItem, base *url.URL) {
+	if n.Type != html.ElementNode {
+		return
+	}
+	types, props := classTokens(n)
+	var nested *mfItem
+	if len(types) > 0 {
+		nested = parseItem(n, types, base)
+	}
+	for _, p := range props {
+		prefix, name, _ := strings.Cut(p, "-")
+		v := mfValue{item: nested}
+		switch {
+		case nested != nil && prefix == "u":
+			v.text = firstNonEmpty(nested.first("url"), propValue(n, prefix, base))
+		case nested != nil:
+			v.text = firstNonEmpty(nested.first("name"), propValue(n, prefix, base))
+		default:
+			v.text = propValue(n, prefix, base)
+		}
+		it.props[name] = append(it.props[name], v)
+	}
+	if nested != nil {
+		return
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		collectProps(c, it, base)
+	}
+}
+
+// propValue 按属性前缀解析元素的值
+func propValue(n *html.Node, prefix string, base *url.URL) string {
+	switch prefix {
+	case "u":
+		for _, name := range urlAttrs(n.Data) {
+			if v, ok := attr(n, name); ok {
+				return resolve(base, v)
+			}
+		}
+		if n.Data == "abbr" {
+			if v, ok := attr(n, "title"); ok {
+				return v
+			}
+		}
+		return resolve(base, textContent(n))
+	case "dt":
+		switch n.Data {
+		case "time", "ins", "del":
+			if v, ok := attr(n, "datetime"); ok {
+				return strings.TrimSpace(v)
+			}
+		case "data", "input":
+			if v, ok := attr(n, "value"); ok {
+				return strings.TrimSpace(v)
+			}
+		}
+		fallthrough
+	default:
+		if n.Data == "abbr" {
+			if v, ok := attr(n, "title"); ok {
+				return strings.TrimSpace(v)
+			}
+		}
+		if n.Data == "img" || n.Data == "area" {
+			return strings.TrimSpace(attrValue(n, "alt"))
+		}
+		return textContent(n)
+	}
+}
+
+func urlAttrs(tag string) []string {
+	switch tag {
+	case "a", "area", "link":
+		return []string{"href"}
+	case "img", "audio", "video", "source", "iframe":
+		return []string{"src"}
+	case "object":
+		return []string{"data"}
+	}
+	return nil
+}
+
+// resolve 将相对地址解析为绝对地址
+func resolve(base *url.URL, ref string) string {
+	ref = strings.TrimSpace(ref)
+	u, err := url.Parse(ref)
+	if err != nil || base == nil {
+		return ref
+	}
+	return base.ResolveReference(u).String()
+}
+
+// ---------- HTML 辅助函数 ----------
+
+// walk 深度优先遍历，fn 返回 false 时不再进入该节点的子节点
+func walk(n *html.Node, fn func(*html.Node) bool) {
+	if !fn(n) {
+		return
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, fn)
+	}
+}
+
+func attr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && a.Key == key {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+func attrValue(n *html.Node, key string) string {
+	v, _ := attr(n, key)
+	return v
+}
+
+// hasToken 空格分隔的属性值中是否包含 token（不区分大小写）
+func hasToken(value, token string) bool {
+	for _, t := range strings.Fields(value) {
+		if strings.EqualFold(t, token) {
+			return true
+		}
+	}
+	return false
+}
+
+// textContent 元素的文本内容，忽略 script/style，块级元素之间以空格分隔，空白折叠为单个空格
+func textContent(n *html.Node) string {
+	var b strings.Builder
+	walk(n, func(c *html.Node) bool {
+		if c.Type == html.ElementNode {
+			switch c.Data {
+			case "script", "style", "template":
+				return false
+			case "br", "p", "div", "li", "tr", "td", "th", "blockquote", "pre", "h1", "h2", "h3", "h4", "h5", "h6":
+				b.WriteByte(' ')
+			}
+		}
+		if c.Type == html.TextNode {
+			b.WriteString(c.Data)
+		}
+		return true
+	})
+	return strings.Join(strings.Fields(b.String()), " ")
+}
@@ -0,0 +1,170 @@
+package webmention
+
+import (
+	"bytes"
+	"context"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Endpoint 目标声明的通知端点
+type Endpoint struct {
+	URL      string
+	Pingback bool // 为 true 时是 XML-RPC Pingback 端点
+}
+
+// Discover 按 Webmention 规范发现目标的端点：依次检查 Link 响应头、HTML 中第一个 rel=webmention 的 <link>/<a>；
+// 没有 Webmention 端点时退回到 X-Pingback 响应头或 <link rel="pingback">
+func Discover(ctx context.Context, client Doer, target string) (*Endpoint, error) {
+	resp, err := do(ctx, client, http.MethodGet, target, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status < 200 || resp.Status >= 300 {
+		return nil, &StatusError{URL: target, Code: resp.Status}
+	}
+
+	for _, link := range resp.Header.Values("Link") {
+		if href, ok := linkHeaderRel(link, "webmention"); ok {
+			return resolveEndpoint(resp.URL, href, false)
+		}
+	}
+	var doc *html.Node
+	if isHTML(resp.Header.Get("Content-Type")) {
+		if doc, err = html.Parse(bytes.NewReader(resp.Body)); err != nil {
+			doc = nil
+		}
+	}
+	if doc != nil {
+		if href, ok := findRelLink(doc, "webmention", true); ok {
+			return resolveEndpoint(resp.URL, href, false)
+		}
+	}
+	if href := strings.TrimSpace(resp.Header.Get("X-Pingback")); href != "" {
+		return resolveEndpoint(resp.URL, href, true)
+	}
+	if doc != nil {
+		if href, ok := findRelLink(doc, "pingback", false); ok && href != "" {
+			return resolveEndpoint(resp.URL, href, true)
+		}
+	}
+	return nil, ErrNoEndpoint
+}
+
+func resolveEndpoint(base *url.URL, href string, pingback bool) (*Endpoint, error) {
+	ref, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return nil, err
+	}
+	endpoint := base.ResolveReference(ref)
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, ErrNoEndpoint
+	}
+	return &Endpoint{URL: endpoint.String(), Pingback: pingback}, nil
+}
+
+// linkHeaderRel 在一个 Link 响应头值中查找指定 rel 的地址，例如 <https://a/webmention>; rel="webmention"
+func linkHeaderRel(header, rel string) (string, bool) {
+	for _, part := range splitLinkHeader(header) {
+		start, end := strings.Index(part, "<"), strings.Index(part, ">")
+		if start < 0 || end < start {
+			continue
+		}
+		for _, param := range strings.Split(part[end+1:], ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(key), "rel") {
+				continue
+			}
+			for _, r := range strings.Fields(strings.Trim(strings.TrimSpace(value), `"`)) {
+				if strings.EqualFold(r, rel) {
+					return part[start+1 : end], true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// splitLinkHeader 按不在 <> 内的逗号切分 Link 响应头
+func splitLinkHeader(header string) []string {
+	var parts []string
+	depth, last := 0, 0
+	for i, c := range header {
+		switch c {
+		case '<':
+			depth++
+		case '>':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, header[last:i])
+				last = i + 1
+			}
+		}
+	}
+	return append(parts, header[last:])
+}
+
+// findRelLink 按文档顺序查找第一个 rel 含指定值且带 href 的 <link>（withAnchor 时也包括 <a>）
+func findRelLink(doc *html.Node, rel string, withAnchor bool) (string, bool) {
+	var href string
+	found := false
+	walk(doc, func(n *html.Node) bool {
+		if found {
+			return false
+		}
+		if n.Type != html.ElementNode || (n.Data != "link" && !(withAnchor && n.Data == "a")) {
+			return true
+		}
+		v, ok := attr(n, "href")
+		if !ok || !hasToken(attrValue(n, "rel"), rel) {
+			return true
+		}
+		href, found = v, true
+		return false
+	})
+	return href, found
+}
+
+// Send 向 Webmention 端点发送通知，2xx 视为成功，返回对方的状态码
+func Send(ctx context.Context, client Doer, endpoint, source, target string) (int, error) {
+	form := url.Values{"source": {source}, "target": {target}}
+	resp, err := do(ctx, client, http.MethodPost, endpoint, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return 0, err
+	}
+	if resp.Status < 200 || resp.Status >= 300 {
+		return resp.Status, &StatusError{URL: endpoint, Code: resp.Status}
+	}
+	return resp.Status, nil
+}
+
+// Ping 向 Pingback 端点发送 pingback.ping；对方返回“已登记”（48）时视为成功
+func Ping(ctx context.Context, client Doer, endpoint, source, target string) (int, error) {
+	body, err := encodeMethodCall("pingback.ping", source, target)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := do(ctx, client, http.MethodPost, endpoint, "text/xml", bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	if resp.Status < 200 || resp.Status >= 300 {
+		return resp.Status, &StatusError{URL: endpoint, Code: resp.Status}
+	}
+	if fault := decodeFault(resp.Body); fault != nil && fault.Code != FaultAlreadyRegistered {
+		return resp.Status, fault
+	}
+	return resp.Status, nil
+}
+
+func isHTML(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
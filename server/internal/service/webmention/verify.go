@@ -0,0 +1,207 @@
+package webmention
+
+import (
+	"bytes"
+	"context"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// Mention 校验通过后从来源页面解析出的提及
+type Mention struct {
+	Type        string // mention/reply/like/repost/bookmark
+	URL         string // 来源条目的永久链接（u-url），缺省为来源地址
+	Title       string
+	Content     string // 纯文本
+	AuthorName  string
+	AuthorURL   string
+	AuthorPhoto string
+	Published   time.Time // 未声明时为零值
+}
+
+// Verify 抓取来源页面，确认其包含指向目标的链接，并按 h-entry 微格式解析提及内容。
+// 来源返回 410 时返回 ErrGone，不再包含链接时返回 ErrNoLink，其余非 2xx 返回 *StatusError
+func Verify(ctx context.Context, client Doer, source, target string) (*Mention, error) {
+	resp, err := do(ctx, client, http.MethodGet, source, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status == http.StatusGone {
+		return nil, ErrGone
+	}
+	if resp.Status < 200 || resp.Status >= 300 {
+		return nil, &StatusError{URL: source, Code: resp.Status}
+	}
+
+	mention := &Mention{Type: TypeMention, URL: source}
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if !isHTML(mediaType) {
+		// 纯文本、JSON 等只检查是否出现目标地址
+		if (strings.HasPrefix(mediaType, "text/") || strings.HasSuffix(mediaType, "json")) && bytes.Contains(resp.Body, []byte(target)) {
+			return mention, nil
+		}
+		return nil, ErrNoLink
+	}
+
+	doc, err := html.Parse(bytes.NewReader(resp.Body))
+	if err != nil {
+		return nil, err
+	}
+	want := NormalizeURL(target)
+	var linkNode *html.Node
+	walk(doc, func(n *html.Node) bool {
+		if linkNode != nil {
+			return false
+		}
+		if n.Type == html.ElementNode {
+			for _, name := range urlAttrs(n.Data) {
+				if v, ok := attr(n, name); ok && NormalizeURL(resolve(resp.URL, v)) == want {
+					linkNode = n
+					return false
+				}
+			}
+		}
+		return true
+	})
+	if linkNode == nil {
+		return nil, ErrNoLink
+	}
+
+	entry := findEntry(parseItems(doc, resp.URL), linkNode, resp.URL)
+	if entry == nil {
+		mention.Title = pageTitle(doc)
+		return mention, nil
+	}
+	fillMention(mention, entry, want)
+	return mention, nil
+}
+
+// findEntry 选择包含该链接的 h-entry，没有时取第一个 h-entry
+func findEntry(items []*mfItem, link *html.Node, base *url.URL) *mfItem {
+	var entries []*mfItem
+	var collect func(list []*mfItem)
+	collect = func(list []*mfItem) {
+		for _, it := range list {
+			if it.has("h-entry") {
+				entries = append(entries, it)
+			}
+			if it.has("h-feed") {
+				var children []*mfItem
+				for c := it.node.FirstChild; c != nil; c = c.NextSibling {
+					children = append(children, parseItems(c, base)...)
+				}
+				collect(children)
+			}
+		}
+	}
+	collect(items)
+	for _, e := range entries {
+		for p := link; p != nil; p = p.Parent {
+			if p == e.node {
+				return e
+			}
+		}
+	}
+	if len(entries) > 0 {
+		return entries[0]
+	}
+	return nil
+}
+
+// fillMention 从 h-entry 读取提及内容；类型按 Post Type Discovery 的顺序判断，且只认指向目标的属性
+func fillMention(m *Mention, entry *mfItem, target string) {
+	if u := entry.first("url"); u != "" {
+		m.URL = u
+	}
+	m.Content = firstNonEmpty(entry.first("content"), entry.first("summary"))
+	if name := entry.first("name"); name != "" && !strings.HasPrefix(m.Content, name) {
+		m.Title = name
+	}
+	if published := entry.first("published"); published != "" {
+		m.Published = parseTime(published)
+	}
+	for _, v := range entry.props["author"] {
+		if v.item != nil {
+			m.AuthorName = v.item.first("name")
+			m.AuthorURL = v.item.first("url")
+			m.AuthorPhoto = v.item.first("photo")
+		} else {
+			m.AuthorName = v.text
+		}
+		break
+	}
+	for _, c := range []struct{ prop, typ string }{
+		{"repost-of", TypeRepost},
+		{"like-of", TypeLike},
+		{"bookmark-of", TypeBookmark},
+		{"in-reply-to", TypeReply},
+	} {
+		for _, u := range entry.urls(c.prop) {
+			if NormalizeURL(u) == target {
+				m.Type = c.typ
+				return
+			}
+		}
+	}
+}
+
+// parseTime 解析 dt-published，支持常见的 ISO 8601 写法
+func parseTime(s string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z0700", "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func pageTitle(doc *html.Node) string {
+	title := ""
+	walk(doc, func(n *html.Node) bool {
+		if title != "" {
+			return false
+		}
+		if n.Type == html.ElementNode && n.Data == "title" {
+			title = textContent(n)
+			return false
+		}
+		return true
+	})
+	return title
+}
+
+// ExtractLinks 提取 HTML 中 <a href> 指向的 http/https 绝对地址（相对地址按 base 解析），去重并保持顺序
+func ExtractLinks(content, base string) []string {
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return nil
+	}
+	baseURL, _ := url.Parse(base)
+	seen := map[string]bool{}
+	var links []string
+	walk(doc, func(n *html.Node) bool {
+		if n.Type != html.ElementNode || n.Data != "a" {
+			return true
+		}
+		href, ok := attr(n, "href")
+		if !ok {
+			return true
+		}
+		link := resolve(baseURL, href)
+		if u, err := url.Parse(link); err == nil {
+			u.Fragment = ""
+			link = u.String()
+		}
+		if ValidURL(link) && !seen[link] {
+			seen[link] = true
+			links = append(links, link)
+		}
+		return true
+	})
+	return links
+}
@@ -0,0 +1,158 @@
+// Package webmention 实现 Webmention（W3C）与 Pingback 的发送、端点发现和来源校验。
+//
+// 所有网络请求通过 Doer 接口发出，调用方可以注入自定义客户端（例如指向本地桩服务的 *http.Client）；
+// NewHTTPClient 返回的默认客户端会拒绝连接内网地址，避免来源校验被用于探测内部服务。
+package webmention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// 提及类型
+const (
+	TypeMention  = "mention"
+	TypeReply    = "reply"
+	TypeLike     = "like"
+	TypeRepost   = "repost"
+	TypeBookmark = "bookmark"
+)
+
+// maxBodySize 抓取页面的最大字节数，超出部分被忽略
+const maxBodySize = 1 << 20
+
+// userAgent 发出请求时使用的 User-Agent
+const userAgent = "JieCool-Webmention/1.0"
+
+var (
+	// ErrNoEndpoint 目标页面没有声明 Webmention 或 Pingback 端点
+	ErrNoEndpoint = errors.New("目标未声明 Webmention 或 Pingback 端点")
+	// ErrNoLink 来源页面不包含指向目标的链接
+	ErrNoLink = errors.New("来源页面不包含指向目标的链接")
+	// ErrGone 来源页面已删除（410）
+	ErrGone = errors.New("来源页面已删除")
+	// ErrPrivateAddress 默认客户端拒绝连接内网地址
+	ErrPrivateAddress = errors.New("不允许访问内网地址")
+)
+
+// Doer 发送 HTTP 请求，*http.Client 满足该接口
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// StatusError 对方返回了非 2xx 状态码
+type StatusError struct {
+	URL  string
+	Code int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s 返回状态码 %d", e.URL, e.Code)
+}
+
+// Permanent 4xx 视为永久失败，5xx 可以重试
+func (e *StatusError) Permanent() bool {
+	return e.Code >= 400 && e.Code < 500
+}
+
+// NewHTTPClient 创建默认客户端；allowPrivate 为 false 时拒绝连接回环、内网与链路本地地址（解析 DNS 之后检查）
+func NewHTTPClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateIP(ip) {
+				return ErrPrivateAddress
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.Proxy = nil
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.New("重定向次数过多")
+			}
+			return nil
+		},
+	}
+}
+
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast()
+}
+
+// response 读取后的响应
+type response struct {
+	URL    *url.URL // 重定向之后的最终地址
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// do 发出请求并读取最多 maxBodySize 字节的响应体
+func do(ctx context.Context, client Doer, method, rawURL, contentType string, body io.Reader) (*response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return nil, err
+	}
+	final := req.URL
+	if resp.Request != nil && resp.Request.URL != nil {
+		final = resp.Request.URL
+	}
+	return &response{URL: final, Status: resp.StatusCode, Header: resp.Header, Body: data}, nil
+}
+
+// ValidURL 是否为带主机名的 http/https 绝对地址
+func ValidURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// NormalizeURL 用于比较的地址形式：去掉片段与末尾斜杠，协议与主机名小写
+func NormalizeURL(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return raw
+	}
+	u.Fragment = ""
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	s := u.String()
+	if strings.HasSuffix(s, "/") && u.RawQuery == "" {
+		s = strings.TrimSuffix(s, "/")
+	}
+	return s
+}
@@ -0,0 +1,233 @@
+package webmention
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const target = "https://blog.example/posts/hello"
+
+func TestVerify(t *testing.T) {
+	pages := map[string]string{
+		"/reply": `<html><head><title>Page</title></head><body>
+			<div class="h-entry">
+				<a class="u-url" href="/reply#entry">permalink</a>
+				<span class="p-name">Nice post</span>
+				<div class="e-content">Nice post, agreed.</div>
+				<time class="dt-published" datetime="2024-05-06T07:08:09Z">May 6</time>
+				<span class="p-author h-card"><img class="u-photo" src="/a.png" alt=""><a class="p-name u-url" href="https://alice.example">Alice</a></span>
+				<a class="u-in-reply-to" href="https://BLOG.example/posts/hello/">in reply to</a>
+			</div></body></html>`,
+		"/feed": `<div class="h-feed">
+			<div class="h-entry"><p class="e-content">first</p></div>
+			<div class="h-entry"><p class="e-content">second</p><a class="u-like-of" href="` + target + `">like</a></div>
+			</div>`,
+		"/bare":   `<html><head><title> Bare page </title></head><body><a href="` + target + `#c">link</a></body></html>`,
+		"/nolink": `<html><body><a href="https://blog.example/other">other</a></body></html>`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gone":
+			w.WriteHeader(http.StatusGone)
+		case "/error":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/moved":
+			http.Redirect(w, r, "/reply", http.StatusFound)
+		case "/text":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = io.WriteString(w, "see "+target)
+		default:
+			page, ok := pages[r.URL.Path]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = io.WriteString(w, page)
+		}
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	m, err := Verify(ctx, srv.Client(), srv.URL+"/moved", target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Mention{
+		Type: TypeReply, URL: srv.URL + "/reply#entry", Title: "",
+		Content: "Nice post, agreed.", AuthorName: "Alice", AuthorURL: "https://alice.example", AuthorPhoto: srv.URL + "/a.png",
+		Published: time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC),
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("reply:\n got %+v\nwant %+v", m, want)
+	}
+
+	cases := []struct {
+		path    string
+		typ     string
+		title   string
+		content string
+	}{
+		{"/feed", TypeLike, "", "second"},
+		{"/bare", TypeMention, "Bare page", ""},
+		{"/text", TypeMention, "", ""},
+	}
+	for _, tc := range cases {
+		m, err := Verify(ctx, srv.Client(), srv.URL+tc.path, target)
+		if err != nil {
+			t.Errorf("%s: %v", tc.path, err)
+			continue
+		}
+		if m.Type != tc.typ || m.Title != tc.title || m.Content != tc.content {
+			t.Errorf("%s: got %+v", tc.path, m)
+		}
+	}
+
+	var statusErr *StatusError
+	if _, err = Verify(ctx, srv.Client(), srv.URL+"/nolink", target); !errors.Is(err, ErrNoLink) {
+		t.Errorf("nolink: got %v", err)
+	}
+	if _, err = Verify(ctx, srv.Client(), srv.URL+"/gone", target); !errors.Is(err, ErrGone) {
+		t.Errorf("gone: got %v", err)
+	}
+	if _, err = Verify(ctx, srv.Client(), srv.URL+"/error", target); !errors.As(err, &statusErr) || statusErr.Permanent() {
+		t.Errorf("server error: got %v", err)
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	cases := []struct {
+		name     string
+		header   map[string]string
+		body     string
+		want     string
+		pingback bool
+	}{
+		{"link header", map[string]string{"Link": `<https://x.example/a>; rel="other", </wm?a=1,2>; rel="webmention"`}, `<link rel="webmention" href="/html">`, "/wm?a=1,2", false},
+		{"html link", nil, `<a href="/not" rel="nofollow"></a><link rel="stylesheet" href="/s.css"><link rel="webmention" href="/html">`, "/html", false},
+		{"anchor", nil, `<a rel="webmention" href="https://wm.example/endpoint">wm</a>`, "https://wm.example/endpoint", false},
+		{"empty href", nil, `<link rel="webmention" href="">`, "/page", false},
+		{"x-pingback", map[string]string{"X-Pingback": "/xmlrpc.php"}, `<link rel="pingback" href="/other.php">`, "/xmlrpc.php", true},
+		{"html pingback", nil, `<link rel="pingback" href="/xmlrpc.php">`, "/xmlrpc.php", true},
+	}
+	for _, tc := range cases {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for k, v := range tc.header {
+				w.Header().Set(k, v)
+			}
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = io.WriteString(w, tc.body)
+		}))
+		ep, err := Discover(context.Background(), srv.Client(), srv.URL+"/page")
+		want := tc.want
+		if strings.HasPrefix(want, "/") {
+			want = srv.URL + want
+		}
+		if err != nil || ep.URL != want || ep.Pingback != tc.pingback {
+			t.Errorf("%s: got %+v, %v; want %s", tc.name, ep, err, want)
+		}
+		srv.Close()
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = io.WriteString(w, `<link rel="webmention" href="javascript:alert(1)">`)
+	}))
+	defer srv.Close()
+	if _, err := Discover(context.Background(), srv.Client(), srv.URL); !errors.Is(err, ErrNoEndpoint) {
+		t.Errorf("unsupported scheme: got %v", err)
+	}
+}
+
+func TestSendAndPing(t *testing.T) {
+	var got url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != userAgent {
+			t.Errorf("user agent: %q", r.Header.Get("User-Agent"))
+		}
+		switch r.URL.Path {
+		case "/webmention":
+			_ = r.ParseForm()
+			got = r.PostForm
+			w.WriteHeader(http.StatusAccepted)
+		case "/rejected":
+			w.WriteHeader(http.StatusBadRequest)
+		case "/xmlrpc":
+			body, _ := io.ReadAll(r.Body)
+			source, _, err := ParsePingback(body)
+			switch {
+			case err != nil:
+				_, _ = w.Write(EncodeFault(err))
+			case strings.HasSuffix(source, "/again"):
+				_, _ = w.Write(EncodeFault(&Fault{Code: FaultAlreadyRegistered, Message: "already registered"}))
+			case strings.HasSuffix(source, "/nolink"):
+				_, _ = w.Write(EncodeFault(&Fault{Code: FaultNoLink, Message: "no link"}))
+			default:
+				_, _ = w.Write(EncodeResponse("ok"))
+			}
+		}
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+	source := "https://blog.example/posts/new"
+
+	if code, err := Send(ctx, srv.Client(), srv.URL+"/webmention", source, target); err != nil || code != http.StatusAccepted {
+		t.Fatalf("send: got %d, %v", code, err)
+	}
+	if got.Get("source") != source || got.Get("target") != target {
+		t.Errorf("send form: %v", got)
+	}
+	var statusErr *StatusError
+	if code, err := Send(ctx, srv.Client(), srv.URL+"/rejected", source, target); code != http.StatusBadRequest || !errors.As(err, &statusErr) || !statusErr.Permanent() {
+		t.Errorf("rejected: got %d, %v", code, err)
+	}
+
+	for _, src := range []string{source, source + "/again"} {
+		if _, err := Ping(ctx, srv.Client(), srv.URL+"/xmlrpc", src, target); err != nil {
+			t.Errorf("ping %s: %v", src, err)
+		}
+	}
+	var fault *Fault
+	if _, err := Ping(ctx, srv.Client(), srv.URL+"/xmlrpc", source+"/nolink", target); !errors.As(err, &fault) || fault.Code != FaultNoLink {
+		t.Errorf("ping fault: got %v", err)
+	}
+}
+
+func TestHTTPClientRejectsPrivateAddress(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	if _, err := Verify(context.Background(), NewHTTPClient(time.Second, false), srv.URL, target); !errors.Is(err, ErrPrivateAddress) {
+		t.Errorf("loopback: got %v", err)
+	}
+	if _, err := Verify(context.Background(), NewHTTPClient(time.Second, true), srv.URL, target); !errors.Is(err, ErrNoLink) {
+		t.Errorf("allow private: got %v", err)
+	}
+}
+
+func TestExtractLinksAndNormalizeURL(t *testing.T) {
+	links := ExtractLinks(`<a href="/a#x">a</a> <a href="https://x.example/b">b</a> <a href="/a">again</a>
+		<a href="mailto:me@example.com">mail</a> <a>none</a>`, "https://blog.example/posts/1")
+	if want := []string{"https://blog.example/a", "https://x.example/b"}; !reflect.DeepEqual(links, want) {
+		t.Errorf("ExtractLinks: got %q", links)
+	}
+
+	cases := map[string]string{
+		"HTTPS://Blog.Example/posts/hello/#top": "https://blog.example/posts/hello",
+		"https://blog.example":                  "https://blog.example",
+		"https://blog.example/?p=1":             "https://blog.example/?p=1",
+	}
+	for in, want := range cases {
+		if got := NormalizeURL(in); got != want {
+			t.Errorf("NormalizeURL(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package webmention
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Pingback 规范定义的错误码
+const (
+	FaultGeneric           = 0
+	FaultSourceNotFound    = 16 // 来源地址不存在
+	FaultNoLink            = 17 // 来源页面不包含指向目标的链接
+	FaultTargetNotFound    = 32 // 目标地址不存在
+	FaultTargetInvalid     = 33 // 目标不接受 Pingback
+	FaultAlreadyRegistered = 48 // 已经登记过
+	FaultAccessDenied      = 49 // 拒绝访问
+)
+
+// Fault XML-RPC 错误响应
+type Fault struct {
+	Code    int
+	Message string
+}
+
+func (f *Fault) Error() string {
+	return fmt.Sprintf("pingback 错误 %d: %s", f.Code, f.Message)
+}
+
+type xmlrpcValue struct {
+	String string        `xml:"string"`
+	Int    string        `xml:"int"`
+	I4     string        `xml:"i4"`
+	Struct *xmlrpcStruct `xml:"struct"`
+	Text   string        `xml:",chardata"`
+}
+
+// str 字符串值，未标注类型的值按字符串处理
+func (v xmlrpcValue) str() string {
+	if v.String != "" {
+		return v.String
+	}
+	return strings.TrimSpace(v.Text)
+}
+
+type xmlrpcStruct struct {
+	Members []struct {
+		Name  string      `xml:"name"`
+		Value xmlrpcValue `xml:"value"`
+	} `xml:"member"`
+}
+
+type xmlrpcCall struct {
+	XMLName    xml.Name `xml:"methodCall"`
+	MethodName string   `xml:"methodName"`
+	Params     []struct {
+		Value xmlrpcValue `xml:"value"`
+	} `xml:"params>param"`
+}
+
+type xmlrpcResponse struct {
+	XMLName xml.Name     `xml:"methodResponse"`
+	Fault   *xmlrpcValue `xml:"fault>value"`
+}
+
+// encodeMethodCall 编码只含字符串参数的 XML-RPC 调用
+func encodeMethodCall(method string, params ...string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString("<methodCall><methodName>")
+	if err := xml.EscapeText(&buf, []byte(method)); err != nil {
+		return nil, err
+	}
+	buf.WriteString("</methodName><params>")
+	for _, p := range params {
+		buf.WriteString("<param><value><string>")
+		if err := xml.EscapeText(&buf, []byte(p)); err != nil {
+			return nil, err
+		}
+		buf.WriteString("</string></value></param>")
+	}
+	buf.WriteString("</params></methodCall>")
+	return buf.Bytes(), nil
+}
+
+// decodeFault 解析 XML-RPC 响应中的错误，无错误时返回 nil
+func decodeFault(body []byte) *Fault {
+	var resp xmlrpcResponse
+	if err := xml.Unmarshal(body, &resp); err != nil || resp.Fault == nil || resp.Fault.Struct == nil {
+		return nil
+	}
+	fault := &Fault{}
+	for _, m := range resp.Fault.Struct.Members {
+		switch m.Name {
+		case "faultCode":
+			fault.Code, _ = strconv.Atoi(firstNonEmpty(m.Value.Int, m.Value.I4, m.Value.str()))
+		case "faultString":
+			fault.Message = m.Value.str()
+		}
+	}
+	return fault
+}
+
+// ParsePingback 解析收到的 pingback.ping 调用，返回来源与目标地址
+func ParsePingback(body []byte) (source, target string, err error) {
+	var call xmlrpcCall
+	if err = xml.Unmarshal(body, &call); err != nil {
+		return "", "", &Fault{Code: FaultGeneric, Message: "无法解析 XML-RPC 请求"}
+	}
+	if call.MethodName != "pingback.ping" {
+		return "", "", &Fault{Code: -32601, Message: "不支持的方法: " + call.MethodName}
+	}
+	if len(call.Params) != 2 {
+		return "", "", &Fault{Code: -32602, Message: "pingback.ping 需要两个参数"}
+	}
+	return call.Params[0].Value.str(), call.Params[1].Value.str(), nil
+}
+
+// EncodeResponse 编码成功响应（字符串结果）
+func EncodeResponse(message string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString("<methodResponse><params><param><value><string>")
+	_ = xml.EscapeText(&buf, []byte(message))
+	buf.WriteString("</string></value></param></params></methodResponse>")
+	return buf.Bytes()
+}
+
+// EncodeFault 编码错误响应；err 不是 *Fault 时使用通用错误码
+func EncodeFault(err error) []byte {
+	var fault *Fault
+	if !errors.As(err, &fault) {
+		fault = &Fault{Code: FaultGeneric, Message: err.Error()}
+	}
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString("<methodResponse><fault><value><struct>")
+	buf.WriteString("<member><name>faultCode</name><value><int>" + strconv.Itoa(fault.Code) + "</int></value></member>")
+	buf.WriteString("<member><name>faultString</name><value><string>")
+	_ = xml.EscapeText(&buf, []byte(fault.Message))
+	buf.WriteString("</string></value></member>")
+	buf.WriteString("</struct></value></fault></methodResponse>")
+	return buf.Bytes()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/os/gtime"
@@ -13,6 +15,7 @@ import (
 	"server/internal/dao"
 	"server/internal/model/do"
 	"server/internal/model/entity"
+	"server/internal/service/auth"
 )
 
 // IWeibo 微博服务接口
@@ -31,6 +34,10 @@ type IWeibo interface {
 	Snapshot(ctx context.Context, id int64) (snap *entity.WeiboSnapshots, metaAssets []v1.AssetItem, err error)
 	// Delete 软删除
 	Delete(ctx context.Context, id int64) error
+	// ArchiveMonths 按年、月统计微博数；未登录只统计公开微博，登录后可按可见性过滤
+	ArchiveMonths(ctx context.Context, visibility string) ([]*ArchiveYear, error)
+	// ArchiveList 指定年月内的微博，可见性规则与 ArchiveMonths 相同，按创建时间倒序
+	ArchiveList(ctx context.Context, year, month int, visibility string, page, size int) (posts []*entity.WeiboPosts, assetsMap map[int64][]*entity.WeiboAssets, total int, err error)
 }
 
 type sWeibo struct{}
@@ -51,13 +58,17 @@ func (s *sWeibo) Create(ctx context.Context, req *v1.CreateReq) (id int64, creat
 	if req.Visibility == "" {
 		req.Visibility = "public"
 	}
+	authorId := auth.CurrentUserID(ctx)
+	if authorId == 0 {
+		return 0, "", gerror.NewCode(gcode.CodeNotAuthorized, "未登录")
+	}
 
 	err = g.DB().Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
 		// 插入主帖
 		result, err := dao.WeiboPosts.Ctx(ctx).TX(tx).InsertAndGetId(do.WeiboPosts{
 			Content:    req.Content,
 			Visibility: req.Visibility,
-			AuthorId:   0,
+			AuthorId:   authorId,
 			Lat:        req.Lat,
 			Lng:        req.Lng,
 			City:       req.City,
@@ -91,7 +102,8 @@ func (s *sWeibo) Create(ctx context.Context, req *v1.CreateReq) (id int64, creat
 			}
 		}
 
-		return nil
+		// 话题与提及
+		return WeiboTopic().Sync(ctx, tx, id, req.Content)
 	})
 	if err != nil {
 		return 0, "", err
@@ -109,6 +121,7 @@ func (s *sWeibo) Create(ctx context.Context, req *v1.CreateReq) (id int64, creat
 			createdAt = post.CreatedAt.String()
 		}
 	}
+	InvalidateContentCaches()
 	return id, createdAt, nil
 }
 
@@ -131,6 +144,9 @@ func (s *sWeibo) Update(ctx context.Context, req *v1.UpdateReq) (snapshotVersion
 		}
 		var cur entity.WeiboPosts
 		_ = rec.Struct(&cur)
+		if err = CheckOwnership(ctx, cur.AuthorId); err != nil {
+			return err
+		}
 
 		// 当前资产
 		assetsRec, err := dao.WeiboAssets.Ctx(ctx).TX(tx).Where(dao.WeiboAssets.Columns().PostId, req.Id).Order(dao.WeiboAssets.Columns().SortOrder + "," + dao.WeiboAssets.Columns().Id).All()
@@ -220,6 +236,13 @@ func (s *sWeibo) Update(ctx context.Context, req *v1.UpdateReq) (snapshotVersion
 			}
 		}
 
+		// 正文变更后重新解析话题与提及
+		if req.Content != "" {
+			if err := WeiboTopic().Sync(ctx, tx, req.Id, req.Content); err != nil {
+				return err
+			}
+		}
+
 		// 更新资产：先删后插
 		if _, err := dao.WeiboAssets.Ctx(ctx).TX(tx).Where(dao.WeiboAssets.Columns().PostId, req.Id).Delete(); err != nil {
 			return gerror.Wrap(err, "清理微博资产失败")
@@ -245,6 +268,9 @@ func (s *sWeibo) Update(ctx context.Context, req *v1.UpdateReq) (snapshotVersion
 
 		return nil
 	})
+	if err == nil {
+		InvalidateContentCaches()
+	}
 
 	return snapshotVersion, err
 }
@@ -264,6 +290,16 @@ func (s *sWeibo) List(ctx context.Context, req *v1.ListReq) (posts []*entity.Wei
 	if req.Visibility != "" {
 		m = m.Where(dao.WeiboPosts.Columns().Visibility, req.Visibility)
 	}
+	if req.AuthorId > 0 {
+		m = m.Where(dao.WeiboPosts.Columns().AuthorId, req.AuthorId)
+	}
+	if req.Author != "" {
+		m = m.Where("author_id IN (SELECT id FROM users WHERE username = ?)", strings.ToLower(strings.TrimSpace(req.Author)))
+	}
+	if req.Mention != "" {
+		m = m.Where("id IN (SELECT m.post_id FROM weibo_post_mentions m INNER JOIN users u ON u.id = m.user_id WHERE u.username = ?)",
+			strings.ToLower(strings.TrimPrefix(strings.TrimSpace(req.Mention), "@")))
+	}
 
 	total, err = m.Count()
 	if err != nil {
@@ -278,25 +314,91 @@ func (s *sWeibo) List(ctx context.Context, req *v1.ListReq) (posts []*entity.Wei
 		_ = recs.Structs(&posts)
 	}
 
-	assetsMap = make(map[int64][]*entity.WeiboAssets)
-	if len(posts) > 0 {
-		ids := make([]int64, 0, len(posts))
-		for _, p := range posts {
-			ids = append(ids, p.Id)
-		}
-		aRecs, err := dao.WeiboAssets.Ctx(ctx).WhereIn(dao.WeiboAssets.Columns().PostId, ids).Order(dao.WeiboAssets.Columns().SortOrder + "," + dao.WeiboAssets.Columns().Id).All()
-		if err != nil {
-			return posts, nil, total, gerror.Wrap(err, "查询微博资产失败")
-		}
-		var allAssets []*entity.WeiboAssets
-		if !aRecs.IsEmpty() {
-			_ = aRecs.Structs(&allAssets)
-		}
-		for _, a := range allAssets {
-			assetsMap[a.PostId] = append(assetsMap[a.PostId], a)
+	assetsMap, err = s.postsAssets(ctx, posts)
+	if err != nil {
+		return posts, nil, total, err
+	}
+	return posts, assetsMap, total, nil
+}
+
+// postsAssets 批量查询多条微博的资产，按微博ID分组
+func (s *sWeibo) postsAssets(ctx context.Context, posts []*entity.WeiboPosts) (map[int64][]*entity.WeiboAssets, error) {
+	assetsMap := make(map[int64][]*entity.WeiboAssets)
+	if len(posts) == 0 {
+		return assetsMap, nil
+	}
+	ids := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, p.Id)
+	}
+	aRecs, err := dao.WeiboAssets.Ctx(ctx).WhereIn(dao.WeiboAssets.Columns().PostId, ids).Order(dao.WeiboAssets.Columns().SortOrder + "," + dao.WeiboAssets.Columns().Id).All()
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询微博资产失败")
+	}
+	var allAssets []*entity.WeiboAssets
+	if !aRecs.IsEmpty() {
+		_ = aRecs.Structs(&allAssets)
+	}
+	for _, a := range allAssets {
+		assetsMap[a.PostId] = append(assetsMap[a.PostId], a)
+	}
+	return assetsMap, nil
+}
+
+// archivePosts 按调用方身份应用可见性与软删除规则
+func (s *sWeibo) archivePosts(ctx context.Context, visibility string) (*gdb.Model, bool) {
+	cols := dao.WeiboPosts.Columns()
+	m := dao.WeiboPosts.Ctx(ctx).Where(cols.IsDeleted, false)
+	if !auth.IsAuthenticated(ctx) {
+		return m.Where(cols.Visibility, "public"), true
+	}
+	if visibility != "" {
+		m = m.Where(cols.Visibility, visibility)
+	}
+	return m, false
+}
+
+// ArchiveMonths 按月统计微博数
+func (s *sWeibo) ArchiveMonths(ctx context.Context, visibility string) ([]*ArchiveYear, error) {
+	m, public := s.archivePosts(ctx, visibility)
+	// 只缓存匿名访客的结果，微博变更时随内容缓存一并失效
+	if public {
+		if v, ok := blogContentCache.get("archive:weibo"); ok {
+			return v.([]*ArchiveYear), nil
 		}
 	}
+	years, err := archiveBuckets(m, dao.WeiboPosts.Columns().CreatedAt)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询微博归档失败")
+	}
+	if public {
+		blogContentCache.set(ctx, "archive:weibo", years)
+	}
+	return years, nil
+}
 
+// ArchiveList 指定年月内的微博
+func (s *sWeibo) ArchiveList(ctx context.Context, year, month int, visibility string, page, size int) (posts []*entity.WeiboPosts, assetsMap map[int64][]*entity.WeiboAssets, total int, err error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 10
+	}
+	m, _ := s.archivePosts(ctx, visibility)
+	m = whereArchiveMonth(m, dao.WeiboPosts.Columns().CreatedAt, year, month)
+
+	total, err = m.Count()
+	if err != nil {
+		return nil, nil, 0, gerror.Wrap(err, "统计归档微博失败")
+	}
+	if err = m.OrderDesc(dao.WeiboPosts.Columns().CreatedAt).Limit(size).Offset((page - 1) * size).Scan(&posts); err != nil {
+		return nil, nil, 0, gerror.Wrap(err, "查询归档微博失败")
+	}
+	assetsMap, err = s.postsAssets(ctx, posts)
+	if err != nil {
+		return posts, nil, total, err
+	}
 	return posts, assetsMap, total, nil
 }
 
@@ -375,9 +477,23 @@ func (s *sWeibo) Snapshot(ctx context.Context, id int64) (snap *entity.WeiboSnap
 
 // Delete 软删除
 func (s *sWeibo) Delete(ctx context.Context, id int64) error {
-	_, err := dao.WeiboPosts.Ctx(ctx).Where(dao.WeiboPosts.Columns().Id, id).Update(g.Map{dao.WeiboPosts.Columns().IsDeleted: true})
+	authorId, err := dao.WeiboPosts.Ctx(ctx).Fields(dao.WeiboPosts.Columns().AuthorId).
+		Where(dao.WeiboPosts.Columns().Id, id).
+		Where(dao.WeiboPosts.Columns().IsDeleted, false).
+		Value()
+	if err != nil {
+		return gerror.Wrap(err, "查询微博失败")
+	}
+	if authorId.IsNil() {
+		return gerror.NewCode(gcode.CodeNotFound, "微博不存在或已删除")
+	}
+	if err = CheckOwnership(ctx, authorId.Int64()); err != nil {
+		return err
+	}
+	_, err = dao.WeiboPosts.Ctx(ctx).Where(dao.WeiboPosts.Columns().Id, id).Update(g.Map{dao.WeiboPosts.Columns().IsDeleted: true})
 	if err != nil {
 		return gerror.Wrap(err, "删除微博失败")
 	}
+	InvalidateContentCaches()
 	return nil
 }
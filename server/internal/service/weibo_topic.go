@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+
+	"server/internal/dao"
+	"server/internal/model/do"
+	"server/internal/model/entity"
+	"server/internal/service/auth"
+	"server/internal/service/configcache"
+	"server/internal/service/weibotext"
+)
+
+// 热门话题时间窗口上限：30 天
+const maxTrendingHours = 30 * 24
+
+// reindexBatchSize 重新解析微博时每批处理的数量
+const reindexBatchSize = 200
+
+// TopicStat 话题及其可见微博数
+type TopicStat struct {
+	entity.WeiboTopics
+	PostCount  int         `json:"postCount"  orm:"post_count"`
+	LastPostAt *gtime.Time `json:"lastPostAt" orm:"last_post_at"`
+}
+
+// WeiboEntity 微博正文中的话题或提及，提及附带对应的用户ID
+type WeiboEntity struct {
+	weibotext.Entity
+	UserId int64
+}
+
+// IWeiboTopic 微博话题与 @提及服务接口
+type IWeiboTopic interface {
+	// Sync 重新解析微博正文并替换其话题与提及关联，在微博创建、编辑的事务内调用
+	Sync(ctx context.Context, tx gdb.TX, postId int64, content string) error
+	// Entities 批量计算微博正文中的话题与提及，提及只包含对应到站内用户的
+	Entities(ctx context.Context, posts []*entity.WeiboPosts) (map[int64][]*WeiboEntity, error)
+	// List 话题列表，按微博数倒序；keyword 非空时按名称包含匹配
+	List(ctx context.Context, keyword string, page, size int) ([]*TopicStat, int, error)
+	// Get 按名称查询话题及微博数，名称大小写不敏感
+	Get(ctx context.Context, name string) (*TopicStat, error)
+	// Posts 话题下的微博，按创建时间倒序
+	Posts(ctx context.Context, topicId int64, page, size int) (posts []*entity.WeiboPosts, assetsMap map[int64][]*entity.WeiboAssets, total int, err error)
+	// Trending 最近 hours 小时内被最多微博使用的话题，hours、limit 为 0 时使用配置
+	Trending(ctx context.Context, hours, limit int) ([]*TopicStat, error)
+	// Reindex 重新解析全部微博，返回处理的微博数
+	Reindex(ctx context.Context) (int, error)
+}
+
+type sWeiboTopic struct{}
+
+// WeiboTopic 微博话题服务实例
+func WeiboTopic() IWeiboTopic {
+	return &sWeiboTopic{}
+}
+
+// Sync 同步话题与提及
+func (s *sWeiboTopic) Sync(ctx context.Context, tx gdb.TX, postId int64, content string) error {
+	oldTopicIds, err := dao.WeiboPostTopics.Ctx(ctx).TX(tx).Fields("topic_id").Where("post_id", postId).Array()
+	if err != nil {
+		return gerror.Wrap(err, "查询微博话题失败")
+	}
+	if _, err = dao.WeiboPostTopics.Ctx(ctx).TX(tx).Where("post_id", postId).Delete(); err != nil {
+		return gerror.Wrap(err, "清理微博话题失败")
+	}
+	if _, err = dao.WeiboPostMentions.Ctx(ctx).TX(tx).Where("post_id", postId).Delete(); err != nil {
+		return gerror.Wrap(err, "清理微博提及失败")
+	}
+
+	var (
+		seen      = make(map[string]bool)
+		usernames []string
+	)
+	for _, e := range weibotext.Parse(content) {
+		switch e.Type {
+		case weibotext.TypeHashtag:
+			key := weibotext.TopicKey(e.Value)
+			if key == "" || seen["#"+key] {
+				continue
+			}
+			seen["#"+key] = true
+			topicId, err := tx.GetValue(`INSERT INTO weibo_topics (name, name_key) VALUES (?, ?)
+				ON CONFLICT (name_key) DO UPDATE SET updated_at = NOW() RETURNING id`, strings.TrimSpace(e.Value), key)
+			if err != nil {
+				return gerror.Wrap(err, "保存话题失败")
+			}
+			if _, err = dao.WeiboPostTopics.Ctx(ctx).TX(tx).Insert(do.WeiboPostTopics{
+				PostId:  postId,
+				TopicId: topicId.Int64(),
+			}); err != nil {
+				return gerror.Wrap(err, "保存微博话题失败")
+			}
+		case weibotext.TypeMention:
+			if !seen["@"+e.Value] {
+				seen["@"+e.Value] = true
+				usernames = append(usernames, e.Value)
+			}
+		}
+	}
+
+	if len(usernames) > 0 {
+		userIds, err := dao.Users.Ctx(ctx).TX(tx).Fields("id").
+			WhereIn("username", usernames).
+			Where("is_active", true).
+			Array()
+		if err != nil {
+			return gerror.Wrap(err, "查询提及用户失败")
+		}
+		for _, id := range userIds {
+			if _, err = dao.WeiboPostMentions.Ctx(ctx).TX(tx).Insert(do.WeiboPostMentions{
+				PostId: postId,
+				UserId: id.Int64(),
+			}); err != nil {
+				return gerror.Wrap(err, "保存微博提及失败")
+			}
+		}
+	}
+
+	// 移除不再被任何微博使用的话题
+	if len(oldTopicIds) > 0 {
+		_, err = dao.WeiboTopics.Ctx(ctx).TX(tx).
+			WhereIn("id", oldTopicIds).
+			Where("NOT EXISTS (SELECT 1 FROM weibo_post_topics pt WHERE pt.topic_id = weibo_topics.id)").
+			Delete()
+		if err != nil {
+			return gerror.Wrap(err, "清理话题失败")
+		}
+	}
+	return nil
+}
+
+// Entities 计算正文实体
+func (s *sWeiboTopic) Entities(ctx context.Context, posts []*entity.WeiboPosts) (map[int64][]*WeiboEntity, error) {
+	out := make(map[int64][]*WeiboEntity, len(posts))
+	parsed := make(map[int64][]weibotext.Entity, len(posts))
+	var mentioned []int64
+	for _, p := range posts {
+		entities := weibotext.Parse(p.Content)
+		parsed[p.Id] = entities
+		for _, e := range entities {
+			if e.Type == weibotext.TypeMention {
+				mentioned = append(mentioned, p.Id)
+				break
+			}
+		}
+	}
+
+	// 微博ID → 用户名 → 用户ID
+	users := make(map[int64]map[string]int64)
+	if len(mentioned) > 0 {
+		rows, err := g.DB().Ctx(ctx).GetAll(ctx, `SELECT m.post_id, u.id, u.username FROM weibo_post_mentions m
+			INNER JOIN users u ON u.id = m.user_id
+			WHERE m.post_id IN(?)`, mentioned)
+		if err != nil {
+			return nil, gerror.Wrap(err, "查询微博提及失败")
+		}
+		for _, r := range rows {
+			postId := r["post_id"].Int64()
+			if users[postId] == nil {
+				users[postId] = make(map[string]int64)
+			}
+			users[postId][r["username"].String()] = r["id"].Int64()
+		}
+	}
+
+	for postId, entities := range parsed {
+		list := make([]*WeiboEntity, 0, len(entities))
+		for _, e := range entities {
+			item := &WeiboEntity{Entity: e}
+			if e.Type == weibotext.TypeMention {
+				if item.UserId = users[postId][e.Value]; item.UserId == 0 {
+					continue
+				}
+			}
+			list = append(list, item)
+		}
+		out[postId] = list
+	}
+	return out, nil
+}
+
+// visiblePostsCond 统计话题微博数时的可见性条件：匿名访客只统计公开微博
+func (s *sWeiboTopic) visiblePostsCond(ctx context.Context) string {
+	if auth.IsAuthenticated(ctx) {
+		return "p.is_deleted = false"
+	}
+	return "p.is_deleted = false AND p.visibility = 'public'"
+}
+
+// List 话题列表
+func (s *sWeiboTopic) List(ctx context.Context, keyword string, page, size int) ([]*TopicStat, int, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+	from := fmt.Sprintf(`FROM weibo_topics t
+		INNER JOIN weibo_post_topics pt ON pt.topic_id = t.id
+		INNER JOIN weibo_posts p ON p.id = pt.post_id AND %s`, s.visiblePostsCond(ctx))
+	var args []interface{}
+	if key := weibotext.TopicKey(keyword); key != "" {
+		from += " WHERE t.name_key LIKE ?"
+		args = append(args, "%"+key+"%")
+	}
+
+	total, err := g.DB().Ctx(ctx).GetValue(ctx, "SELECT COUNT(DISTINCT t.id) "+from, args...)
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "统计话题失败")
+	}
+	var list []*TopicStat
+	err = g.DB().Ctx(ctx).GetScan(ctx, &list, `SELECT t.*, COUNT(p.id) AS post_count, MAX(p.created_at) AS last_post_at `+from+`
+		GROUP BY t.id ORDER BY post_count DESC, last_post_at DESC LIMIT ? OFFSET ?`,
+		append(args, size, (page-1)*size)...)
+	if err != nil {
+		return nil, 0, gerror.Wrap(err, "查询话题列表失败")
+	}
+	return list, total.Int(), nil
+}
+
+// Get 查询话题
+func (s *sWeiboTopic) Get(ctx context.Context, name string) (*TopicStat, error) {
+	key := weibotext.TopicKey(strings.Trim(strings.TrimSpace(name), "#＃"))
+	if key == "" {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "话题名称不能为空")
+	}
+	var stat *TopicStat
+	err := g.DB().Ctx(ctx).GetScan(ctx, &stat, fmt.Sprintf(`SELECT t.*, COUNT(p.id) AS post_count, MAX(p.created_at) AS last_post_at
+		FROM weibo_topics t
+		LEFT JOIN weibo_post_topics pt ON pt.topic_id = t.id
+		LEFT JOIN weibo_posts p ON p.id = pt.post_id AND %s
+		WHERE t.name_key = ?
+		GROUP BY t.id`, s.visiblePostsCond(ctx)), key)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询话题失败")
+	}
+	if stat == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "话题不存在")
+	}
+	return stat, nil
+}
+
+// Posts 话题下的微博
+func (s *sWeiboTopic) Posts(ctx context.Context, topicId int64, page, size int) (posts []*entity.WeiboPosts, assetsMap map[int64][]*entity.WeiboAssets, total int, err error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 10
+	}
+	weibo := &sWeibo{}
+	m, _ := weibo.archivePosts(ctx, "")
+	m = m.Where("id IN (SELECT post_id FROM weibo_post_topics WHERE topic_id = ?)", topicId)
+
+	total, err = m.Count()
+	if err != nil {
+		return nil, nil, 0, gerror.Wrap(err, "统计话题微博失败")
+	}
+	if err = m.OrderDesc(dao.WeiboPosts.Columns().CreatedAt).Limit(size).Offset((page - 1) * size).Scan(&posts); err != nil {
+		return nil, nil, 0, gerror.Wrap(err, "查询话题微博失败")
+	}
+	assetsMap, err = weibo.postsAssets(ctx, posts)
+	if err != nil {
+		return posts, nil, total, err
+	}
+	return posts, assetsMap, total, nil
+}
+
+// Trending 热门话题
+func (s *sWeiboTopic) Trending(ctx context.Context, hours, limit int) ([]*TopicStat, error) {
+	if hours <= 0 {
+		hours = configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "weibo_trending_hours", 24)
+	}
+	if hours <= 0 || hours > maxTrendingHours {
+		hours = maxTrendingHours
+	}
+	if limit <= 0 {
+		limit = configcache.GetInt(ctx, blogConfigNamespace, blogConfigEnv, "weibo_trending_limit", 10)
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 10
+	}
+
+	// 只缓存匿名访客的结果，微博变更时随内容缓存一并失效
+	public := !auth.IsAuthenticated(ctx)
+	cacheKey := fmt.Sprintf("weibo:trending:%d:%d", hours, limit)
+	if public {
+		if v, ok := blogContentCache.get(cacheKey); ok {
+			return v.([]*TopicStat), nil
+		}
+	}
+	var list []*TopicStat
+	err := g.DB().Ctx(ctx).GetScan(ctx, &list, fmt.Sprintf(`SELECT t.*, COUNT(p.id) AS post_count, MAX(p.created_at) AS last_post_at
+		FROM weibo_topics t
+		INNER JOIN weibo_post_topics pt ON pt.topic_id = t.id
+		INNER JOIN weibo_posts p ON p.id = pt.post_id AND %s
+		WHERE p.created_at >= NOW() - (? * INTERVAL '1 hour')
+		GROUP BY t.id ORDER BY post_count DESC, last_post_at DESC LIMIT ?`, s.visiblePostsCond(ctx)), hours, limit)
+	if err != nil {
+		return nil, gerror.Wrap(err, "查询热门话题失败")
+	}
+	if public {
+		blogContentCache.set(ctx, cacheKey, list)
+	}
+	return list, nil
+}
+
+// Reindex 重新解析全部微博
+func (s *sWeiboTopic) Reindex(ctx context.Context) (int, error) {
+	var (
+		lastId int64
+		count  int
+	)
+	for {
+		var posts []*entity.WeiboPosts
+		err := dao.WeiboPosts.Ctx(ctx).Fields("id, content").
+			Where("id > ?", lastId).
+			OrderAsc("id").
+			Limit(reindexBatchSize).
+			Scan(&posts)
+		if err != nil {
+			return count, gerror.Wrap(err, "查询微博失败")
+		}
+		if len(posts) == 0 {
+			break
+		}
+		err = g.DB().Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			for _, p := range posts {
+				if err := s.Sync(ctx, tx, p.Id, p.Content); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return count, err
+		}
+		count += len(posts)
+		lastId = posts[len(posts)-1].Id
+	}
+
+	// 清理没有任何微博使用的话题
+	if _, err := dao.WeiboTopics.Ctx(ctx).
+		Where("NOT EXISTS (SELECT 1 FROM weibo_post_topics pt WHERE pt.topic_id = weibo_topics.id)").
+		Delete(); err != nil {
+		return count, gerror.Wrap(err, "清理话题失败")
+	}
+
+	InvalidateContentCaches()
+	g.Log().Info(ctx, "WeiboTopic.Reindex", "posts", count)
+	return count, nil
+}
@@ -0,0 +1,130 @@
+// Package weibotext 解析微博正文中的话题（#话题# 与 #tag 两种写法）和 @提及
+//
+// 偏移量按 Unicode 码点计算，区间左闭右开，客户端可用 Array.from(content) 等方式按码点截取。
+package weibotext
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 实体类型
+const (
+	TypeHashtag = "hashtag"
+	TypeMention = "mention"
+)
+
+const (
+	// MaxTopicLen 话题名称最大长度（码点）
+	MaxTopicLen = 50
+	// maxUsernameLen 用户名最大长度，与 users.username 一致
+	maxUsernameLen = 50
+)
+
+// Entity 正文中的一个话题或提及
+type Entity struct {
+	Type  string
+	Start int    // 起始偏移（码点）
+	End   int    // 结束偏移（码点，不含）
+	Text  string // 原文片段，包含 # 或 @
+	Value string // 话题名称（保留原有大小写）或用户名（小写）
+}
+
+// Parse 按出现顺序返回正文中的全部话题与提及
+//
+//   - #话题#：两个 # 之间不含空白的 1~50 个字符，前面可以紧跟中文
+//   - #tag：# 后连续的字母、数字或下划线，至少包含一个字母，到空白或标点结束
+//   - @username：与用户名规则相同的字母、数字、下划线与连字符
+//
+// # 与 @ 前紧跟 ASCII 字母、数字或 / & 等字符时不识别，避免误匹配网址锚点与邮箱地址。
+func Parse(content string) []Entity {
+	rs := []rune(content)
+	var out []Entity
+	for i := 0; i < len(rs); {
+		var (
+			e  Entity
+			ok bool
+		)
+		switch rs[i] {
+		case '#', '＃':
+			e, ok = parseHashtag(rs, i)
+		case '@', '＠':
+			e, ok = parseMention(rs, i)
+		}
+		if ok {
+			out = append(out, e)
+			i = e.End
+			continue
+		}
+		i++
+	}
+	return out
+}
+
+// TopicKey 话题的规范化键：去除首尾空白并转为小写，#Go 与 #go 视为同一话题
+func TopicKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func parseHashtag(rs []rune, i int) (Entity, bool) {
+	if i > 0 && isAttachedRune(rs[i-1]) {
+		return Entity{}, false
+	}
+
+	// 闭合写法 #话题#
+	for j := i + 1; j < len(rs) && j-i-1 <= MaxTopicLen; j++ {
+		r := rs[j]
+		if unicode.IsSpace(r) {
+			break
+		}
+		if r == '#' || r == '＃' {
+			if j == i+1 {
+				break
+			}
+			return Entity{Type: TypeHashtag, Start: i, End: j + 1, Text: string(rs[i : j+1]), Value: string(rs[i+1 : j])}, true
+		}
+	}
+
+	// 开放写法 #tag
+	j, letter := i+1, false
+	for j < len(rs) && isTagRune(rs[j]) {
+		if unicode.IsLetter(rs[j]) {
+			letter = true
+		}
+		j++
+	}
+	if !letter || j-i-1 > MaxTopicLen {
+		return Entity{}, false
+	}
+	return Entity{Type: TypeHashtag, Start: i, End: j, Text: string(rs[i:j]), Value: string(rs[i+1 : j])}, true
+}
+
+func parseMention(rs []rune, i int) (Entity, bool) {
+	if i > 0 && (isAttachedRune(rs[i-1]) || rs[i-1] == '.' || rs[i-1] == '-' || rs[i-1] == '+') {
+		return Entity{}, false
+	}
+	j := i + 1
+	if j >= len(rs) || !isASCIIAlnum(rs[j]) {
+		return Entity{}, false
+	}
+	for j < len(rs) && (isASCIIAlnum(rs[j]) || rs[j] == '_' || rs[j] == '-') {
+		j++
+	}
+	if j-i-1 > maxUsernameLen {
+		return Entity{}, false
+	}
+	return Entity{Type: TypeMention, Start: i, End: j, Text: string(rs[i:j]), Value: strings.ToLower(string(rs[i+1 : j]))}, true
+}
+
+// isAttachedRune # 或 @ 前出现这些字符时视为网址、邮箱等的一部分
+func isAttachedRune(r rune) bool {
+	return isASCIIAlnum(r) || r == '_' || r == '/' || r == '&' || r == '#' || r == '@'
+}
+
+func isTagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || r == '_'
+}
+
+func isASCIIAlnum(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
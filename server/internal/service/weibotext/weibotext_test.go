@@ -0,0 +1,56 @@
+package weibotext
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	long := strings.Repeat("a", MaxTopicLen)
+	cases := []struct {
+		name    string
+		content string
+		want    string // 每个实体为 类型:起止偏移:值，以空格分隔
+	}{
+		{"closed", "#Go# 好", "hashtag:0-4:Go"},
+		{"closed after chinese", "学习#Go语言#了", "hashtag:2-8:Go语言"},
+		{"fullwidth", "＃全角＃", "hashtag:0-4:全角"},
+		{"open", "#golang is fun", "hashtag:0-7:golang"},
+		{"open stops at space", "#话题 带空格#", "hashtag:0-3:话题"},
+		{"open digits only", "#2024", ""},
+		{"empty closed", "## # 空格#", ""},
+		{"url anchor", "see https://a.example/#top", ""},
+		{"attached", "a#b", ""},
+		{"max length", "#" + long + "#", "hashtag:0-52:" + long},
+		{"too long", "#" + long + "a#", ""},
+		{"mention", "@Alice hi", "mention:0-6:alice"},
+		{"mention fullwidth", "＠bob", "mention:0-4:bob"},
+		{"mention charset", "@bob_smith-2,", "mention:0-12:bob_smith-2"},
+		{"email", "mail a@b.com", ""},
+		{"after dot", "x.@bob", ""},
+		{"leading underscore", "@_x", ""},
+		{"mixed", "你好 @bob #Go#，@amy", "mention:3-7:bob hashtag:8-12:Go mention:13-17:amy"},
+	}
+	for _, tc := range cases {
+		var parts []string
+		rs := []rune(tc.content)
+		for _, e := range Parse(tc.content) {
+			if e.Text != string(rs[e.Start:e.End]) {
+				t.Errorf("%s: text %q does not match offsets %d-%d", tc.name, e.Text, e.Start, e.End)
+			}
+			parts = append(parts, fmt.Sprintf("%s:%d-%d:%s", e.Type, e.Start, e.End, e.Value))
+		}
+		if got := strings.Join(parts, " "); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestTopicKey(t *testing.T) {
+	for in, want := range map[string]string{" Go ": "go", "GoLang": "golang", "话题": "话题"} {
+		if got := TopicKey(in); got != want {
+			t.Errorf("TopicKey(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package utility
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gogf/gf/v2/net/ghttp"
+)
+
+// ServeContent 输出可缓存的原始内容，设置 ETag/Last-Modified 并处理条件请求
+//
+// 优先比较 If-None-Match，未携带时再比较 If-Modified-Since；命中时返回 304。
+func ServeContent(r *ghttp.Request, contentType string, body []byte, etag string, lastModified time.Time, maxAge int) {
+	response := r.Response
+	response.Header().Set("Content-Type", contentType)
+	response.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(maxAge))
+	if etag != "" {
+		response.Header().Set("ETag", etag)
+	}
+	if !lastModified.IsZero() {
+		response.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		if etag != "" && inm == etag {
+			response.WriteStatus(http.StatusNotModified)
+			return
+		}
+	} else if ims := r.Header.Get("If-Modified-Since"); ims != "" && !lastModified.IsZero() {
+		if t, err := http.ParseTime(ims); err == nil && !lastModified.Truncate(time.Second).After(t) {
+			response.WriteStatus(http.StatusNotModified)
+			return
+		}
+	}
+
+	response.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	response.Write(body)
+}